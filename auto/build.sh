@@ -51,6 +51,8 @@ function buildpkg() {
 buildpkg xchain "$HOMEDIR/cmd/xchain/main.go"
 # adapetr client
 buildpkg xchain-cli "$HOMEDIR/cmd/client/main.go"
+# signer service for client
+buildpkg xchain-signer "$HOMEDIR/cmd/signer/main.go"
 
 # build output
 cp -r "$HOMEDIR/conf" "$OUTDIR"
@@ -20,7 +20,7 @@ func NewAccountCommand(cli *Cli) *cobra.Command {
 	c.cli = cli
 	c.cmd = &cobra.Command{
 		Use:   "account",
-		Short: "Operate an account or address: balance|new|newkeys|contracts|restore|decrypt.",
+		Short: "Operate an account or address: balance|new|newkeys|contracts|restore|derive|import|export|encrypt|decrypt|sign|verify.",
 	}
 	c.cmd.AddCommand(NewAccountBalanceCommand(cli))
 	c.cmd.AddCommand(NewAccountNewkeysCommand(cli))
@@ -28,7 +28,13 @@ func NewAccountCommand(cli *Cli) *cobra.Command {
 	c.cmd.AddCommand(NewAccountContractsCommand(cli))
 	c.cmd.AddCommand(NewAccountQueryCommand(cli))
 	c.cmd.AddCommand(NewAccountRestoreCommand(cli))
+	c.cmd.AddCommand(NewAccountDeriveCommand(cli))
+	c.cmd.AddCommand(NewAccountImportCommand(cli))
+	c.cmd.AddCommand(NewAccountExportCommand(cli))
+	c.cmd.AddCommand(NewAccountEncryptCommand(cli))
 	c.cmd.AddCommand(NewAccountDecryptCommand(cli))
+	c.cmd.AddCommand(NewAccountSignCommand(cli))
+	c.cmd.AddCommand(NewAccountVerifyCommand(cli))
 	return c.cmd
 }
 
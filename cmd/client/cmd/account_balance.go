@@ -6,7 +6,6 @@ package cmd
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"github.com/spf13/cobra"
@@ -73,9 +72,19 @@ func (b *AccountBalanceCommand) queryBalance(ctx context.Context, account string
 	if err != nil {
 		return err
 	}
-	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
-		return errors.New(reply.Header.Error.String())
+	if err := headerError(reply.Header); err != nil {
+		return err
+	}
+	// 未指定-o时只输出余额，便于脚本直接使用
+	if b.cli.RootOptions.Output == "" {
+		fmt.Println(reply.Bcs[0].Balance)
+		return nil
+	}
+	balance := &Balance{
+		Address: account,
+		Bcname:  reply.Bcs[0].Bcname,
+		Balance: reply.Bcs[0].Balance,
+		Frozen:  b.frozen,
 	}
-	fmt.Println(reply.Bcs[0].Balance)
-	return nil
+	return b.cli.PrintOutput(balance)
 }
@@ -5,7 +5,6 @@ package cmd
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/spf13/cobra"
@@ -71,13 +70,11 @@ func (c *AccountContractsCommand) queryAccountContracts(ctx context.Context) err
 	if err != nil {
 		return err
 	}
-	output, err := json.MarshalIndent(res.GetContractsStatus(), "", "  ")
-	if err != nil {
-		fmt.Println(err)
-		return err
+	contracts := make([]*ContractStatus, 0, len(res.GetContractsStatus()))
+	for _, status := range res.GetContractsStatus() {
+		contracts = append(contracts, FromContractStatusPB(status))
 	}
-	fmt.Println(string(output))
-	return nil
+	return c.cli.PrintOutput(contracts)
 }
 
 func (c *AccountContractsCommand) queryAddressContracts(ctx context.Context) error {
@@ -92,11 +89,11 @@ func (c *AccountContractsCommand) queryAddressContracts(ctx context.Context) err
 	if err != nil {
 		return err
 	}
-	output, err := json.MarshalIndent(res.GetContracts(), "", "  ")
-	if err != nil {
-		fmt.Println(err)
-		return err
+	contracts := make(map[string][]*ContractStatus, len(res.GetContracts()))
+	for account, list := range res.GetContracts() {
+		for _, status := range list.GetContractStatus() {
+			contracts[account] = append(contracts[account], FromContractStatusPB(status))
+		}
 	}
-	fmt.Println(string(output))
-	return nil
+	return c.cli.PrintOutput(contracts)
 }
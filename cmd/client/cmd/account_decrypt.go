@@ -9,17 +9,11 @@ package cmd
 
 import (
 	"context"
-	"encoding/base64"
-	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 
-	"github.com/manifoldco/promptui"
 	"github.com/spf13/cobra"
-
-	"github.com/xuperchain/crypto/core/account"
-	"github.com/xuperchain/crypto/core/hdwallet/key"
 )
 
 // AccountDecryptCommand decrypt account struct
@@ -59,64 +53,49 @@ func (c *AccountDecryptCommand) decrypt(ctx context.Context) error {
 		return fmt.Errorf("output directory exists, abort")
 	}
 
-	if c.cli.RootOptions.Crypto != "default" {
-		return fmt.Errorf("only support default crypto plugin by now")
-	}
-
 	// get encrypted key
 	content, err := ioutil.ReadFile(c.file)
 	if err != nil {
 		fmt.Println("failed to read encrypted private key")
 		return err
 	}
-	encKey, err := base64.StdEncoding.DecodeString(string(content))
-	if err != nil {
-		fmt.Println("failed to base64 decode private key")
-		return err
-	}
 
 	// read password
-	validate := func(input string) error {
-		// suggest at least 6 chars
-		if len(input) < 4 {
-			return errors.New("Password must at least 4 characters")
-		}
-		return nil
-	}
-	prompt := promptui.Prompt{
-		Label:    "Password",
-		Validate: validate,
-		Mask:     '*',
-	}
-	passwd, err := prompt.Run()
+	passwd, err := readKeyPassword("Password", false)
 	if err != nil {
 		fmt.Println("failed to get password")
 		return err
 	}
 
 	// decrypt account
-	sk, err := key.GetBinaryEcdsaPrivateKeyFromString(string(encKey), passwd)
+	sk, err := privateKeyFile.decryptContent(string(content), passwd)
+	if err != nil {
+		fmt.Println("failed to restore private key, please check your key or password")
+		return err
+	}
+
+	// 按私钥的曲线选择密码学插件，支持国密私钥
+	cryptoClient, err := newCryptoClient(c.cli.RootOptions.Crypto, sk)
 	if err != nil {
-		fmt.Println("failed to restore private key")
 		return err
 	}
 
 	// get private key
-	eccPrivkey, err := account.GetEcdsaPrivateKeyFromJson(sk)
+	eccPrivkey, err := cryptoClient.GetEcdsaPrivateKeyFromJsonStr(sk)
 	if err != nil {
 		fmt.Println("failed to restore private key, please check your key or password")
 		return err
 	}
 
 	// get public key
-	pk, err := account.GetEcdsaPublicKeyJsonFormat(eccPrivkey)
+	pk, err := cryptoClient.GetEcdsaPublicKeyJsonFormatStr(eccPrivkey)
 	if err != nil {
 		fmt.Println("failed to get public key")
 		return err
 	}
 
 	// get address
-	addr, err := account.GetAddressFromPublicKey(&eccPrivkey.PublicKey)
+	addr, err := cryptoClient.GetAddressFromPublicKey(&eccPrivkey.PublicKey)
 	if err != nil {
 		fmt.Println("failed to get address")
 		return err
@@ -124,7 +103,7 @@ func (c *AccountDecryptCommand) decrypt(ctx context.Context) error {
 
 	// print address
 	fmt.Println("decrypted address:", addr)
-	err = c.saveAccount([]byte(addr), []byte(pk), sk)
+	err = c.saveAccount([]byte(addr), []byte(pk), []byte(sk))
 	if err != nil {
 		fmt.Println("failed to save account")
 		return err
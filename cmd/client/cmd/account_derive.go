@@ -0,0 +1,199 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ *
+ * Usage: Derive child keys from the mnemonic of an account.
+ *        ./xchain-cli account derive --keys data/keys --path "m/44'/0'/0'/0/0" --count 10
+ *        ./xchain-cli account derive --keys data/keys --path "m/44'/0'/0'/0" --xpub
+ *        ./xchain-cli account derive --from-xpub spub... --path m/0 --count 10
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	hdapi "github.com/xuperchain/crypto/core/hdwallet/api"
+	"github.com/xuperchain/crypto/core/hdwallet/keychain"
+)
+
+// AccountDeriveCommand derive child keys struct
+type AccountDeriveCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	path     string
+	count    int
+	mnemonic string
+	xpub     bool
+	fromXpub string
+	save     string
+	encrypt  bool
+	showPub  bool
+}
+
+// NewAccountDeriveCommand new derive account command
+func NewAccountDeriveCommand(cli *Cli) *cobra.Command {
+	c := new(AccountDeriveCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "derive",
+		Short: "Derive child keys from the mnemonic in --keys directory or an extended public key.",
+		Long: `Derive child keys by a BIP32 path such as m/44'/0'/0'/0/0, ' or h marks a
+hardened index. --count derives consecutive keys by increasing the last index,
+which gives one deposit address per user from a single seed.
+
+--xpub exports the extended public key at --path for watch-only services, and
+--from-xpub derives non-hardened child addresses from it without private keys.
+Other commands sign with a derived key by --index n, i.e. hdPath/n.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.derive()
+		},
+	}
+	c.addFlags()
+	return c.cmd
+}
+
+func (c *AccountDeriveCommand) addFlags() {
+	c.cmd.Flags().StringVar(&c.path, "path", "", "derivation path, default hdPath/0, or m/0 with --from-xpub")
+	c.cmd.Flags().IntVar(&c.count, "count", 1, "number of consecutive keys to derive")
+	c.cmd.Flags().StringVarP(&c.mnemonic, "mnemonic", "m", "", "mnemonic, default the mnemonic in keys directory")
+	c.cmd.Flags().BoolVar(&c.xpub, "xpub", false, "export the extended public key at the path")
+	c.cmd.Flags().StringVar(&c.fromXpub, "from-xpub", "", "derive public keys from an extended public key")
+	c.cmd.Flags().StringVar(&c.save, "save", "", "save the derived key as an account in the directory")
+	c.cmd.Flags().BoolVar(&c.encrypt, "encrypt", false, "encrypt the saved private key with a password")
+	c.cmd.Flags().BoolVar(&c.showPub, "show-public-key", false, "show public keys of derived keys")
+}
+
+func (c *AccountDeriveCommand) derive() error {
+	if c.count < 1 {
+		return usageError(errors.New("--count should be greater than 0"))
+	}
+	if c.save != "" && (c.count != 1 || c.xpub) {
+		return usageError(errors.New("--save can only save one derived key"))
+	}
+	if c.save != "" && c.fromXpub != "" {
+		return usageError(errors.New("--save requires private key, can not be used with --from-xpub"))
+	}
+	if c.encrypt && c.save == "" {
+		return usageError(errors.New("--encrypt requires --save"))
+	}
+
+	root, path, err := c.rootKey()
+	if err != nil {
+		return err
+	}
+	indexes, err := parseHDPath(path)
+	if err != nil {
+		return usageError(err)
+	}
+
+	if c.xpub {
+		child, err := deriveChild(root, indexes)
+		if err != nil {
+			return fmt.Errorf("derive %s failed.err:%v", path, err)
+		}
+		pub, err := child.Neuter()
+		if err != nil {
+			return fmt.Errorf("export extended public key failed.err:%v", err)
+		}
+		return c.cli.PrintOutput(&ExtendedPublicKey{Path: path, Xpub: pub.ToString()})
+	}
+
+	keys, err := c.deriveKeys(root, indexes)
+	if err != nil {
+		return err
+	}
+	if c.save != "" {
+		return c.saveAccount(keys[0])
+	}
+	views := make([]*DerivedKey, 0, len(keys))
+	for _, key := range keys {
+		view := &DerivedKey{Path: key.Path, Address: key.Address}
+		if c.showPub {
+			view.PublicKey = key.PublicKey
+		}
+		views = append(views, view)
+	}
+	return c.cli.PrintOutput(views)
+}
+
+// rootKey 派生使用的根密钥和路径，--from-xpub时路径相对于扩展公钥
+func (c *AccountDeriveCommand) rootKey() (*keychain.ExtendedKey, string, error) {
+	path := c.path
+	if c.fromXpub != "" {
+		if path == "" {
+			path = "m/0"
+		}
+		key, err := parseExtendedPublicKey(c.fromXpub)
+		return key, path, err
+	}
+
+	if path == "" {
+		path = hdBasePath() + "/0"
+	}
+	mnemonic := c.mnemonic
+	if mnemonic == "" {
+		var err error
+		if mnemonic, err = mnemonicFile.read(c.cli.RootOptions.Keys); err != nil {
+			return nil, "", fmt.Errorf("read mnemonic in %s failed, use -m to specify one.err:%v",
+				c.cli.RootOptions.Keys, err)
+		}
+	}
+	key, err := masterKeyFromMnemonic(mnemonic)
+	return key, path, err
+}
+
+// deriveKeys 从路径开始派生count个密钥，依次递增路径的最后一级
+func (c *AccountDeriveCommand) deriveKeys(root *keychain.ExtendedKey, indexes []uint32) ([]*hdKey, error) {
+	if len(indexes) == 0 {
+		return nil, usageError(errors.New("derivation path should have at least one index"))
+	}
+	parent, err := deriveChild(root, indexes[:len(indexes)-1])
+	if err != nil {
+		return nil, fmt.Errorf("derive %s failed.err:%v", formatHDPath(indexes[:len(indexes)-1]), err)
+	}
+	last := indexes[len(indexes)-1]
+	if last < hdapi.HardenedKeyStart && uint64(last)+uint64(c.count) > hdapi.HardenedKeyStart {
+		return nil, usageError(errors.New("--count exceeds the max non-hardened index"))
+	}
+
+	keys := make([]*hdKey, 0, c.count)
+	path := append([]uint32{}, indexes...)
+	for i := 0; i < c.count; i++ {
+		path[len(path)-1] = last + uint32(i)
+		child, err := childKey(parent, path[len(path)-1])
+		if err != nil {
+			return nil, fmt.Errorf("derive %s failed.err:%v", formatHDPath(path), err)
+		}
+		key, err := newHDKey(formatHDPath(path), child)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// saveAccount 将派生密钥保存为与account newkeys相同的密钥目录
+func (c *AccountDeriveCommand) saveAccount(key *hdKey) error {
+	if _, err := os.Stat(c.save); err == nil {
+		return fmt.Errorf("output directory exists, abort")
+	}
+	var passwd string
+	if c.encrypt {
+		var err error
+		if passwd, err = readKeyPassword("Password", true); err != nil {
+			return err
+		}
+	}
+	if err := writeKeyDir(c.save, key.Address, key.PublicKey, key.PrivateKey, c.encrypt, passwd); err != nil {
+		os.RemoveAll(c.save)
+		return err
+	}
+	fmt.Printf("derive %s successfully, account info saved at %s\n", key.Path, c.save)
+	return nil
+}
@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ *
+ * Usage: Encrypt the private key of an account with a password.
+ *        ./xchain-cli account encrypt --keys data/keys
+ *        ./xchain-cli account encrypt --keys data/keys --output data/enckeys
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+)
+
+// AccountEncryptCommand encrypt account struct
+type AccountEncryptCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	output string
+}
+
+// NewAccountEncryptCommand new encrypt account command
+func NewAccountEncryptCommand(cli *Cli) *cobra.Command {
+	c := new(AccountEncryptCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "encrypt",
+		Short: "Encrypt the private key and mnemonic in --keys directory with a password.",
+		Long: `Encrypt the private key and mnemonic in --keys directory with a password.
+The password is read from XCHAIN_KEY_PASSWORD, --password-file or the terminal.
+Encrypted keys are decrypted in memory by all signing commands, and can be
+restored to plaintext by account decrypt.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.encrypt()
+		},
+	}
+	c.addFlags()
+	return c.cmd
+}
+
+func (c *AccountEncryptCommand) addFlags() {
+	c.cmd.Flags().StringVarP(&c.output, "output", "o", "", "output directory, default encrypt in place")
+}
+
+func (c *AccountEncryptCommand) encrypt() error {
+	keys := c.cli.RootOptions.Keys
+	if c.output != "" {
+		if _, err := os.Stat(c.output); err == nil {
+			return fmt.Errorf("output directory exists, abort")
+		}
+	}
+	sk, err := readKeys(filepath.Join(keys, "private.key"))
+	if err != nil {
+		return fmt.Errorf("read private key failed.err:%v", err)
+	}
+	if isEncryptedKey(sk) {
+		return fmt.Errorf("private key in %s is already encrypted", keys)
+	}
+
+	passwd, err := readKeyPassword("Password", true)
+	if err != nil {
+		return err
+	}
+	if c.output == "" {
+		if err := encryptKeyDir(keys, passwd); err != nil {
+			return err
+		}
+		fmt.Printf("private key in %s encrypted\n", keys)
+		return nil
+	}
+
+	if err := c.saveAccount(keys, passwd); err != nil {
+		os.RemoveAll(c.output)
+		return err
+	}
+	fmt.Printf("account encrypted successfully, account info saved at %s\n", c.output)
+	return nil
+}
+
+// saveAccount 复制密钥目录后加密其中的私钥和助记词
+func (c *AccountEncryptCommand) saveAccount(keys, passwd string) error {
+	if err := os.MkdirAll(c.output, 0700); err != nil {
+		return fmt.Errorf("failed to create output dir:%s", err)
+	}
+	for _, name := range []string{"address", "public.key", privateKeyFile.name, mnemonicFile.name} {
+		buf, err := ioutil.ReadFile(filepath.Join(keys, name))
+		if os.IsNotExist(err) && name == mnemonicFile.name {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read %s:%s", name, err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(c.output, name), buf, 0600); err != nil {
+			return fmt.Errorf("failed to save %s:%s", name, err)
+		}
+	}
+	return encryptKeyDir(c.output, passwd)
+}
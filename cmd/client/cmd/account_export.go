@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ *
+ * Usage: Export the private key of an account as a standard key file.
+ *        ./xchain-cli account export --keys data/keys --format pem --file key.pem
+ *        ./xchain-cli account export --keys data/keys --format keystore-json --file keystore.json
+ */
+
+package cmd
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/xuperchain/crypto/core/account"
+	crypto_client "github.com/xuperchain/xupercore/lib/crypto/client"
+)
+
+// AccountExportCommand export account struct
+type AccountExportCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	format string
+	file   string
+	light  bool
+}
+
+// NewAccountExportCommand new export account command
+func NewAccountExportCommand(cli *Cli) *cobra.Command {
+	c := new(AccountExportCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "export",
+		Short: "Export the private key in --keys directory as PKCS#8 PEM or keystore json.",
+		Long: `Export the private key in --keys directory, or the key derived by --index,
+for the default crypto plugin:
+  pem            unencrypted PKCS#8 PEM
+  keystore-json  Ethereum style v3 keystore encrypted by scrypt and aes-128-ctr,
+                 the address field is the xuper address
+The keystore password is read from XCHAIN_KEY_PASSWORD, --password-file or the terminal.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.export()
+		},
+	}
+	c.addFlags()
+	return c.cmd
+}
+
+func (c *AccountExportCommand) addFlags() {
+	c.cmd.Flags().StringVar(&c.format, "format", keyFormatKeystore, "key file format: pem|keystore-json")
+	c.cmd.Flags().StringVar(&c.file, "file", "", "output file, default print to stdout")
+	c.cmd.Flags().BoolVar(&c.light, "light", false, "use light scrypt parameters for keystore-json")
+}
+
+func (c *AccountExportCommand) export() error {
+	if c.format != keyFormatPEM && c.format != keyFormatKeystore {
+		return usageError(fmt.Errorf("unsupported format %s, expect pem|keystore-json", c.format))
+	}
+	if c.file != "" {
+		if _, err := os.Stat(c.file); err == nil {
+			return fmt.Errorf("output file %s exists, abort", c.file)
+		}
+	}
+	sk, err := readPrivateKey(c.cli.RootOptions.Keys)
+	if err != nil {
+		return fmt.Errorf("read private key failed.err:%v", err)
+	}
+	if cryptoType := keyCryptoType(sk); cryptoType != crypto_client.CryptoTypeDefault {
+		return fmt.Errorf("export only supports keys of the default crypto plugin, got %s", cryptoType)
+	}
+	key, err := account.GetEcdsaPrivateKeyFromJson([]byte(sk))
+	if err != nil {
+		return fmt.Errorf("parse private key failed.err:%v", err)
+	}
+
+	var content []byte
+	switch c.format {
+	case keyFormatPEM:
+		content, err = encodePEM(key)
+	case keyFormatKeystore:
+		content, err = c.keystore(key)
+	}
+	if err != nil {
+		return err
+	}
+	if c.file == "" {
+		fmt.Println(string(content))
+		return nil
+	}
+	if err := ioutil.WriteFile(c.file, content, 0600); err != nil {
+		return fmt.Errorf("write %s failed.err:%v", c.file, err)
+	}
+	fmt.Printf("private key exported to %s\n", c.file)
+	return nil
+}
+
+func (c *AccountExportCommand) keystore(key *ecdsa.PrivateKey) ([]byte, error) {
+	address, err := account.GetAddressFromPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	passwd, err := readKeyPassword("Keystore password", true)
+	if err != nil {
+		return nil, err
+	}
+	if passwd == "" {
+		return nil, errors.New("keystore password should not be empty")
+	}
+	return encryptKeystore(key, address, passwd, c.light)
+}
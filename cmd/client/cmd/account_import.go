@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ *
+ * Usage: Import a PKCS#8 PEM or keystore json file as an account.
+ *        ./xchain-cli account import key.pem -o data/keys --address dpzuVdosQrF2kmzumhVeFQZa1aYcdgFpN
+ *        ./xchain-cli account import keystore.json -o data/keys --encrypt
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+)
+
+// AccountImportCommand import account struct
+type AccountImportCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	format    string
+	outputdir string
+	address   string
+	force     bool
+	encrypt   bool
+}
+
+// NewAccountImportCommand new import account command
+func NewAccountImportCommand(cli *Cli) *cobra.Command {
+	c := new(AccountImportCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "import <key file>",
+		Short: "Import a PKCS#8 PEM or keystore json file as an account.",
+		Long: `Import a P-256 private key of the default crypto plugin from PKCS#8 or SEC1 PEM,
+or an Ethereum style v3 keystore json (scrypt or pbkdf2, aes-128-ctr), and save
+it as address, public.key and private.key in the output directory.
+
+The address computed from the private key must equal the address field of the
+keystore and --address if given. The keystore password is read from
+XCHAIN_KEY_PASSWORD, --password-file or the terminal.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.importKey(args[0])
+		},
+	}
+	c.addFlags()
+	return c.cmd
+}
+
+func (c *AccountImportCommand) addFlags() {
+	c.cmd.Flags().StringVar(&c.format, "format", "", "key file format: pem|keystore-json, default detect by content")
+	c.cmd.Flags().StringVarP(&c.outputdir, "output", "o", "./data/keys", "output directory")
+	c.cmd.Flags().StringVar(&c.address, "address", "", "expected address of the imported key")
+	c.cmd.Flags().BoolVarP(&c.force, "force", "f", false, "Force override existing account files")
+	c.cmd.Flags().BoolVar(&c.encrypt, "encrypt", false, "encrypt the imported private key with a password")
+}
+
+func (c *AccountImportCommand) importKey(file string) error {
+	if _, err := os.Stat(c.outputdir); err == nil && !c.force {
+		return fmt.Errorf("output directory exists, abort")
+	}
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("read %s failed.err:%v", file, err)
+	}
+	key, fileAddress, err := c.decode(content)
+	if err != nil {
+		return err
+	}
+
+	address, publicKey, privateKey, err := keyDirFiles(key)
+	if err != nil {
+		return err
+	}
+	if fileAddress != "" && fileAddress != address {
+		return fmt.Errorf("address mismatch, keystore address is %s but the private key belongs to %s", fileAddress, address)
+	}
+	if c.address != "" && c.address != address {
+		return fmt.Errorf("address mismatch, expect %s but the private key belongs to %s", c.address, address)
+	}
+
+	var passwd string
+	if c.encrypt {
+		if passwd, err = readKeyPassword("Password", true); err != nil {
+			return err
+		}
+	}
+	// 覆盖时删除原有账户的助记词，避免与导入的私钥不一致
+	if err := os.Remove(filepath.Join(c.outputdir, mnemonicFile.name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := writeKeyDir(c.outputdir, address, publicKey, privateKey, c.encrypt, passwd); err != nil {
+		return err
+	}
+	fmt.Printf("import account %s successfully, account info saved at %s\n", address, c.outputdir)
+	return nil
+}
+
+// decode 按格式解析私钥，keystore json同时返回其中记录的地址
+func (c *AccountImportCommand) decode(content []byte) (*ecdsa.PrivateKey, string, error) {
+	format := c.format
+	if format == "" {
+		format = keyFormatKeystore
+		if bytes.HasPrefix(bytes.TrimSpace(content), []byte("-----BEGIN")) {
+			format = keyFormatPEM
+		}
+	}
+	switch format {
+	case keyFormatPEM:
+		key, err := decodePEM(content)
+		return key, "", err
+	case keyFormatKeystore:
+		passwd, err := readKeyPassword("Keystore password", false)
+		if err != nil {
+			return nil, "", err
+		}
+		return decryptKeystore(content, passwd)
+	default:
+		return nil, "", usageError(fmt.Errorf("unsupported format %s, expect pem|keystore-json", format))
+	}
+}
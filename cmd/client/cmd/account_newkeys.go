@@ -23,6 +23,7 @@ type AccountNewkeysCommand struct {
 	lang         string
 	forceOveride bool
 	cryptoType   string
+	encrypt      bool
 }
 
 // NewAccountNewkeysCommand new addr account cmd
@@ -45,28 +46,35 @@ func (c *AccountNewkeysCommand) addFlags() {
 	c.cmd.Flags().Uint8Var(&c.strength, "strength", 0, "using mnemonic with specific strength(easy:1 mid:2 hard:3)")
 	c.cmd.Flags().StringVar(&c.lang, "lang", "zh", "mnemonic language, zh|en")
 	c.cmd.Flags().BoolVarP(&c.forceOveride, "force", "f", false, "Force override existing account files")
+	c.cmd.Flags().BoolVar(&c.encrypt, "encrypt", false, "encrypt the private key and mnemonic with a password")
 }
 
 func (c *AccountNewkeysCommand) createAccount() error {
 	if _, err := os.Stat(c.outputdir); err == nil && !c.forceOveride {
 		return fmt.Errorf("output directory exists, abort")
 	}
+	// 先读取密码，避免生成明文私钥后才发现无法加密
+	var passwd string
+	var err error
+	if c.encrypt {
+		if passwd, err = readKeyPassword("Password", true); err != nil {
+			return err
+		}
+	}
 	if err := os.MkdirAll(c.outputdir, os.ModePerm); nil != err {
 		return fmt.Errorf("failed to create output dir before create account:%s", err)
 	}
 	c.cryptoType = c.cli.RootOptions.Crypto
-	var err error
 	if c.strength > 0 {
 		// intversion, _ := strconv.ParseInt(xchainversion.Version, 0, 8)
 		// version := uint8(intversion)
 		err = c.createMnmAccount(c.strength, c.lang)
-		if err != nil {
-			os.RemoveAll(c.outputdir)
-			return err
-		}
-		return nil
+	} else {
+		err = c.createSimpleAccount()
+	}
+	if err == nil && c.encrypt {
+		err = encryptKeyDir(c.outputdir, passwd)
 	}
-	err = c.createSimpleAccount()
 	if err != nil {
 		os.RemoveAll(c.outputdir)
 		return err
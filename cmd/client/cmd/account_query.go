@@ -6,8 +6,6 @@ package cmd
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
 	"fmt"
 
 	"github.com/spf13/cobra"
@@ -65,17 +63,15 @@ func (t *AccountQueryCommand) queryAccount(ctx context.Context) error {
 		return err
 	}
 
-	if reply.GetHeader().GetError() != pb.XChainErrorEnum_SUCCESS {
-		return errors.New(reply.Header.Error.String())
+	if err := headerError(reply.Header); err != nil {
+		return err
 	}
 
 	if reply != nil {
 		account := reply.GetAccount()
-		output, err := json.MarshalIndent(account, "", "  ")
-		if err != nil {
-			fmt.Println(err)
+		if err := t.cli.PrintOutput(account); err != nil {
+			return err
 		}
-		fmt.Println(string(output))
 	}
 	return nil
 }
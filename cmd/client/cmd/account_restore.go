@@ -57,7 +57,12 @@ func (c *AccountRestoreCommand) restoreAccount() error {
 	default:
 		return fmt.Errorf("bad lang:%s use zh|en instead", langstr)
 	}
-	c.cryptoType = c.cli.RootOptions.Crypto
+	// 助记词中记录了密码学类型，国密助记词不需要再指定--crypto gm
+	cryptoType, err := mnemonicCryptoType(mnemonic, lang)
+	if err != nil {
+		return fmt.Errorf("restore account by mnemonic failed:%s", err)
+	}
+	c.cryptoType = cryptoType
 	cryptoClient, cryptoErr := crypto_client.CreateCryptoClient(c.cryptoType)
 	if cryptoErr != nil {
 		return fmt.Errorf("fail to create crypto client, err:%s", cryptoErr)
@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ *
+ * Usage: Sign a message with the private key of an account.
+ *        ./xchain-cli account sign --keys data/keys -m hello
+ *        ./xchain-cli account sign --keys data/keys --file msg.txt
+ */
+
+package cmd
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+	"github.com/xuperchain/crypto/core/hash"
+)
+
+// AccountSignCommand sign message struct
+type AccountSignCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	message string
+	file    string
+}
+
+// NewAccountSignCommand new sign message command
+func NewAccountSignCommand(cli *Cli) *cobra.Command {
+	c := new(AccountSignCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "sign",
+		Short: "Sign a message with the private key in --keys directory.",
+		Long: `Sign the double sha256 of a message with the private key in --keys directory
+or the keystore signer, the remote signer does not sign messages,
+the crypto plugin (default or gm) is chosen by the curve of the key.
+The signature is hex encoded and can be checked by account verify.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.sign()
+		},
+	}
+	c.addFlags()
+	return c.cmd
+}
+
+func (c *AccountSignCommand) addFlags() {
+	c.cmd.Flags().StringVarP(&c.message, "message", "m", "", "message to sign")
+	c.cmd.Flags().StringVar(&c.file, "file", "", "file containing the message to sign")
+}
+
+func (c *AccountSignCommand) sign() error {
+	msg, err := readMessage(c.message, c.file)
+	if err != nil {
+		return err
+	}
+	signer, err := accountSigner(c.cli.RootOptions.Keys, c.cli.RootOptions.Crypto)
+	if err != nil {
+		return err
+	}
+	pk, err := signer.PublicKey()
+	if err != nil {
+		return fmt.Errorf("read public key failed.err:%v", err)
+	}
+	address, err := signer.Address()
+	if err != nil {
+		return fmt.Errorf("read address failed.err:%v", err)
+	}
+	sig, cryptoType, err := signMessage(signer, c.cli.RootOptions.Crypto, msg)
+	if err != nil {
+		return err
+	}
+	return c.cli.PrintOutput(&MessageSignature{
+		Address:   address,
+		Crypto:    cryptoType,
+		PublicKey: pk,
+		Signature: hex.EncodeToString(sig),
+	})
+}
+
+// signMessage 签名消息的double sha256，返回签名和公钥对应的密码学插件
+func signMessage(signer Signer, cryptoType string, msg []byte) ([]byte, string, error) {
+	pk, err := signer.PublicKey()
+	if err != nil {
+		return nil, "", fmt.Errorf("read public key failed.err:%v", err)
+	}
+	if keyType := keyCryptoType(pk); keyType != "" {
+		cryptoType = keyType
+	}
+	local, ok := signer.(digestSigner)
+	if !ok {
+		return nil, "", errors.New("sign message is not supported by remote signer, use a local key")
+	}
+	sig, err := local.signDigest(hash.DoubleSha256(msg))
+	if err != nil {
+		return nil, "", fmt.Errorf("sign message failed.err:%v", err)
+	}
+	return sig, cryptoType, nil
+}
+
+// readMessage 读取--message或--file指定的消息
+func readMessage(message, file string) ([]byte, error) {
+	switch {
+	case message != "" && file != "":
+		return nil, usageError(errors.New("--message and --file can not be used together"))
+	case message != "":
+		return []byte(message), nil
+	case file != "":
+		msg, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read %s failed.err:%v", file, err)
+		}
+		return msg, nil
+	default:
+		return nil, usageError(errors.New("--message or --file is required"))
+	}
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ *
+ * Usage: Verify a message signature generated by account sign.
+ *        ./xchain-cli account verify -m hello --signature 3045... --public-key data/keys/public.key
+ *        ./xchain-cli account verify -m hello --signature 3045... --keys data/keys --address TeyyPLpp9L7QAcxHangtcHTu7HUZ6iydY
+ */
+
+package cmd
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/xuperchain/crypto/core/hash"
+)
+
+// AccountVerifyCommand verify message signature struct
+type AccountVerifyCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	message   string
+	file      string
+	signature string
+	publicKey string
+	address   string
+}
+
+// NewAccountVerifyCommand new verify signature command
+func NewAccountVerifyCommand(cli *Cli) *cobra.Command {
+	c := new(AccountVerifyCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "verify",
+		Short: "Verify a message signature generated by account sign.",
+		Long: `Verify a hex signature of a message generated by account sign. The public key
+is read from --public-key (a json key or a file) or the public.key in --keys
+directory, and the crypto plugin (default or gm) is chosen by its curve.
+--address additionally checks that the public key belongs to the address.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.verify()
+		},
+	}
+	c.addFlags()
+	return c.cmd
+}
+
+func (c *AccountVerifyCommand) addFlags() {
+	c.cmd.Flags().StringVarP(&c.message, "message", "m", "", "signed message")
+	c.cmd.Flags().StringVar(&c.file, "file", "", "file containing the signed message")
+	c.cmd.Flags().StringVarP(&c.signature, "signature", "s", "", "hex encoded signature")
+	c.cmd.Flags().StringVar(&c.publicKey, "public-key", "", "json public key or public key file, default public.key in keys directory")
+	c.cmd.Flags().StringVar(&c.address, "address", "", "expected address of the public key")
+}
+
+func (c *AccountVerifyCommand) verify() error {
+	msg, err := readMessage(c.message, c.file)
+	if err != nil {
+		return err
+	}
+	if c.signature == "" {
+		return usageError(errors.New("--signature is required"))
+	}
+	sig, err := hex.DecodeString(strings.TrimPrefix(c.signature, "0x"))
+	if err != nil {
+		return usageError(fmt.Errorf("invalid signature.err:%v", err))
+	}
+	pk, err := c.readPublicKey()
+	if err != nil {
+		return err
+	}
+
+	result, err := verifyMessage(c.cli.RootOptions.Crypto, pk, c.address, msg, sig)
+	if err != nil {
+		return err
+	}
+	if !result.Valid {
+		return fmt.Errorf("verify signature failed, the signature does not match the message and public key of %s", result.Address)
+	}
+	return c.cli.PrintOutput(result)
+}
+
+// readPublicKey --public-key可以是json格式的公钥或公钥文件
+func (c *AccountVerifyCommand) readPublicKey() (string, error) {
+	if c.publicKey == "" {
+		return readPublicKey(c.cli.RootOptions.Keys)
+	}
+	if strings.HasPrefix(strings.TrimSpace(c.publicKey), "{") {
+		return c.publicKey, nil
+	}
+	buf, err := ioutil.ReadFile(c.publicKey)
+	if err != nil {
+		return "", fmt.Errorf("read public key failed.err:%v", err)
+	}
+	return strings.TrimSpace(string(buf)), nil
+}
+
+// verifyMessage 使用公钥对应的密码学插件验证消息签名，address非空时同时检查公钥与地址是否匹配
+func verifyMessage(cryptoType, jsonPk, address string, msg, sig []byte) (*SignatureVerification, error) {
+	if keyType := keyCryptoType(jsonPk); keyType != "" {
+		cryptoType = keyType
+	}
+	cryptoClient, err := newCryptoClient(cryptoType, jsonPk)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := cryptoClient.GetEcdsaPublicKeyFromJsonStr(jsonPk)
+	if err != nil {
+		return nil, fmt.Errorf("parse public key failed.err:%v", err)
+	}
+	keyAddress, err := cryptoClient.GetAddressFromPublicKey(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("get address from public key failed.err:%v", err)
+	}
+	if address != "" && address != keyAddress {
+		return nil, fmt.Errorf("address mismatch, expect %s but the public key belongs to %s", address, keyAddress)
+	}
+	// 签名格式错误时也视为验证失败
+	valid, _ := cryptoClient.VerifyECDSA(publicKey, sig, hash.DoubleSha256(msg))
+	return &SignatureVerification{
+		Address: keyAddress,
+		Crypto:  cryptoType,
+		Valid:   valid,
+	}, nil
+}
@@ -6,9 +6,7 @@ package cmd
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
-	"fmt"
 
 	"github.com/spf13/cobra"
 	"github.com/xuperchain/xuperchain/service/pb"
@@ -66,25 +64,9 @@ func (t *ACLQueryCommand) queryACL(ctx context.Context) error {
 		return err
 	}
 
-	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
-		return errors.New(reply.Header.Error.String())
+	if err := headerError(reply.Header); err != nil {
+		return err
 	}
 
-	if reply != nil {
-		acl := reply.GetAcl()
-		output, err := json.MarshalIndent(acl, "", "  ")
-		if err != nil {
-			fmt.Println(err)
-		}
-		fmt.Println(string(output))
-		if string(output) != "{}" && output != nil {
-			confirmed := reply.GetConfirmed()
-			if !confirmed {
-				fmt.Println("unconfirmed")
-			} else {
-				fmt.Println("confirmed")
-			}
-		}
-	}
-	return nil
+	return t.cli.PrintOutput(FromACLPB(reply.GetAcl(), reply.GetConfirmed()))
 }
@@ -7,9 +7,7 @@ package cmd
 import (
 	"context"
 	"encoding/hex"
-	"encoding/json"
 	"errors"
-	"fmt"
 	"strconv"
 
 	"github.com/spf13/cobra"
@@ -71,19 +69,14 @@ func (b *BlockCommand) queryBlock(ctx context.Context, blockid string) error {
 		return err
 	}
 
-	if block.Header.Error != pb.XChainErrorEnum_SUCCESS {
-		return errors.New(block.Header.Error.String())
+	if err := headerError(block.Header); err != nil {
+		return err
 	}
 	if block.Block == nil {
 		return errors.New("block not found")
 	}
 	iblock := FromInternalBlockPB(block.Block)
-	output, err := json.MarshalIndent(iblock, "", "  ")
-	if err != nil {
-		fmt.Println(err)
-	}
-	fmt.Println(string(output))
-	return nil
+	return b.cli.PrintOutput(iblock)
 }
 
 func (b *BlockCommand) queryBlockByHeight(ctx context.Context, height int64) error {
@@ -99,19 +92,14 @@ func (b *BlockCommand) queryBlockByHeight(ctx context.Context, height int64) err
 	if err != nil {
 		return err
 	}
-	if block.Header.Error != pb.XChainErrorEnum_SUCCESS {
-		return errors.New(block.Header.Error.String())
+	if err := headerError(block.Header); err != nil {
+		return err
 	}
 	if block.Block == nil {
 		return errors.New("block not found")
 	}
 	iblock := FromInternalBlockPB(block.Block)
-	output, err := json.MarshalIndent(iblock, "", "  ")
-	if err != nil {
-		fmt.Println(err)
-	}
-	fmt.Println(string(output))
-	return nil
+	return b.cli.PrintOutput(iblock)
 }
 
 func (b *BlockCommand) addFlags() {
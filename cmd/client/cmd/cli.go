@@ -6,28 +6,19 @@ package cmd
 
 import (
 	"context"
-	"crypto/tls"
-	"crypto/x509"
 	"encoding/hex"
-	"encoding/json"
-	"errors"
 	"fmt"
 	"io/ioutil"
 	"math/big"
 	"os"
-	"strconv"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
 
 	"github.com/xuperchain/xuperchain/service/common"
 	"github.com/xuperchain/xuperchain/service/pb"
-	crypto_client "github.com/xuperchain/xupercore/lib/crypto/client"
-	crypto_base "github.com/xuperchain/xupercore/lib/crypto/client/base"
-	cryptoHash "github.com/xuperchain/xupercore/lib/crypto/hash"
 	"github.com/xuperchain/xupercore/lib/utils"
 )
 
@@ -50,6 +41,24 @@ type RootOptions struct {
 	EndorseServiceHost string                `yaml:"endorseServiceHost,omitempty"`
 	ComplianceCheck    ComplianceCheckConfig `yaml:"complianceCheck,omitempty"`
 	MinNewChainAmount  string                `yaml:"minNewChainAmount,omitempty"`
+	// --fee auto时在估算手续费基础上增加的比例
+	FeeMargin float64 `yaml:"feeMargin,omitempty"`
+	// 使用的profile，为空时使用profile文件中的当前profile
+	Profile string `yaml:"profile,omitempty"`
+	// profile文件，为空时使用~/.xchain-cli/profiles.yaml
+	ProfileFile string `yaml:"profileFile,omitempty"`
+	// 查询结果的输出格式：json|yaml|table|go-template=
+	Output string `yaml:"output,omitempty"`
+	// 出错时以json格式输出{code, name, message, logid}到stderr
+	JSONErrors bool `yaml:"jsonErrors,omitempty"`
+	// 加密私钥的密码文件，未指定时使用环境变量XCHAIN_KEY_PASSWORD或终端输入
+	PasswordFile string `yaml:"passwordFile,omitempty"`
+	// --index使用的派生路径前缀，签名密钥为hdPath/index
+	HDPath string `yaml:"hdPath,omitempty"`
+	// 从密钥目录的助记词派生签名密钥的序号，小于0时直接使用private.key
+	Index int `yaml:"-"`
+	// 签名方式，私钥可以保存在独立的签名服务中
+	Signer SignerOptions `yaml:"signer,omitempty"`
 }
 
 // Cli 是所有子命令执行的上下文.
@@ -57,9 +66,13 @@ type Cli struct {
 	RootOptions RootOptions
 
 	rootCmd *cobra.Command
+	conn    *grpc.ClientConn
 	xclient pb.XchainClient
 
 	eventClient pb.EventServiceClient
+
+	// shell中已完成初始化，执行命令时不再重新加载配置和连接节点
+	inShell bool
 }
 
 // NewCli new cli cmd
@@ -68,7 +81,22 @@ func NewCli() *Cli {
 		Use:           "xchain-cli",
 		SilenceErrors: true,
 		SilenceUsage:  true,
-	}
+		Long: `xchain-cli is the command line client of xuperchain.
+
+Exit codes:
+  0  success
+  1  general client error
+  2  invalid command line arguments
+  3  connection refused or timeout
+  4  chain, block or tx not found
+  5  not enough utxo, fee or gas
+  6  tx verification or signature error
+  7  request refused or node not ready
+  8  node internal or unknown error`,
+	}
+	rootCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return usageError(err)
+	})
 	return &Cli{
 		rootCmd: rootCmd,
 	}
@@ -79,17 +107,21 @@ func (c *Cli) SetVer(ver string) {
 }
 
 func (c *Cli) initXchainClient() error {
-	conn, err := grpc.Dial(c.RootOptions.Host, grpc.WithInsecure(), grpc.WithMaxMsgSize(64<<20-1))
+	conn, err := dialNode(context.Background(), c.RootOptions.Host, c.RootOptions.TLS)
 	if err != nil {
 		return err
 	}
+	if c.conn != nil {
+		c.conn.Close()
+	}
+	c.conn = conn
 	c.xclient = pb.NewXchainClient(conn)
 	c.eventClient = pb.NewEventServiceClient(conn)
 	return nil
 }
 
 func (c *Cli) initFlags() error {
-	// 参数设置优先级：1.命令行指定 2.配置文件指定 3.默认值
+	// 参数设置优先级：1.命令行指定 2.配置文件指定 3.profile 4.默认值
 	// 加载配置文件
 	var cfgFile string
 	rootFlag := c.rootCmd.PersistentFlags()
@@ -97,22 +129,38 @@ func (c *Cli) initFlags() error {
 	c.RootOptions = NewRootOptions()
 	err := c.RootOptions.LoadConfig(cfgFile)
 	if err != nil {
-		fmt.Printf("load client config failed.config:%s err:%v\n", cfgFile, err)
-		os.Exit(-1)
+		fmt.Fprintf(os.Stderr, "load client config failed.config:%s err:%v\n", cfgFile, err)
+		os.Exit(ExitGeneral)
 	}
 	// 设置命令行参数和默认值
 	rootFlag.StringP("host", "H", c.RootOptions.Host, "server node ip:port")
 	rootFlag.String("name", c.RootOptions.Name, "block chain name")
 	rootFlag.String("keys", c.RootOptions.Keys, "directory of keys")
 	rootFlag.String("crypto", c.RootOptions.Crypto, "crypto type")
+	rootFlag.Bool("insecure-skip-verify", c.RootOptions.TLS.InsecureSkipVerify,
+		"skip verifying node certificate when tls is enabled, only for testing")
+	rootFlag.String("profile", c.RootOptions.Profile, "profile to use instead of the active one")
+	rootFlag.StringP("output", "o", c.RootOptions.Output, "output format: json|yaml|table|go-template=...")
+	rootFlag.Bool("json-errors", c.RootOptions.JSONErrors, "print errors as json {code, name, message, logid} to stderr")
+	rootFlag.String("password-file", c.RootOptions.PasswordFile, "file containing the password of encrypted private key")
+	rootFlag.Int("index", c.RootOptions.Index, "sign with the key derived at hdPath/index from the mnemonic in keys directory")
 	viper.BindPFlags(rootFlag)
+	viper.BindPFlag("tls.insecureSkipVerify", rootFlag.Lookup("insecure-skip-verify"))
+	viper.BindPFlag("jsonErrors", rootFlag.Lookup("json-errors"))
+	viper.BindPFlag("passwordFile", rootFlag.Lookup("password-file"))
 
 	cobra.OnInitialize(func() {
+		if c.inShell {
+			return
+		}
+		err = applyProfile(viper.GetString("profile"), viper.GetString("profileFile"))
+		if err != nil {
+			c.exit(fmt.Errorf("load profile failed.err:%w", err))
+		}
 		viper.Unmarshal(&c.RootOptions)
 		err = c.initXchainClient()
 		if err != nil {
-			fmt.Printf("init xchain client failed.err:%v\n", err)
-			os.Exit(-1)
+			c.exit(fmt.Errorf("init xchain client failed.err:%w", err))
 		}
 	})
 
@@ -139,11 +187,27 @@ func (c *Cli) AddCommands(cmds []CommandFunc) {
 func (c *Cli) Execute() {
 	err := c.rootCmd.Execute()
 	if err != nil {
-		fmt.Println(err)
-		os.Exit(-1)
+		c.exit(err)
 	}
 }
 
+// exit 按错误类型输出错误并以对应的退出码退出
+func (c *Cli) exit(err error) {
+	cliErr := toCliError(err)
+	printError(os.Stderr, cliErr, c.RootOptions.JSONErrors || viper.GetBool("jsonErrors") || jsonErrorsInArgs(os.Args[1:]))
+	os.Exit(cliErr.ExitCode)
+}
+
+// jsonErrorsInArgs 参数解析失败时flag未生效，直接从命令行判断是否指定了--json-errors
+func jsonErrorsInArgs(args []string) bool {
+	for _, arg := range args {
+		if arg == "--json-errors" || arg == "--json-errors=true" {
+			return true
+		}
+	}
+	return false
+}
+
 // XchainClient get xchain client
 func (c *Cli) XchainClient() pb.XchainClient {
 	return c.xclient
@@ -165,129 +229,75 @@ func (c *Cli) GetNodes(ctx context.Context) ([]string, error) {
 	if err != nil {
 		return nil, err
 	}
-	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
-		return nil, errors.New(reply.Header.Error.String())
+	if err := headerError(reply.Header); err != nil {
+		return nil, err
 	}
 	nodes := reply.GetSystemsStatus().GetPeerUrls()
 	return nodes, nil
 }
 
-func genCreds(certPath, serverName string) (credentials.TransportCredentials, error) {
-	bs, err := ioutil.ReadFile(certPath + "/cert.crt")
-
-	if err != nil {
-		return nil, err
-	}
-	certPool := x509.NewCertPool()
-	ok := certPool.AppendCertsFromPEM(bs)
-	if !ok {
-		return nil, err
-	}
-
-	certificate, err := tls.LoadX509KeyPair(certPath+"/key.pem", certPath+"/private.key")
-	if err != nil {
-		return nil, err
-	}
-	creds := credentials.NewTLS(
-		&tls.Config{
-			ServerName:   serverName,
-			Certificates: []tls.Certificate{certificate},
-			RootCAs:      certPool,
-			ClientCAs:    certPool,
-			ClientAuth:   tls.RequireAndVerifyClientCert,
-		})
-	return creds, nil
-}
-
 // RangeNodes exe func in all nodes
 func (c *Cli) RangeNodes(ctx context.Context, f func(addr string, client pb.XchainClient, err error) error) error {
 	nodes, err := c.GetNodes(ctx)
 	if err != nil {
 		return err
 	}
-	options := []grpc.DialOption{grpc.WithMaxMsgSize(64<<20 - 1)}
-	if c.RootOptions.TLS.Enable {
-		cred, err := genCreds(c.RootOptions.TLS.Cert, c.RootOptions.TLS.Server)
-		if err != nil {
-			return err
-		}
-		options = append(options, grpc.WithTransportCredentials(cred))
-	} else {
-		options = append(options, grpc.WithInsecure())
-	}
-
 	for _, addr := range nodes {
-		conn, err := grpc.Dial(addr, options...)
+		conn, err := dialNode(ctx, addr, c.RootOptions.TLS)
 		if err != nil {
 			err = f(addr, nil, err)
 			if err != nil {
 				return err
 			}
+			continue
 		}
 		client := pb.NewXchainClient(conn)
 		err = f(addr, client, err)
+		conn.Close()
 		if err != nil {
 			return err
 		}
 	}
 
-	optionsRPC := []grpc.DialOption{grpc.WithMaxMsgSize(64<<20 - 1), grpc.WithInsecure()}
-	conn, err := grpc.Dial(c.RootOptions.Host, optionsRPC...)
-	if err != nil {
-		return err
-	}
-	client := pb.NewXchainClient(conn)
-	err = f(c.RootOptions.Host, client, err)
-	if err != nil {
-		return err
-	}
-	return nil
+	return f(c.RootOptions.Host, c.xclient, nil)
 }
 
 // Transfer transfer cli entrance
 func (c *Cli) Transfer(ctx context.Context, opt *TransferOptions) (string, error) {
-	fromAddr, err := readAddress(opt.KeyPath)
+	signer, err := accountSigner(opt.KeyPath, opt.CryptoType)
 	if err != nil {
 		return "", err
 	}
-	fromPubkey, err := readPublicKey(opt.KeyPath)
+	fromAddr, err := signer.Address()
 	if err != nil {
 		return "", err
 	}
-
-	fromScrkey, err := readPrivateKey(opt.KeyPath)
+	fromPubkey, err := signer.PublicKey()
 	if err != nil {
 		return "", err
 	}
 
-	// create crypto client
-	cryptoClient, cryptoErr := crypto_client.CreateCryptoClient(opt.CryptoType)
-	if cryptoErr != nil {
-		fmt.Println("fail to create crypto client, err=", cryptoErr)
-		return "", cryptoErr
-	}
-
-	return c.transfer(ctx, c.xclient, opt, fromAddr, fromPubkey, fromScrkey, cryptoClient)
+	return c.transfer(ctx, c.xclient, opt, fromAddr, fromPubkey, signer)
 }
 
 func (c *Cli) transfer(ctx context.Context, client pb.XchainClient, opt *TransferOptions, fromAddr,
-	fromPubkey, fromScrkey string, cryptoClient crypto_base.CryptoClient) (string, error) {
+	fromPubkey string, signer Signer) (string, error) {
 	if opt.From == "" {
 		opt.From = fromAddr
 	}
-	return c.tansferSupportAccount(ctx, client, opt, fromAddr, fromPubkey, fromScrkey, cryptoClient)
+	return c.tansferSupportAccount(ctx, client, opt, fromAddr, fromPubkey, signer)
 }
 
 func (c *Cli) tansferSupportAccount(ctx context.Context, client pb.XchainClient, opt *TransferOptions,
-	initAddr, initPubkey, initScrkey string, cryptoClient crypto_base.CryptoClient) (string, error) {
+	initAddr, initPubkey string, signer Signer) (string, error) {
 	// 组装交易
-	txStatus, err := assembleTxSupportAccount(ctx, client, opt, initAddr, initPubkey, initScrkey, cryptoClient)
+	txStatus, err := assembleTxSupportAccount(ctx, client, opt, initAddr, initPubkey, signer)
 	if err != nil {
 		return "", err
 	}
 
 	// 签名和生成txid
-	signTx, err := common.ComputeTxSign(cryptoClient, txStatus.Tx, []byte(initScrkey))
+	signTx, err := signer.SignTx(txStatus.Tx)
 	if err != nil {
 		return "", err
 	}
@@ -296,7 +306,7 @@ func (c *Cli) tansferSupportAccount(ctx context.Context, client pb.XchainClient,
 		Sign:      signTx,
 	}
 	txStatus.Tx.InitiatorSigns = append(txStatus.Tx.InitiatorSigns, signInfo)
-	txStatus.Tx.AuthRequireSigns, err = genAuthRequireSigns(opt, cryptoClient, txStatus.Tx, initScrkey, initPubkey)
+	txStatus.Tx.AuthRequireSigns, err = genAuthRequireSigns(opt, signer, txStatus.Tx, initPubkey)
 	if err != nil {
 		return "", fmt.Errorf("Failed to genAuthRequireSigns %s", err)
 	}
@@ -307,25 +317,25 @@ func (c *Cli) tansferSupportAccount(ctx context.Context, client pb.XchainClient,
 	txStatus.Txid = txStatus.Tx.Txid
 
 	if opt.Debug {
-		ttx := FromPBTx(txStatus.Tx)
-		out, _ := json.MarshalIndent(ttx, "", "  ")
-		fmt.Println(string(out))
+		if err := c.PrintOutput(FromPBTx(txStatus.Tx)); err != nil {
+			return "", err
+		}
 		return hex.EncodeToString(txStatus.GetTxid()), nil
 	}
 
 	// 提交
 	reply, err := client.PostTx(ctx, txStatus)
 	if err != nil {
-		return "", fmt.Errorf("transferSupportAccount post tx err %s", err)
+		return "", fmt.Errorf("transferSupportAccount post tx err %w", err)
 	}
-	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
-		return "", fmt.Errorf("Failed to post tx: %s", reply.Header.String())
+	if err := headerError(reply.Header); err != nil {
+		return "", fmt.Errorf("Failed to post tx: %w", err)
 	}
 	return hex.EncodeToString(txStatus.GetTxid()), nil
 }
 
-func assembleTxSupportAccount(ctx context.Context, client pb.XchainClient, opt *TransferOptions, initAddr, initPubkey,
-	initScrkey string, cryptoClient crypto_base.CryptoClient) (*pb.TxStatus, error) {
+func assembleTxSupportAccount(ctx context.Context, client pb.XchainClient, opt *TransferOptions, initAddr, initPubkey string,
+	signer Signer) (*pb.TxStatus, error) {
 	bigZero := big.NewInt(0)
 	totalNeed := big.NewInt(0)
 	tx := &pb.Transaction{
@@ -342,6 +352,10 @@ func assembleTxSupportAccount(ctx context.Context, client pb.XchainClient, opt *
 		FrozenHeight: opt.FrozenHeight,
 	}
 	accounts := []*pb.TxDataAccount{account}
+	// 普通转账不调用合约，不消耗gas
+	if opt.Fee == feeAuto {
+		opt.Fee = "0"
+	}
 	if opt.Fee != "" && opt.Fee != "0" {
 		accounts = append(accounts, newFeeAccount(opt.Fee))
 	}
@@ -363,7 +377,7 @@ func assembleTxSupportAccount(ctx context.Context, client pb.XchainClient, opt *
 	}
 	// 组装input 和 剩余output
 	txInputs, deltaTxOutput, err := assembleTxInputsSupportAccount(ctx, client, opt, totalNeed, initAddr,
-		initPubkey, initScrkey, cryptoClient)
+		initPubkey, signer)
 	if err != nil {
 		return nil, err
 	}
@@ -429,10 +443,10 @@ func genAuthRequire(from, path string) ([]string, error) {
 	return authRequire, nil
 }
 
-func genAuthRequireSigns(opt *TransferOptions, cryptoClient crypto_base.CryptoClient, tx *pb.Transaction, initScrkey, initPubkey string) ([]*pb.SignatureInfo, error) {
+func genAuthRequireSigns(opt *TransferOptions, signer Signer, tx *pb.Transaction, initPubkey string) ([]*pb.SignatureInfo, error) {
 	authRequireSigns := []*pb.SignatureInfo{}
 	if opt.AccountPath == "" {
-		signTx, err := common.ComputeTxSign(cryptoClient, tx, []byte(initScrkey))
+		signTx, err := signer.SignTx(tx)
 		if err != nil {
 			return nil, err
 		}
@@ -450,15 +464,15 @@ func genAuthRequireSigns(opt *TransferOptions, cryptoClient crypto_base.CryptoCl
 	}
 	for _, fi := range dir {
 		if fi.IsDir() {
-			sk, err := readPrivateKey(opt.AccountPath + "/" + fi.Name())
+			authSigner, err := accountSigner(opt.AccountPath+"/"+fi.Name(), opt.CryptoType)
 			if err != nil {
 				return nil, err
 			}
-			pk, err := readPublicKey(opt.AccountPath + "/" + fi.Name())
+			pk, err := authSigner.PublicKey()
 			if err != nil {
 				return nil, err
 			}
-			signTx, err := common.ComputeTxSign(cryptoClient, tx, []byte(sk))
+			signTx, err := authSigner.SignTx(tx)
 			if err != nil {
 				return nil, err
 			}
@@ -473,7 +487,7 @@ func genAuthRequireSigns(opt *TransferOptions, cryptoClient crypto_base.CryptoCl
 }
 
 func assembleTxInputsSupportAccount(ctx context.Context, client pb.XchainClient, opt *TransferOptions, totalNeed *big.Int,
-	initAddr, initPubkey, initScrkey string, cryptoClient crypto_base.CryptoClient) ([]*pb.TxInput, *pb.TxOutput, error) {
+	initAddr, initPubkey string, signer Signer) ([]*pb.TxInput, *pb.TxOutput, error) {
 	ui := &pb.UtxoInput{
 		Bcname:    opt.BlockchainName,
 		Address:   opt.From,
@@ -482,14 +496,17 @@ func assembleTxInputsSupportAccount(ctx context.Context, client pb.XchainClient,
 		Publickey: initPubkey,
 	}
 
-	sign, err := computeSelectUtxoSign(opt.BlockchainName, initAddr, totalNeed.String(), initScrkey, strconv.FormatBool(true), cryptoClient)
+	sign, err := signer.SignSelectUtxo(opt.BlockchainName, initAddr, totalNeed.String())
 	if err != nil {
 		return nil, nil, err
 	}
 	ui.UserSign = sign
 	utxoRes, selectErr := client.SelectUTXO(ctx, ui)
-	if selectErr != nil || utxoRes.Header.Error != pb.XChainErrorEnum_SUCCESS {
-		return nil, nil, ErrSelectUtxo
+	if selectErr != nil {
+		return nil, nil, fmt.Errorf("%v, details:%w", ErrSelectUtxo, selectErr)
+	}
+	if err := headerError(utxoRes.Header); err != nil {
+		return nil, nil, fmt.Errorf("%v, details:%w", ErrSelectUtxo, err)
 	}
 	var txTxInputs []*pb.TxInput
 	var txOutput *pb.TxOutput
@@ -516,21 +533,6 @@ func assembleTxInputsSupportAccount(ctx context.Context, client pb.XchainClient,
 	return txTxInputs, txOutput, nil
 }
 
-func computeSelectUtxoSign(bcName, account, need, initScrKey, isLock string, cryptoClient crypto_base.CryptoClient) ([]byte, error) {
-	privateKey, err := cryptoClient.GetEcdsaPrivateKeyFromJsonStr(initScrKey)
-	if err != nil {
-		return nil, err
-	}
-
-	hashStr := bcName + account + need + isLock
-	doubleHash := cryptoHash.DoubleSha256([]byte(hashStr))
-	signResult, err := cryptoClient.SignECDSA(privateKey, doubleHash)
-	if err != nil {
-		return nil, err
-	}
-	return signResult, nil
-}
-
 // AddCommand add sub cmd
 func AddCommand(cmd CommandFunc) {
 	Commands = append(Commands, cmd)
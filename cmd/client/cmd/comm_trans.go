@@ -18,13 +18,11 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
-	"google.golang.org/grpc"
 
 	"github.com/xuperchain/xuperchain/service/common"
 	"github.com/xuperchain/xuperchain/service/pb"
 	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo"
 	"github.com/xuperchain/xupercore/kernel/contract"
-	crypto_client "github.com/xuperchain/xupercore/lib/crypto/client"
 	"github.com/xuperchain/xupercore/lib/utils"
 )
 
@@ -61,6 +59,18 @@ type CommTrans struct {
 
 	// DebugTx if enabled, tx will be printed instead of being posted
 	DebugTx bool
+
+	// 交易提交后等待的确认数，为0时不等待
+	Wait        int64
+	WaitTimeout time.Duration
+	EventClient pb.EventServiceClient
+
+	// 选中的背书节点，同一笔交易预执行和签名时使用相同的节点
+	endorsers []*endorser
+	// 签名失败的背书节点host，重新选择时排除
+	failedEndorsers map[string]bool
+	// 已支付服务费的背书节点host及其服务费交易，重新选择后复用
+	paidEndorsers map[string]*pb.Transaction
 }
 
 // GenerateTx generate raw tx
@@ -136,9 +146,12 @@ func (c *CommTrans) GenPreExeRes(ctx context.Context) (
 			return nil, nil, fmt.Errorf("Get auth require error: %s", err.Error())
 		}
 	}
+	if err := c.resolveAutoFee(ctx, preExeRPCReq); err != nil {
+		return nil, nil, err
+	}
 	preExeRPCRes, err := c.XchainClient.PreExec(ctx, preExeRPCReq)
 	if err != nil {
-		return nil, nil, fmt.Errorf("PreExe contract response : %v, logid:%s", err, preExeRPCReq.Header.Logid)
+		return nil, nil, fmt.Errorf("PreExe contract response : %w", err)
 	}
 	for _, res := range preExeRPCRes.Response.Responses {
 		if res.Status >= contract.StatusErrorThreshold {
@@ -348,8 +361,8 @@ func (c *CommTrans) GenTxInputs(ctx context.Context, totalNeed *big.Int) (
 	if err != nil {
 		return nil, nil, fmt.Errorf("%v, details:%v", ErrSelectUtxo, err)
 	}
-	if utxoOutputs.Header.Error != pb.XChainErrorEnum_SUCCESS {
-		return nil, nil, fmt.Errorf("%v, details:%v", ErrSelectUtxo, utxoOutputs.Header.Error)
+	if err := headerError(utxoOutputs.Header); err != nil {
+		return nil, nil, fmt.Errorf("%v, details:%w", ErrSelectUtxo, err)
 	}
 
 	// 组装txInputs
@@ -387,11 +400,19 @@ func (c *CommTrans) Transfer(ctx context.Context) error {
 	var err error
 	tx := &pb.Transaction{}
 	if c.RootOptions.ComplianceCheck.IsNeedComplianceCheck == true {
-		preSelectUTXORes, err := c.GenPreExeWithSelectUtxoRes(ctx)
-		if err != nil {
-			return err
+		// 背书节点签名失败时换用其他节点，AuthRequire变化后需要重新生成交易，
+		// 已支付服务费的节点复用原服务费交易，只向新选中的节点收费
+		for {
+			preSelectUTXORes, err := c.GenPreExeWithSelectUtxoRes(ctx)
+			if err != nil {
+				return err
+			}
+			err = c.GenCompleteTxAndPost(ctx, preSelectUTXORes)
+			if err == nil || !c.replaceEndorsers(err) {
+				return err
+			}
+			fmt.Printf("%v, retry with other endorsers\n", err)
 		}
-		return c.GenCompleteTxAndPost(ctx, preSelectUTXORes)
 	} else {
 		tx, err = c.GenerateTx(ctx)
 		if err != nil {
@@ -399,10 +420,7 @@ func (c *CommTrans) Transfer(ctx context.Context) error {
 		}
 
 		if c.DebugTx {
-			ttx := FromPBTx(tx)
-			out, _ := json.MarshalIndent(ttx, "", "  ")
-			fmt.Println(string(out))
-			return nil
+			return printOutput(os.Stdout, c.RootOptions.Output, FromPBTx(tx))
 		}
 
 		return c.SendTx(ctx, tx)
@@ -442,24 +460,31 @@ func (c *CommTrans) SendTx(ctx context.Context, tx *pb.Transaction) error {
 	}
 	fmt.Printf("Tx id: %s\n", txid)
 
-	return nil
+	return c.waitTx(ctx, tx.Txid)
 }
 
-func (c *CommTrans) genInitSign(tx *pb.Transaction) ([]*pb.SignatureInfo, error) {
-	fromPubkey, err := readPublicKey(c.Keys)
-	if err != nil {
-		return nil, err
+// waitTx 按--wait等待交易确认
+func (c *CommTrans) waitTx(ctx context.Context, txid []byte) error {
+	if c.Wait <= 0 {
+		return nil
+	}
+	status, err := waitTxConfirmed(ctx, c.XchainClient, c.EventClient, c.ChainName, txid, c.Wait, c.WaitTimeout)
+	if status != nil {
+		printTxStatus(status)
 	}
+	return err
+}
 
-	cryptoClient, err := crypto_client.CreateCryptoClient(c.CryptoType)
+func (c *CommTrans) genInitSign(tx *pb.Transaction) ([]*pb.SignatureInfo, error) {
+	signer, err := accountSigner(c.Keys, c.CryptoType)
 	if err != nil {
-		return nil, errors.New("Create crypto client error")
+		return nil, err
 	}
-	fromScrkey, err := readPrivateKey(c.Keys)
+	fromPubkey, err := signer.PublicKey()
 	if err != nil {
 		return nil, err
 	}
-	signTx, err := common.ComputeTxSign(cryptoClient, tx, []byte(fromScrkey))
+	signTx, err := signer.SignTx(tx)
 	if err != nil {
 		return nil, err
 	}
@@ -476,23 +501,17 @@ func (c *CommTrans) genInitSign(tx *pb.Transaction) ([]*pb.SignatureInfo, error)
 }
 
 func (c *CommTrans) genAuthRequireSignsFromPath(tx *pb.Transaction, path string) ([]*pb.SignatureInfo, error) {
-	cryptoClient, err := crypto_client.CreateCryptoClient(c.CryptoType)
-	if err != nil {
-		return nil, errors.New("Create crypto client error")
-	}
-
 	authRequireSigns := []*pb.SignatureInfo{}
 	if path == "" {
-		initPubkey, err := readPublicKey(c.Keys)
+		signer, err := accountSigner(c.Keys, c.CryptoType)
 		if err != nil {
 			return nil, err
 		}
-
-		initScrkey, err := readPrivateKey(c.Keys)
+		initPubkey, err := signer.PublicKey()
 		if err != nil {
 			return nil, err
 		}
-		signTx, err := common.ComputeTxSign(cryptoClient, tx, []byte(initScrkey))
+		signTx, err := signer.SignTx(tx)
 		if err != nil {
 			return nil, err
 		}
@@ -509,15 +528,15 @@ func (c *CommTrans) genAuthRequireSignsFromPath(tx *pb.Transaction, path string)
 	}
 	for _, fi := range dir {
 		if fi.IsDir() {
-			sk, err := readPrivateKey(path + "/" + fi.Name())
+			signer, err := accountSigner(path+"/"+fi.Name(), c.CryptoType)
 			if err != nil {
 				return nil, err
 			}
-			pk, err := readPublicKey(path + "/" + fi.Name())
+			pk, err := signer.PublicKey()
 			if err != nil {
 				return nil, err
 			}
-			signTx, err := common.ComputeTxSign(cryptoClient, tx, []byte(sk))
+			signTx, err := signer.SignTx(tx)
 			if err != nil {
 				return nil, err
 			}
@@ -546,8 +565,8 @@ func (c *CommTrans) postTx(ctx context.Context, tx *pb.Transaction) (string, err
 	if err != nil {
 		return "", err
 	}
-	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
-		return "", fmt.Errorf("Failed to post tx:%s, logid:%s", reply.Header.Error.String(), reply.Header.Logid)
+	if err := headerError(reply.Header); err != nil {
+		return "", fmt.Errorf("Failed to post tx:%w", err)
 	}
 
 	return hex.EncodeToString(txStatus.Txid), nil
@@ -664,8 +683,8 @@ func (c *CommTrans) GenTxInputsWithMergeUTXO(ctx context.Context) ([]*pb.TxInput
 	if err != nil {
 		return nil, nil, fmt.Errorf("%v, details:%v", ErrSelectUtxo, err)
 	}
-	if utxoOutputs.Header.Error != pb.XChainErrorEnum_SUCCESS {
-		return nil, nil, fmt.Errorf("%v, details:%v", ErrSelectUtxo, utxoOutputs.Header.Error)
+	if err := headerError(utxoOutputs.Header); err != nil {
+		return nil, nil, fmt.Errorf("%v, details:%w", ErrSelectUtxo, err)
 	}
 
 	// 组装txInputs
@@ -752,7 +771,17 @@ func (c *CommTrans) GenPreExeWithSelectUtxoRes(ctx context.Context) (
 			return nil, fmt.Errorf("Get auth require error: %s", err.Error())
 		}
 	}
-	extraAmount := int64(c.RootOptions.ComplianceCheck.ComplianceCheckEndorseServiceFee)
+	if err := c.resolveAutoFee(ctx, preExeRPCReq); err != nil {
+		return nil, err
+	}
+	endorserAuthRequire, err := c.endorserAuthRequire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	preExeRPCReq.AuthRequire = append(preExeRPCReq.AuthRequire, endorserAuthRequire...)
+	// 选中的未支付过的背书节点各自收取服务费
+	_, endorserFee := c.endorserFees()
+	extraAmount := endorserFee.Int64()
 	if c.Fee != "" && c.Fee != "0" {
 		fee, err := strconv.ParseInt(c.Fee, 10, 64)
 		if err != nil {
@@ -760,7 +789,6 @@ func (c *CommTrans) GenPreExeWithSelectUtxoRes(ctx context.Context) (
 		}
 		extraAmount += fee
 	}
-	preExeRPCReq.AuthRequire = append(preExeRPCReq.AuthRequire, c.RootOptions.ComplianceCheck.ComplianceCheckEndorseServiceAddr)
 	preSelUTXOReq := &pb.PreExecWithSelectUTXORequest{
 		Bcname:      c.ChainName,
 		Address:     initiator,
@@ -794,23 +822,28 @@ func (c *CommTrans) GenPreExeWithSelectUtxoRes(ctx context.Context) (
 }
 
 func (c *CommTrans) GenCompleteTxAndPost(ctx context.Context, preExeResp *pb.PreExecWithSelectUTXOResponse) error {
-	complianceCheckTx, err := c.GenComplianceCheckTx(preExeResp.GetUtxoOutput())
-	if err != nil {
-		fmt.Printf("GenCompleteTxAndPost GenComplianceCheckTx failed, err: %v", err)
-		return err
+	// 真实交易会花费服务费交易的找零，以此与服务费交易绑定；
+	// 重试时已支付的节点复用原服务费交易，只为新选中的节点生成服务费交易
+	if c.unpaidEndorsers() {
+		complianceCheckTx, err := c.GenComplianceCheckTx(preExeResp.GetUtxoOutput(), nil)
+		if err != nil {
+			fmt.Printf("GenCompleteTxAndPost GenComplianceCheckTx failed, err: %v", err)
+			return err
+		}
+		fmt.Printf("ComplianceCheck txid: %v\n", hex.EncodeToString(complianceCheckTx.Txid))
+		c.payEndorsers(complianceCheckTx)
 	}
-	fmt.Printf("ComplianceCheck txid: %v\n", hex.EncodeToString(complianceCheckTx.Txid))
 
-	tx, err := c.GenRealTx(preExeResp, complianceCheckTx)
+	tx, err := c.GenRealTx(preExeResp, c.endorserFeeTxs())
 	if err != nil {
 		fmt.Printf("GenRealTx failed, err: %v", err)
 		return err
 	}
-	endorserSign, err := c.ComplianceCheck(tx, complianceCheckTx)
+	endorserSigns, err := c.ComplianceCheck(tx)
 	if err != nil {
 		return err
 	}
-	tx.AuthRequireSigns = append(tx.AuthRequireSigns, endorserSign)
+	tx.AuthRequireSigns = append(tx.AuthRequireSigns, endorserSigns...)
 	tx.Txid, _ = common.MakeTxId(tx)
 
 	txid, err := c.postTx(ctx, tx)
@@ -819,28 +852,31 @@ func (c *CommTrans) GenCompleteTxAndPost(ctx context.Context, preExeResp *pb.Pre
 	}
 	fmt.Printf("Tx id: %s\n", txid)
 
-	return nil
+	return c.waitTx(ctx, tx.Txid)
 }
 
+// GenRealTx 生成被背书交易，花费各服务费交易中发起者的找零
 func (c *CommTrans) GenRealTx(response *pb.PreExecWithSelectUTXOResponse,
-	complianceCheckTx *pb.Transaction) (*pb.Transaction, error) {
+	feeTxs []*pb.Transaction) (*pb.Transaction, error) {
 	utxolist := []*pb.Utxo{}
 	totalSelected := big.NewInt(0)
 	initiator, err := c.genInitiator()
 	if err != nil {
 		return nil, err
 	}
-	for index, txOutput := range complianceCheckTx.TxOutputs {
-		if string(txOutput.ToAddr) == initiator {
-			utxo := &pb.Utxo{
-				Amount:    txOutput.Amount,
-				ToAddr:    txOutput.ToAddr,
-				RefTxid:   complianceCheckTx.Txid,
-				RefOffset: int32(index),
+	for _, feeTx := range feeTxs {
+		for index, txOutput := range feeTx.TxOutputs {
+			if string(txOutput.ToAddr) == initiator {
+				utxo := &pb.Utxo{
+					Amount:    txOutput.Amount,
+					ToAddr:    txOutput.ToAddr,
+					RefTxid:   feeTx.Txid,
+					RefOffset: int32(index),
+				}
+				utxolist = append(utxolist, utxo)
+				utxoAmount := big.NewInt(0).SetBytes(utxo.Amount)
+				totalSelected.Add(totalSelected, utxoAmount)
 			}
-			utxolist = append(utxolist, utxo)
-			utxoAmount := big.NewInt(0).SetBytes(utxo.Amount)
-			totalSelected.Add(totalSelected, utxoAmount)
 		}
 	}
 	utxoOutput := &pb.UtxoOutput{
@@ -909,21 +945,22 @@ func (c *CommTrans) GenRealTx(response *pb.PreExecWithSelectUTXOResponse,
 		authRequire = fromAddr
 	}
 	tx.AuthRequire = append(tx.AuthRequire, authRequire)
-	tx.AuthRequire = append(tx.AuthRequire, c.RootOptions.ComplianceCheck.ComplianceCheckEndorseServiceAddr)
-
-	cryptoClient, err := crypto_client.CreateCryptoClient(c.CryptoType)
+	// 背书节点已在预执行时选定
+	endorserAuthRequire, err := c.endorserAuthRequire(context.Background())
 	if err != nil {
-		return nil, errors.New("Create crypto client error")
+		return nil, err
 	}
-	fromPubkey, err := readPublicKey(c.Keys)
+	tx.AuthRequire = append(tx.AuthRequire, endorserAuthRequire...)
+
+	signer, err := accountSigner(c.Keys, c.CryptoType)
 	if err != nil {
 		return nil, err
 	}
-	fromScrkey, err := readPrivateKey(c.Keys)
+	fromPubkey, err := signer.PublicKey()
 	if err != nil {
 		return nil, err
 	}
-	signTx, err := common.ComputeTxSign(cryptoClient, tx, []byte(fromScrkey))
+	signTx, err := signer.SignTx(tx)
 	if err != nil {
 		return nil, err
 	}
@@ -995,65 +1032,29 @@ func (c *CommTrans) GeneratePureTxInputs(utxoOutputs *pb.UtxoOutput) (
 	return txInputs, nil
 }
 
-func (c *CommTrans) ComplianceCheck(tx *pb.Transaction, fee *pb.Transaction) (
-	*pb.SignatureInfo, error) {
-	txStatus := &pb.TxStatus{
-		Bcname: c.ChainName,
-		Tx:     tx,
-	}
-
-	requestData, err := json.Marshal(txStatus)
-	if err != nil {
-		fmt.Printf("json encode txStatus failed: %v", err)
-		return nil, err
-	}
-
-	endorserRequest := &pb.EndorserRequest{
-		RequestName: "ComplianceCheck",
-		BcName:      c.ChainName,
-		Fee:         fee,
-		RequestData: requestData,
-	}
-
-	conn, err := grpc.Dial(c.RootOptions.EndorseServiceHost, grpc.WithInsecure(), grpc.WithMaxMsgSize(64<<20-1))
-	if err != nil {
-		fmt.Printf("ComplianceCheck connect EndorseServiceHost err: %v", err)
-		return nil, err
-	}
-	defer conn.Close()
-	ctx, cancel := context.WithTimeout(context.Background(), 15000*time.Millisecond)
-	defer cancel()
-
-	client := pb.NewXendorserClient(conn)
-	endorserResponse, err := client.EndorserCall(ctx, endorserRequest)
-	if err != nil {
-		fmt.Printf("EndorserCall failed and err is: %v", err)
-		return nil, fmt.Errorf("EndorserCall error! Response is: %v", err)
-	}
-
-	return endorserResponse.GetEndorserSign(), nil
+// ComplianceCheck 请求选中的背书节点签名，返回的签名与AuthRequire中的背书地址一一对应
+func (c *CommTrans) ComplianceCheck(tx *pb.Transaction) ([]*pb.SignatureInfo, error) {
+	return c.collectEndorsements(context.Background(), tx)
 }
 
-func (c *CommTrans) GenComplianceCheckTx(utxoOutput *pb.UtxoOutput) (*pb.Transaction, error) {
-	totalNeed := new(big.Int).SetInt64(int64(c.RootOptions.ComplianceCheck.ComplianceCheckEndorseServiceFee))
+// GenComplianceCheckTx 生成背书服务费交易，每个选中且未支付过的背书节点一个输出，见endorserFees；
+// desc用于绑定不花费服务费交易输出的被背书交易
+func (c *CommTrans) GenComplianceCheckTx(utxoOutput *pb.UtxoOutput, desc []byte) (*pb.Transaction, error) {
+	txOutputs, totalNeed := c.endorserFees()
 	txInputs, deltaTxOutput, err := c.GenerateTxInput(utxoOutput, totalNeed)
 	if err != nil {
 		fmt.Printf("GenerateComplianceTx GenerateTxInput failed.")
 		return nil, fmt.Errorf("GenerateComplianceTx GenerateTxInput err: %v", err)
 	}
-
-	checkAmount := strconv.Itoa(c.RootOptions.ComplianceCheck.ComplianceCheckEndorseServiceFee)
-	txOutputs, err := c.GenerateTxOutput(c.RootOptions.ComplianceCheck.ComplianceCheckEndorseFeeAddr, checkAmount, "0")
-	if err != nil {
-		fmt.Printf("GenerateComplianceTx GenerateTxOutput failed.")
-		return nil, fmt.Errorf("GenerateComplianceTx GenerateTxOutput err: %v", err)
-	}
 	if deltaTxOutput != nil {
 		txOutputs = append(txOutputs, deltaTxOutput)
 	}
 	// populates fields
+	if desc == nil {
+		desc = []byte("")
+	}
 	tx := &pb.Transaction{
-		Desc:      []byte(""),
+		Desc:      desc,
 		Version:   utxo.TxVersion,
 		Coinbase:  false,
 		Timestamp: time.Now().UnixNano(),
@@ -1067,19 +1068,15 @@ func (c *CommTrans) GenComplianceCheckTx(utxoOutput *pb.UtxoOutput) (*pb.Transac
 	}
 	tx.Initiator = initiator
 
-	cryptoClient, err := crypto_client.CreateCryptoClient(c.CryptoType)
-	if err != nil {
-		return nil, errors.New("Create crypto client error")
-	}
-	fromPubkey, err := readPublicKey(c.Keys)
+	signer, err := accountSigner(c.Keys, c.CryptoType)
 	if err != nil {
 		return nil, err
 	}
-	fromScrkey, err := readPrivateKey(c.Keys)
+	fromPubkey, err := signer.PublicKey()
 	if err != nil {
 		return nil, err
 	}
-	fromAddr, err := readAddress(c.Keys)
+	fromAddr, err := signer.Address()
 	if err != nil {
 		return nil, err
 	}
@@ -1092,7 +1089,7 @@ func (c *CommTrans) GenComplianceCheckTx(utxoOutput *pb.UtxoOutput) (*pb.Transac
 	}
 	tx.AuthRequire = append(tx.AuthRequire, authRequire)
 
-	signTx, err := common.ComputeTxSign(cryptoClient, tx, []byte(fromScrkey))
+	signTx, err := signer.SignTx(tx)
 	if err != nil {
 		return nil, err
 	}
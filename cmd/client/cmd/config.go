@@ -9,10 +9,32 @@ import (
 )
 
 // TLSOptions TLS part
+// Cert: directory of cert.crt(CA), key.pem and private.key(client cert for mTLS)
+// Server: server name in the node certificate
+// CA: custom CA file, default cert.crt in Cert, or system CAs if neither exists
+// InsecureSkipVerify: skip verifying node certificate, only for testing
 type TLSOptions struct {
-	Cert   string `yaml:"cert,omitempty"`
-	Server string `yaml:"server,omitempty"`
-	Enable bool   `yaml:"enable,omitempty"`
+	Cert               string `yaml:"cert,omitempty"`
+	Server             string `yaml:"server,omitempty"`
+	Enable             bool   `yaml:"enable,omitempty"`
+	CA                 string `yaml:"ca,omitempty"`
+	InsecureSkipVerify bool   `yaml:"insecureSkipVerify,omitempty"`
+}
+
+// SignerOptions 签名方式，--keys指定的账户使用该方式签名交易
+// Type: file(default) signs with private.key in keys directory,
+// keystore signs with an encrypted keystore json file,
+// remote signs by a signer service such as xchain-signer
+// Keystore: keystore json file of keystore signer
+// Host: ip:port or unix:path of remote signer
+// TLS: tls of remote signer, same as the node tls options
+// TimeoutMs: timeout of a single remote sign request
+type SignerOptions struct {
+	Type      string     `yaml:"type,omitempty"`
+	Keystore  string     `yaml:"keystore,omitempty"`
+	Host      string     `yaml:"host,omitempty"`
+	TLS       TLSOptions `yaml:"tls,omitempty"`
+	TimeoutMs int        `yaml:"timeoutMs,omitempty"`
 }
 
 // ComplianceCheckConfig: config of xendorser service control
@@ -20,12 +42,18 @@ type TLSOptions struct {
 // IsNeedComplianceCheckFee: is need pay for compliance check
 // ComplianceCheckEndorseServiceFee: fee for compliance check
 // ComplianceCheckEndorseServiceAddr: compliance check addr
+// Endorsers: endorsers for M-of-N endorsement, override endorseServiceHost and ComplianceCheckEndorseServiceAddr
+// EndorserQuorum: number of endorsers required to sign, 0 means all
+// EndorserTimeoutMs: timeout of a single endorser
 type ComplianceCheckConfig struct {
-	IsNeedComplianceCheck             bool   `yaml:"isNeedComplianceCheck,omitempty"`
-	IsNeedComplianceCheckFee          bool   `yaml:"isNeedComplianceCheckFee,omitempty"`
-	ComplianceCheckEndorseServiceFee  int    `yaml:"complianceCheckEndorseServiceFee,omitempty"`
-	ComplianceCheckEndorseFeeAddr     string `yaml:"complianceCheckEndorseFeeAddr,omitempty"`
-	ComplianceCheckEndorseServiceAddr string `yaml:"complianceCheckEndorseServiceAddr,omitempty"`
+	IsNeedComplianceCheck             bool             `yaml:"isNeedComplianceCheck,omitempty"`
+	IsNeedComplianceCheckFee          bool             `yaml:"isNeedComplianceCheckFee,omitempty"`
+	ComplianceCheckEndorseServiceFee  int              `yaml:"complianceCheckEndorseServiceFee,omitempty"`
+	ComplianceCheckEndorseFeeAddr     string           `yaml:"complianceCheckEndorseFeeAddr,omitempty"`
+	ComplianceCheckEndorseServiceAddr string           `yaml:"complianceCheckEndorseServiceAddr,omitempty"`
+	Endorsers                         []EndorserConfig `yaml:"endorsers,omitempty"`
+	EndorserQuorum                    int              `yaml:"endorserQuorum,omitempty"`
+	EndorserTimeoutMs                 int              `yaml:"endorserTimeoutMs,omitempty"`
 }
 
 // NewRootOptions new a RootOptions instance
@@ -79,6 +107,14 @@ func (nc *RootOptions) setDefaultConf() {
 		IsNeedComplianceCheckFee:          true,
 		ComplianceCheckEndorseServiceFee:  400,
 		ComplianceCheckEndorseServiceAddr: "jknGxa6eyum1JrATWvSJKW3thJ9GKHA9n",
+		EndorserTimeoutMs:                 defaultEndorserTimeoutMs,
 	}
 	nc.MinNewChainAmount = "100"
+	nc.FeeMargin = defaultFeeMargin
+	nc.HDPath = defaultHDPath
+	nc.Index = -1
+	nc.Signer = SignerOptions{
+		Type:      signerTypeFile,
+		TimeoutMs: defaultSignerTimeoutMs,
+	}
 }
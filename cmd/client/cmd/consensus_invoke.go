@@ -70,7 +70,7 @@ func (c *ConsensusInvokeCommand) addFlags() {
 	c.cmd.Flags().StringVarP(&c.bucket, "type", "t", "", "consensus bucket name")
 	c.cmd.Flags().StringVarP(&c.method, "method", "", "", "kernel method name")
 	c.cmd.Flags().StringVarP(&c.account, "account", "", "", "account name")
-	c.cmd.Flags().StringVar(&c.fee, "fee", "", "fee of one tx")
+	c.cmd.Flags().StringVar(&c.fee, "fee", "", "fee of one tx, auto to estimate by node")
 	c.cmd.Flags().BoolVarP(&c.isMulti, "isMulti", "", false, "multisig scene")
 	c.cmd.Flags().StringVarP(&c.multiAddrs, "multiAddrs", "A", "data/acl/addrs", "multiAddrs if multisig scene")
 	c.cmd.Flags().StringVarP(&c.output, "output", "o", "./tx.out", "tx draw data")
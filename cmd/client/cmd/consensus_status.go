@@ -2,8 +2,6 @@ package cmd
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 
 	"github.com/spf13/cobra"
 	"github.com/xuperchain/xuperchain/service/pb"
@@ -48,16 +46,11 @@ func (c *ConsensusStatusCommand) getStatus(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	status := &pb.ConsensusStatus{
+	status := &ConsensusStatus{
 		Version:        statusPb.Version,
 		ConsensusName:  statusPb.ConsensusName,
 		StartHeight:    statusPb.StartHeight,
 		ValidatorsInfo: statusPb.ValidatorsInfo,
 	}
-	resp, err := json.MarshalIndent(status, "", "  ")
-	if err != nil {
-		return err
-	}
-	fmt.Println(string(resp))
-	return nil
+	return c.cli.PrintOutput(status)
 }
@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/json"
 	"io/ioutil"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/spf13/cobra"
@@ -32,6 +33,9 @@ type ContractDeployCommand struct {
 	multiAddrs   string
 	output       string
 	abiFile      string
+
+	wait        int64
+	waitTimeout time.Duration
 }
 
 // NewContractDeployCommand new wasm/native/evm deploy cmd
@@ -57,10 +61,11 @@ func (c *ContractDeployCommand) addFlags() {
 	c.cmd.Flags().StringVarP(&c.contractName, "cname", "n", "", "contract name")
 	c.cmd.Flags().StringVarP(&c.account, "account", "", "", "account name")
 	c.cmd.Flags().StringVarP(&c.runtime, "runtime", "", "c", "if contract code use go lang, then go or if use c lang, then c")
-	c.cmd.Flags().StringVar(&c.fee, "fee", "", "fee of one tx")
+	c.cmd.Flags().StringVar(&c.fee, "fee", "", "fee of one tx, auto to estimate by node")
 	c.cmd.Flags().BoolVarP(&c.isMulti, "isMulti", "m", false, "multisig scene")
 	c.cmd.Flags().StringVarP(&c.multiAddrs, "multiAddrs", "A", "data/acl/addrs", "multiAddrs if multisig scene")
 	c.cmd.Flags().StringVarP(&c.output, "output", "o", "./tx.out", "tx draw data")
+	addWaitFlags(c.cmd.Flags(), &c.wait, &c.waitTimeout)
 	if c.module == string(bridge.TypeEvm) {
 		c.cmd.Flags().StringVarP(&c.abiFile, "abi", "", "", "the abi file of contract")
 	}
@@ -85,6 +90,9 @@ func (c *ContractDeployCommand) deploy(ctx context.Context, codepath string) err
 		XchainClient: c.cli.XchainClient(),
 		CryptoType:   c.cli.RootOptions.Crypto,
 		RootOptions:  c.cli.RootOptions,
+		Wait:         c.wait,
+		WaitTimeout:  c.waitTimeout,
+		EventClient:  c.cli.EventClient(),
 	}
 
 	var err error
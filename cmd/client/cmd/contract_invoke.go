@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -31,6 +32,9 @@ type ContractInvokeCommand struct {
 	amount     string
 	debug      bool
 	abiFile    string
+
+	wait        int64
+	waitTimeout time.Duration
 }
 
 // NewContractInvokeCommand new wasm/native/evm invoke cmd
@@ -55,13 +59,14 @@ func NewContractInvokeCommand(cli *Cli, module string) *cobra.Command {
 func (c *ContractInvokeCommand) addFlags() {
 	c.cmd.Flags().StringVarP(&c.args, "args", "a", "{}", "contract method args")
 	c.cmd.Flags().StringVarP(&c.account, "account", "", "", "account name")
-	c.cmd.Flags().StringVar(&c.fee, "fee", "", "fee of one tx")
+	c.cmd.Flags().StringVar(&c.fee, "fee", "", "fee of one tx, auto to estimate by node")
 	c.cmd.Flags().BoolVarP(&c.isMulti, "isMulti", "m", false, "multisig scene")
 	c.cmd.Flags().StringVarP(&c.multiAddrs, "multiAddrs", "A", "data/acl/addrs", "multiAddrs if multisig scene")
 	c.cmd.Flags().StringVarP(&c.output, "output", "o", "./tx.out", "tx draw data")
 	c.cmd.Flags().StringVarP(&c.methodName, "method", "", "invoke", "contract method name")
 	c.cmd.Flags().StringVarP(&c.amount, "amount", "", "", "the amount transfer to contract")
 	c.cmd.Flags().BoolVarP(&c.debug, "debug", "", false, "debug print tx instead of posting")
+	addWaitFlags(c.cmd.Flags(), &c.wait, &c.waitTimeout)
 	if c.module == string(bridge.TypeEvm) {
 		c.cmd.Flags().StringVarP(&c.abiFile, "abi", "", "", "the abi file of contract")
 	}
@@ -92,6 +97,9 @@ func (c *ContractInvokeCommand) invoke(ctx context.Context, codeName string) err
 		CryptoType:   c.cli.RootOptions.Crypto,
 		DebugTx:      c.debug,
 		RootOptions:  c.cli.RootOptions,
+		Wait:         c.wait,
+		WaitTimeout:  c.waitTimeout,
+		EventClient:  c.cli.EventClient(),
 	}
 	// transfer to contract
 	if c.amount != "" {
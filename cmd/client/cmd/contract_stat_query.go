@@ -2,9 +2,6 @@ package cmd
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
-	"fmt"
 
 	"github.com/spf13/cobra"
 
@@ -50,15 +47,13 @@ func (c *ContractStatDataQueryCommand) queryContractStatData(ctx context.Context
 		return err
 	}
 
-	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
-		return errors.New(reply.Header.Error.String())
-	}
-
-	output, err := json.MarshalIndent(reply, "", "  ")
-	if err != nil {
+	if err := headerError(reply.Header); err != nil {
 		return err
 	}
-	fmt.Println(string(output))
 
-	return nil
+	stat := &ContractStatData{
+		AccountCount:  reply.GetData().GetAccountCount(),
+		ContractCount: reply.GetData().GetContractCount(),
+	}
+	return c.cli.PrintOutput(stat)
 }
@@ -0,0 +1,219 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/xuperchain/xuperchain/service/common"
+	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xupercore/lib/utils"
+)
+
+// CrossQueryCommand cross chain query cmd
+type CrossQueryCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	bcname     string
+	module     string
+	methodName string
+	args       string
+	initiator  string
+	endorsers  []string
+	trusted    []string
+	quorum     int
+	timeoutMs  int
+}
+
+// NewCrossQueryCommand new cross chain query cmd
+func NewCrossQueryCommand(cli *Cli) *cobra.Command {
+	c := new(CrossQueryCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "crossquery [options] contract",
+		Short: "Query a contract through endorsers and verify their signatures like a cross chain query.",
+		Example: `
+xchain-cli crossquery counter --method get -a '{"key":"dev"}' --bcname xuper \
+    --endorsers 127.0.0.1:8848,127.0.0.1:8849 --trusted addr1,addr2 --quorum 2
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return c.query(ctx, args[0])
+		},
+	}
+	c.addFlags()
+	return c.cmd
+}
+
+func (c *CrossQueryCommand) addFlags() {
+	c.cmd.Flags().StringVar(&c.bcname, "bcname", "", "chain to query, default to --name")
+	c.cmd.Flags().StringVar(&c.module, "module", "wasm", "contract module: wasm, native or evm")
+	c.cmd.Flags().StringVar(&c.methodName, "method", "get", "contract method name")
+	c.cmd.Flags().StringVarP(&c.args, "args", "a", "{}", "query method args")
+	c.cmd.Flags().StringVar(&c.initiator, "initiator", "", "initiator of the query, default to the address in --keys")
+	c.cmd.Flags().StringSliceVar(&c.endorsers, "endorsers", nil,
+		"endorser hosts, default to complianceCheck.endorsers or endorseServiceHost in config")
+	c.cmd.Flags().StringSliceVar(&c.trusted, "trusted", nil,
+		"trusted endorser addresses, default to the addresses of complianceCheck.endorsers in config")
+	c.cmd.Flags().IntVar(&c.quorum, "quorum", 0, "identical responses required, 0 means all trusted endorsers")
+	c.cmd.Flags().IntVar(&c.timeoutMs, "timeout", defaultEndorserTimeoutMs, "timeout of a single endorser in milliseconds")
+}
+
+func (c *CrossQueryCommand) query(ctx context.Context, contract string) error {
+	if c.bcname == "" {
+		c.bcname = c.cli.RootOptions.Name
+	}
+	hosts, trusted := c.endorserConf()
+	if len(hosts) == 0 {
+		return errors.New("no endorser host")
+	}
+	if len(trusted) == 0 {
+		return errors.New("no trusted endorser address, please set --trusted")
+	}
+	quorum := c.quorum
+	if quorum == 0 {
+		quorum = len(trusted)
+	}
+
+	requestData, err := c.requestData(contract)
+	if err != nil {
+		return err
+	}
+
+	endorsements := c.fanout(ctx, hosts, requestData)
+	result, err := common.VerifyCrossQueryQuorum(requestData, endorsements, trusted, quorum)
+	if err != nil {
+		return err
+	}
+
+	if err := c.cli.PrintOutput(FromContractResponsePB(result.Response.GetResponse())); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Verified by %d of %d trusted endorsers: %v\n", len(result.Signers), len(trusted), result.Signers)
+	return nil
+}
+
+// endorserConf 命令行未指定时使用配置文件中的背书节点
+func (c *CrossQueryCommand) endorserConf() ([]string, []string) {
+	hosts, trusted := c.endorsers, c.trusted
+	cc := c.cli.RootOptions.ComplianceCheck
+	if len(hosts) == 0 {
+		if len(cc.Endorsers) == 0 && c.cli.RootOptions.EndorseServiceHost != "" {
+			hosts = append(hosts, c.cli.RootOptions.EndorseServiceHost)
+		}
+		for _, e := range cc.Endorsers {
+			hosts = append(hosts, e.Host)
+		}
+	}
+	if len(trusted) == 0 {
+		for _, e := range cc.Endorsers {
+			if e.Address != "" {
+				trusted = append(trusted, e.Address)
+			}
+		}
+	}
+	return hosts, trusted
+}
+
+func (c *CrossQueryCommand) requestData(contract string) ([]byte, error) {
+	args := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(c.args), &args); err != nil {
+		return nil, err
+	}
+	invokeArgs, err := convertToXuper3Args(args)
+	if err != nil {
+		return nil, err
+	}
+
+	initiator := c.initiator
+	if initiator == "" {
+		// 没有本地账户时以空发起者查询
+		initiator, _ = readAddress(c.cli.RootOptions.Keys)
+	}
+	req := &pb.CrossQueryRequest{
+		Bcname:    c.bcname,
+		Timestamp: time.Now().UnixNano(),
+		Initiator: initiator,
+		Request: &pb.InvokeRequest{
+			ModuleName:   c.module,
+			ContractName: contract,
+			MethodName:   c.methodName,
+			Args:         invokeArgs,
+		},
+	}
+	if initiator != "" {
+		req.AuthRequire = []string{initiator}
+	}
+	return json.Marshal(req)
+}
+
+// fanout 并发向所有背书节点发起跨链查询，失败的节点不影响其他节点
+func (c *CrossQueryCommand) fanout(ctx context.Context, hosts []string, requestData []byte) []*common.CrossQueryEndorsement {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(c.timeoutMs)*time.Millisecond)
+	defer cancel()
+
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	var endorsements []*common.CrossQueryEndorsement
+	for _, host := range hosts {
+		wg.Add(1)
+		go func(host string) {
+			defer wg.Done()
+			e, err := c.crossQuery(ctx, host, requestData)
+			if err != nil {
+				fmt.Printf("Endorser %s failed: %v\n", host, err)
+				return
+			}
+			mutex.Lock()
+			endorsements = append(endorsements, e)
+			mutex.Unlock()
+		}(host)
+	}
+	wg.Wait()
+	return endorsements
+}
+
+func (c *CrossQueryCommand) crossQuery(ctx context.Context, host string,
+	requestData []byte) (*common.CrossQueryEndorsement, error) {
+	conn, err := dialNode(ctx, host, c.cli.RootOptions.TLS)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	req := &pb.EndorserRequest{
+		Header:      &pb.Header{Logid: utils.GenLogId()},
+		RequestName: "CrossQueryPreExec",
+		BcName:      c.bcname,
+		RequestData: requestData,
+	}
+	res, err := pb.NewXendorserClient(conn).EndorserCall(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := headerError(res.GetHeader()); err != nil {
+		return nil, fmt.Errorf("endorser refused: %w", err)
+	}
+	return &common.CrossQueryEndorsement{
+		Host:            host,
+		EndorserAddress: res.GetEndorserAddress(),
+		ResponseData:    res.GetResponseData(),
+		Sign:            res.GetEndorserSign(),
+	}, nil
+}
+
+func init() {
+	AddCommand(NewCrossQueryCommand)
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/xuperchain/crypto/core/account"
+	"github.com/xuperchain/crypto/core/config"
+	gmAccount "github.com/xuperchain/crypto/gm/account"
+	crypto_client "github.com/xuperchain/xupercore/lib/crypto/client"
+	crypto_base "github.com/xuperchain/xupercore/lib/crypto/client/base"
+
+	"github.com/xuperchain/xuperchain/service/common"
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+// json格式公私钥中的曲线名对应的密码学插件
+var curveCryptoTypes = map[string]string{
+	"P-256":     crypto_client.CryptoTypeDefault,
+	"SM2-P-256": crypto_client.CryptoTypeGM,
+	"P-256-SN":  crypto_client.CryptoTypeSchnorr,
+}
+
+// keyCryptoType json格式公私钥对应的密码学插件，无法识别时返回空
+func keyCryptoType(jsonKey string) string {
+	var key struct {
+		Curvname string
+	}
+	if err := json.Unmarshal([]byte(jsonKey), &key); err != nil {
+		return ""
+	}
+	return curveCryptoTypes[key.Curvname]
+}
+
+// mnemonicCryptoType 助记词中记录的密码学插件
+// 国密助记词使用SM3计算校验位，需要分别按两种插件解析
+func mnemonicCryptoType(mnemonic string, lang int) (string, error) {
+	crypto, err := account.GetCryptoByteFromMnemonic(mnemonic, lang)
+	if err == nil && crypto == config.Nist {
+		return crypto_client.CryptoTypeDefault, nil
+	}
+	if gmCrypto, gmErr := gmAccount.GetCryptoByteFromMnemonic(mnemonic, lang); gmErr == nil && gmCrypto == config.Gm {
+		return crypto_client.CryptoTypeGM, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("invalid mnemonic.err:%v", err)
+	}
+	return "", fmt.Errorf("unsupported cryptography %d in mnemonic", crypto)
+}
+
+// newCryptoClient 按密钥的曲线选择密码学插件，gm密钥不需要再指定--crypto gm
+// 无法从密钥识别时使用cryptoType
+func newCryptoClient(cryptoType, jsonKey string) (crypto_base.CryptoClient, error) {
+	if keyType := keyCryptoType(jsonKey); keyType != "" {
+		cryptoType = keyType
+	}
+	cryptoClient, err := crypto_client.CreateCryptoClient(cryptoType)
+	if err != nil {
+		return nil, fmt.Errorf("create crypto client %s failed.err:%v", cryptoType, err)
+	}
+	return cryptoClient, nil
+}
+
+// computeTxSign 使用私钥对应的密码学插件签名交易，多个签名密钥可以使用不同的插件
+func computeTxSign(cryptoClient crypto_base.CryptoClient, tx *pb.Transaction, jsonSk string) ([]byte, error) {
+	if keyType := keyCryptoType(jsonSk); keyType != "" {
+		var err error
+		if cryptoClient, err = crypto_client.CreateCryptoClient(keyType); err != nil {
+			return nil, fmt.Errorf("create crypto client %s failed.err:%v", keyType, err)
+		}
+	}
+	return common.ComputeTxSign(cryptoClient, tx, []byte(jsonSk))
+}
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/xuperchain/xuperchain/service/common"
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+// 两种密码学插件都要支持创建、恢复、加解密和签名验签
+var testCryptoTypes = []string{"default", "gm"}
+
+func newTestCli(cryptoType, keys string) *Cli {
+	cli := NewCli()
+	cli.RootOptions.Crypto = cryptoType
+	cli.RootOptions.Keys = keys
+	return cli
+}
+
+func readTestFile(t *testing.T, dir, name string) string {
+	buf, err := ioutil.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.TrimSpace(string(buf))
+}
+
+func TestCryptoAccountLifecycle(t *testing.T) {
+	os.Setenv(keyPasswordEnv, "test-password")
+	defer os.Unsetenv(keyPasswordEnv)
+
+	for _, cryptoType := range testCryptoTypes {
+		workspace, err := ioutil.TempDir("", "xchain-cli-crypto")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(workspace)
+		keys := filepath.Join(workspace, "keys")
+
+		// newkeys
+		newkeys := NewAccountNewkeysCommand(newTestCli(cryptoType, keys))
+		newkeys.SetArgs([]string{"-o", keys, "--strength", "1", "--lang", "en"})
+		if err := newkeys.Execute(); err != nil {
+			t.Fatalf("%s newkeys failed: %v", cryptoType, err)
+		}
+		address := readTestFile(t, keys, "address")
+		privateKey := readTestFile(t, keys, "private.key")
+		if keyCryptoType(privateKey) != cryptoType {
+			t.Fatalf("%s newkeys created key of %s", cryptoType, keyCryptoType(privateKey))
+		}
+
+		// restore，不指定--crypto时按助记词识别
+		restored := filepath.Join(workspace, "restored")
+		restore := NewAccountRestoreCommand(newTestCli("default", keys))
+		restore.SetArgs([]string{"-o", restored, "--lang", "en", "-m", readTestFile(t, keys, "mnemonic")})
+		if err := restore.Execute(); err != nil {
+			t.Fatalf("%s restore failed: %v", cryptoType, err)
+		}
+		if got := readTestFile(t, restored, "address"); got != address {
+			t.Fatalf("%s restore address %s, expect %s", cryptoType, got, address)
+		}
+
+		// encrypt and decrypt
+		encrypt := NewAccountEncryptCommand(newTestCli(cryptoType, keys))
+		encrypt.SetArgs([]string{})
+		if err := encrypt.Execute(); err != nil {
+			t.Fatalf("%s encrypt failed: %v", cryptoType, err)
+		}
+		if !isEncryptedKey(readTestFile(t, keys, "private.key")) {
+			t.Fatalf("%s private key is not encrypted", cryptoType)
+		}
+		decrypted := filepath.Join(workspace, "decrypted")
+		decrypt := NewAccountDecryptCommand(newTestCli("default", keys))
+		decrypt.SetArgs([]string{"-o", decrypted, "--key", filepath.Join(keys, "private.key")})
+		if err := decrypt.Execute(); err != nil {
+			t.Fatalf("%s decrypt failed: %v", cryptoType, err)
+		}
+		if got := readTestFile(t, decrypted, "address"); got != address {
+			t.Fatalf("%s decrypt address %s, expect %s", cryptoType, got, address)
+		}
+		if got := readTestFile(t, decrypted, "private.key"); got != privateKey {
+			t.Fatalf("%s decrypt private key mismatch", cryptoType)
+		}
+
+		// 加密的私钥在内存中解密后签名
+		pk := readTestFile(t, keys, "public.key")
+		testSignAndVerify(t, cryptoType, NewFileSigner(keys, "default"), pk, address)
+	}
+}
+
+func testSignAndVerify(t *testing.T, cryptoType string, signer Signer, pk, address string) {
+	msg := []byte("hello xuperchain")
+	if _, ok := signer.(*remoteSigner); ok {
+		// 签名服务不对任意消息签名
+		if _, _, err := signMessage(signer, "default", msg); err == nil {
+			t.Fatalf("%s sign message by remote signer should fail", cryptoType)
+		}
+	} else {
+		testSignMessage(t, cryptoType, signer, pk, address, msg)
+	}
+
+	// 交易签名和锁定utxo签名
+	cryptoClient, err := newCryptoClient("default", pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey, err := cryptoClient.GetEcdsaPublicKeyFromJsonStr(pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := &pb.Transaction{Desc: []byte("test"), Initiator: address, Nonce: "1"}
+	txSign, err := signer.SignTx(tx)
+	if err != nil {
+		t.Fatalf("%s sign tx failed: %v", cryptoType, err)
+	}
+	digest, err := common.MakeTxDigestHash(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if valid, err := cryptoClient.VerifyECDSA(publicKey, txSign, digest); err != nil || !valid {
+		t.Fatalf("%s verify tx sign failed: %v", cryptoType, err)
+	}
+	utxoSign, err := signer.SignSelectUtxo("xuper", address, "100")
+	if err != nil {
+		t.Fatalf("%s sign select utxo failed: %v", cryptoType, err)
+	}
+	if valid, err := cryptoClient.VerifyECDSA(publicKey, utxoSign, selectUtxoDigest("xuper", address, "100")); err != nil || !valid {
+		t.Fatalf("%s verify select utxo sign failed: %v", cryptoType, err)
+	}
+}
+
+func testSignMessage(t *testing.T, cryptoType string, signer Signer, pk, address string, msg []byte) {
+	sig, signType, err := signMessage(signer, "default", msg)
+	if err != nil {
+		t.Fatalf("%s sign failed: %v", cryptoType, err)
+	}
+	if signType != cryptoType {
+		t.Fatalf("sign with %s, expect %s", signType, cryptoType)
+	}
+	result, err := verifyMessage("default", pk, address, msg, sig)
+	if err != nil || !result.Valid {
+		t.Fatalf("%s verify failed: %v", cryptoType, err)
+	}
+	if result, err := verifyMessage("default", pk, address, []byte("tampered"), sig); err != nil || result.Valid {
+		t.Fatalf("%s verify tampered message should fail", cryptoType)
+	}
+	if _, err := verifyMessage("default", pk, "invalidaddress", msg, sig); err == nil {
+		t.Fatalf("%s verify with wrong address should fail", cryptoType)
+	}
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// 客户端可接收的最大消息
+const maxRecvMsgSize = 64<<20 - 1
+
+// dialNode 连接节点或背书服务，所有客户端连接都通过这里按TLS配置建立
+func dialNode(ctx context.Context, host string, opt TLSOptions) (*grpc.ClientConn, error) {
+	options := []grpc.DialOption{
+		grpc.WithMaxMsgSize(maxRecvMsgSize),
+		grpc.WithUnaryInterceptor(errorInterceptor),
+	}
+	if opt.Enable {
+		creds, err := genCreds(opt)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, grpc.WithTransportCredentials(creds))
+	} else {
+		options = append(options, grpc.WithInsecure())
+	}
+	return grpc.DialContext(ctx, host, options...)
+}
+
+// genCreds 生成客户端TLS凭证：
+// CA为空时使用Cert目录下的cert.crt，也不存在时使用系统CA；
+// Cert目录下存在key.pem和private.key时使用双向认证，否则只校验服务端
+func genCreds(opt TLSOptions) (credentials.TransportCredentials, error) {
+	conf := &tls.Config{
+		ServerName:         opt.Server,
+		InsecureSkipVerify: opt.InsecureSkipVerify,
+	}
+
+	caFile := opt.CA
+	if caFile == "" && opt.Cert != "" && fileExists(filepath.Join(opt.Cert, "cert.crt")) {
+		caFile = filepath.Join(opt.Cert, "cert.crt")
+	}
+	if caFile != "" {
+		bs, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("read ca cert failed.err:%v", err)
+		}
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(bs) {
+			return nil, fmt.Errorf("no valid certificate in %s", caFile)
+		}
+		conf.RootCAs = certPool
+	}
+
+	certFile := filepath.Join(opt.Cert, "key.pem")
+	keyFile := filepath.Join(opt.Cert, "private.key")
+	if opt.Cert != "" && (fileExists(certFile) || fileExists(keyFile)) {
+		certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load client cert failed.err:%v", err)
+		}
+		conf.Certificates = []tls.Certificate{certificate}
+	}
+	return credentials.NewTLS(conf), nil
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
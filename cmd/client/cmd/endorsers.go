@@ -0,0 +1,377 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xupercore/lib/utils"
+)
+
+// defaultEndorserTimeoutMs 单个背书节点的默认超时时间
+const defaultEndorserTimeoutMs = 15000
+
+// EndorserConfig 背书节点配置
+type EndorserConfig struct {
+	// 背书服务地址
+	Host string `yaml:"host,omitempty"`
+	// 背书签名地址，为空时通过GetEndorserInfo获取
+	Address string `yaml:"address,omitempty"`
+	// 背书服务费收费地址，为空时使用complianceCheckEndorseFeeAddr
+	FeeAddr string `yaml:"feeAddr,omitempty"`
+	// 背书服务费，为0时使用complianceCheckEndorseServiceFee
+	Fee int `yaml:"fee,omitempty"`
+}
+
+// endorser 选中参与背书的节点
+type endorser struct {
+	host    string
+	address string
+	feeAddr string
+	fee     int
+	// 向该节点支付服务费的交易，重新背书时复用，不重复支付
+	feeTx *pb.Transaction
+}
+
+// endorserResult 单个背书节点的返回
+type endorserResult struct {
+	host     string
+	endorser *endorser
+	sign     *pb.SignatureInfo
+	err      error
+}
+
+// endorseError 部分背书节点签名失败，failed为失败的节点
+type endorseError struct {
+	total  int
+	failed []*endorser
+	msgs   []string
+}
+
+func (e *endorseError) Error() string {
+	return fmt.Sprintf("%d of %d endorsers failed: %s", len(e.failed), e.total, strings.Join(e.msgs, "; "))
+}
+
+// endorserList 配置的背书节点，未配置endorsers时使用endorseServiceHost
+func (c *CommTrans) endorserList() []EndorserConfig {
+	cc := c.RootOptions.ComplianceCheck
+	if len(cc.Endorsers) > 0 {
+		return cc.Endorsers
+	}
+	return []EndorserConfig{{
+		Host:    c.RootOptions.EndorseServiceHost,
+		Address: cc.ComplianceCheckEndorseServiceAddr,
+	}}
+}
+
+// newEndorser 未配置服务费的节点使用complianceCheck中的收费地址和金额
+func (c *CommTrans) newEndorser(conf EndorserConfig, address string) *endorser {
+	cc := c.RootOptions.ComplianceCheck
+	e := &endorser{
+		host:    conf.Host,
+		address: address,
+		feeAddr: conf.FeeAddr,
+		fee:     conf.Fee,
+		feeTx:   c.paidEndorsers[conf.Host],
+	}
+	if e.feeAddr == "" {
+		e.feeAddr = cc.ComplianceCheckEndorseFeeAddr
+	}
+	if e.fee == 0 {
+		e.fee = cc.ComplianceCheckEndorseServiceFee
+	}
+	return e
+}
+
+func (c *CommTrans) endorserTimeout() time.Duration {
+	timeoutMs := c.RootOptions.ComplianceCheck.EndorserTimeoutMs
+	if timeoutMs <= 0 {
+		timeoutMs = defaultEndorserTimeoutMs
+	}
+	return time.Duration(timeoutMs) * time.Millisecond
+}
+
+// endorserQuorum 需要的背书签名数，0表示全部背书节点
+func (c *CommTrans) endorserQuorum(total int) (int, error) {
+	quorum := c.RootOptions.ComplianceCheck.EndorserQuorum
+	if quorum == 0 {
+		quorum = total
+	}
+	if quorum < 0 || quorum > total {
+		return 0, fmt.Errorf("invalid endorserQuorum %d, %d endorsers configured", quorum, total)
+	}
+	return quorum, nil
+}
+
+// selectEndorsers 向未失败过的背书节点查询背书地址，选出M个节点，
+// 优先选择已支付过服务费的节点，其余按响应先后选择；
+// 被选中节点的地址会写入交易的AuthRequire，更换节点时需要重新生成交易
+func (c *CommTrans) selectEndorsers(ctx context.Context) ([]*endorser, error) {
+	if c.endorsers != nil {
+		return c.endorsers, nil
+	}
+
+	all := c.endorserList()
+	quorum, err := c.endorserQuorum(len(all))
+	if err != nil {
+		return nil, err
+	}
+	list := make([]EndorserConfig, 0, len(all))
+	// 尚未响应的已支付节点数
+	pending := 0
+	for _, conf := range all {
+		if !c.failedEndorsers[conf.Host] {
+			list = append(list, conf)
+			if c.paidEndorsers[conf.Host] != nil {
+				pending++
+			}
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.endorserTimeout())
+	defer cancel()
+	results := make(chan *endorserResult, len(list))
+	for _, conf := range list {
+		go func(conf EndorserConfig) {
+			e, err := c.endorserInfo(ctx, conf)
+			results <- &endorserResult{host: conf.Host, endorser: e, err: err}
+		}(conf)
+	}
+
+	var paid, unpaid []*endorser
+	var failed []string
+	for range list {
+		res := <-results
+		if res.err != nil {
+			if c.paidEndorsers[res.host] != nil {
+				pending--
+			}
+			failed = append(failed, res.err.Error())
+			continue
+		}
+		if res.endorser.feeTx != nil {
+			paid = append(paid, res.endorser)
+			pending--
+		} else {
+			unpaid = append(unpaid, res.endorser)
+		}
+		if pending == 0 && len(paid)+len(unpaid) >= quorum {
+			c.endorsers = append(paid, unpaid...)[:quorum]
+			return c.endorsers, nil
+		}
+	}
+	return nil, fmt.Errorf("only %d of %d endorsers available, need %d: %s",
+		len(paid)+len(unpaid), len(all), quorum, strings.Join(failed, "; "))
+}
+
+// replaceEndorsers 背书签名失败时排除失败的节点，剩余节点足够M个时返回true，
+// 调用方需要重新选择背书节点并重新生成交易
+func (c *CommTrans) replaceEndorsers(err error) bool {
+	var ee *endorseError
+	if !errors.As(err, &ee) {
+		return false
+	}
+	if c.failedEndorsers == nil {
+		c.failedEndorsers = make(map[string]bool)
+	}
+	for _, e := range ee.failed {
+		c.failedEndorsers[e.host] = true
+	}
+	c.endorsers = nil
+
+	list := c.endorserList()
+	quorum, qerr := c.endorserQuorum(len(list))
+	return qerr == nil && len(list)-len(c.failedEndorsers) >= quorum
+}
+
+// endorserInfo 获取背书节点的背书地址，与配置的地址不一致时不使用该节点
+func (c *CommTrans) endorserInfo(ctx context.Context, conf EndorserConfig) (*endorser, error) {
+	conn, err := dialNode(ctx, conf.Host, c.RootOptions.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", conf.Host, err)
+	}
+	defer conn.Close()
+
+	req := &pb.EndorserInfoRequest{
+		Header: &pb.Header{Logid: utils.GenLogId()},
+		BcName: c.ChainName,
+	}
+	info, err := pb.NewXendorserClient(conn).GetEndorserInfo(ctx, req)
+	if status.Code(err) == codes.Unimplemented && conf.Address != "" {
+		// 旧版本背书节点不支持查询，使用配置的地址
+		return c.newEndorser(conf, conf.Address), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", conf.Host, err)
+	}
+	if conf.Address != "" && info.GetEndorserAddress() != conf.Address {
+		return nil, fmt.Errorf("%s: endorser address is %s, but %s is configured",
+			conf.Host, info.GetEndorserAddress(), conf.Address)
+	}
+	return c.newEndorser(conf, info.GetEndorserAddress()), nil
+}
+
+// endorserAuthRequire 选中背书节点的AuthRequire
+func (c *CommTrans) endorserAuthRequire(ctx context.Context) ([]string, error) {
+	endorsers, err := c.selectEndorsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	authRequire := make([]string, 0, len(endorsers))
+	for _, e := range endorsers {
+		authRequire = append(authRequire, e.address)
+	}
+	return authRequire, nil
+}
+
+// endorserFees 服务费交易的输出，每个选中且未支付过的背书节点按各自的收费地址和金额收取，
+// 多个节点使用同一收费地址时合并为一个输出；未选择背书节点时只向complianceCheckEndorseFeeAddr收取
+func (c *CommTrans) endorserFees() ([]*pb.TxOutput, *big.Int) {
+	endorsers := c.endorsers
+	if endorsers == nil {
+		endorsers = []*endorser{c.newEndorser(EndorserConfig{}, "")}
+	}
+
+	total := big.NewInt(0)
+	var outputs []*pb.TxOutput
+	amounts := make(map[string]*big.Int)
+	for _, e := range endorsers {
+		if e.feeTx != nil || e.feeAddr == "" || e.fee <= 0 {
+			continue
+		}
+		fee := big.NewInt(int64(e.fee))
+		total.Add(total, fee)
+		if amount, ok := amounts[e.feeAddr]; ok {
+			amount.Add(amount, fee)
+			continue
+		}
+		amounts[e.feeAddr] = fee
+		outputs = append(outputs, &pb.TxOutput{ToAddr: []byte(e.feeAddr)})
+	}
+	for _, output := range outputs {
+		output.Amount = amounts[string(output.ToAddr)].Bytes()
+	}
+	return outputs, total
+}
+
+// unpaidEndorsers 选中的节点中是否有未支付服务费的节点
+func (c *CommTrans) unpaidEndorsers() bool {
+	for _, e := range c.endorsers {
+		if e.feeTx == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// payEndorsers 记录选中的未支付节点由feeTx支付，更换背书节点重试时不再向这些节点收费
+func (c *CommTrans) payEndorsers(feeTx *pb.Transaction) {
+	if c.paidEndorsers == nil {
+		c.paidEndorsers = make(map[string]*pb.Transaction)
+	}
+	for _, e := range c.endorsers {
+		if e.feeTx == nil {
+			e.feeTx = feeTx
+			c.paidEndorsers[e.host] = feeTx
+		}
+	}
+}
+
+// endorserFeeTxs 选中节点的服务费交易，被背书交易花费其中的找零与之绑定
+func (c *CommTrans) endorserFeeTxs() []*pb.Transaction {
+	var feeTxs []*pb.Transaction
+	seen := make(map[*pb.Transaction]bool)
+	for _, e := range c.endorsers {
+		if e.feeTx != nil && !seen[e.feeTx] {
+			seen[e.feeTx] = true
+			feeTxs = append(feeTxs, e.feeTx)
+		}
+	}
+	return feeTxs
+}
+
+// collectEndorsements 并发请求选中的背书节点签名，结果顺序与AuthRequire一致；
+// 有节点失败时返回*endorseError，见replaceEndorsers
+// 每个节点收到向其支付服务费的交易，同一轮选中的未支付节点共用一笔服务费交易
+func (c *CommTrans) collectEndorsements(ctx context.Context, tx *pb.Transaction) ([]*pb.SignatureInfo, error) {
+	endorsers, err := c.selectEndorsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txStatus := &pb.TxStatus{
+		Bcname: c.ChainName,
+		Tx:     tx,
+	}
+	requestData, err := json.Marshal(txStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.endorserTimeout())
+	defer cancel()
+	results := make([]chan *endorserResult, len(endorsers))
+	for i, e := range endorsers {
+		results[i] = make(chan *endorserResult, 1)
+		req := &pb.EndorserRequest{
+			Header:      &pb.Header{Logid: utils.GenLogId()},
+			RequestName: "ComplianceCheck",
+			BcName:      c.ChainName,
+			Fee:         e.feeTx,
+			RequestData: requestData,
+		}
+		go func(e *endorser, req *pb.EndorserRequest, ch chan *endorserResult) {
+			sign, err := c.endorse(ctx, e, req)
+			ch <- &endorserResult{endorser: e, sign: sign, err: err}
+		}(e, req, results[i])
+	}
+
+	signs := make([]*pb.SignatureInfo, 0, len(endorsers))
+	failed := &endorseError{total: len(endorsers)}
+	for _, ch := range results {
+		res := <-ch
+		if res.err != nil {
+			failed.failed = append(failed.failed, res.endorser)
+			failed.msgs = append(failed.msgs, fmt.Sprintf("%s(%s): %v", res.endorser.host, res.endorser.address, res.err))
+			continue
+		}
+		fmt.Printf("Endorsed by %s (%s)\n", res.endorser.address, res.endorser.host)
+		signs = append(signs, res.sign)
+	}
+	if len(failed.failed) > 0 {
+		return nil, failed
+	}
+	return signs, nil
+}
+
+func (c *CommTrans) endorse(ctx context.Context, e *endorser, req *pb.EndorserRequest) (*pb.SignatureInfo, error) {
+	conn, err := dialNode(ctx, e.host, c.RootOptions.TLS)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	res, err := pb.NewXendorserClient(conn).EndorserCall(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := headerError(res.GetHeader()); err != nil {
+		return nil, fmt.Errorf("endorser refused: %w", err)
+	}
+	if res.GetEndorserAddress() != e.address {
+		return nil, fmt.Errorf("signed by unexpected address %s", res.GetEndorserAddress())
+	}
+	return res.GetEndorserSign(), nil
+}
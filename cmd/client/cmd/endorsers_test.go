@@ -0,0 +1,263 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+// testEndorser 模拟背书节点，refuse时拒绝背书，配置fee时校验服务费交易向feeAddr支付的金额
+type testEndorser struct {
+	pb.UnimplementedXendorserServer
+	address   string
+	infoDelay time.Duration
+	refuse    bool
+	feeAddr   string
+	fee       int64
+
+	mutex sync.Mutex
+	calls int
+	feeTx *pb.Transaction
+}
+
+func (e *testEndorser) GetEndorserInfo(ctx context.Context, req *pb.EndorserInfoRequest) (*pb.EndorserInfoResponse, error) {
+	time.Sleep(e.infoDelay)
+	return &pb.EndorserInfoResponse{Header: req.GetHeader(), BcName: req.GetBcName(), EndorserAddress: e.address}, nil
+}
+
+func (e *testEndorser) EndorserCall(ctx context.Context, req *pb.EndorserRequest) (*pb.EndorserResponse, error) {
+	e.mutex.Lock()
+	e.calls++
+	e.feeTx = req.GetFee()
+	e.mutex.Unlock()
+	if e.refuse || outputAmount(req.GetFee(), e.feeAddr) < e.fee {
+		return &pb.EndorserResponse{Header: &pb.Header{Error: pb.XChainErrorEnum_SERVICE_REFUSED_ERROR}}, nil
+	}
+	return &pb.EndorserResponse{
+		Header:          &pb.Header{Logid: req.GetHeader().GetLogid()},
+		EndorserAddress: e.address,
+		EndorserSign:    &pb.SignatureInfo{PublicKey: e.address},
+	}, nil
+}
+
+func outputAmount(tx *pb.Transaction, addr string) int64 {
+	amount := big.NewInt(0)
+	for _, output := range tx.GetTxOutputs() {
+		if string(output.GetToAddr()) == addr {
+			amount.Add(amount, big.NewInt(0).SetBytes(output.GetAmount()))
+		}
+	}
+	return amount.Int64()
+}
+
+// startTestEndorser 启动模拟背书节点，返回监听地址
+func startTestEndorser(t *testing.T, e *testEndorser) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	pb.RegisterXendorserServer(server, e)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+	return lis.Addr().String()
+}
+
+func newTestEndorseTrans(quorum int, endorsers ...EndorserConfig) *CommTrans {
+	c := &CommTrans{ChainName: "xuper", RootOptions: NewRootOptions()}
+	c.RootOptions.ComplianceCheck.Endorsers = endorsers
+	c.RootOptions.ComplianceCheck.EndorserQuorum = quorum
+	return c
+}
+
+func TestReplaceEndorsers(t *testing.T) {
+	refused := &testEndorser{address: "refused", refuse: true}
+	fast := &testEndorser{address: "fast"}
+	slow := &testEndorser{address: "slow", infoDelay: 300 * time.Millisecond}
+	c := newTestEndorseTrans(2,
+		EndorserConfig{Host: startTestEndorser(t, refused)},
+		EndorserConfig{Host: startTestEndorser(t, fast)},
+		EndorserConfig{Host: startTestEndorser(t, slow)})
+	ctx := context.Background()
+	tx := &pb.Transaction{Desc: []byte("endorse")}
+
+	// 最先响应的refused和fast被选中，refused拒绝背书
+	if _, err := c.collectEndorsements(ctx, tx); err == nil {
+		t.Fatal("endorsement should fail when a selected endorser refuses")
+	} else if !c.replaceEndorsers(err) {
+		t.Fatalf("replace endorsers failed, err: %v", err)
+	}
+
+	// 重新选择时排除refused，由slow代替
+	signs, err := c.collectEndorsements(ctx, tx)
+	if err != nil {
+		t.Fatalf("endorse with replaced endorsers failed: %v", err)
+	}
+	authRequire, err := c.endorserAuthRequire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(signs) != 2 || len(authRequire) != 2 {
+		t.Fatalf("got %d signs of %v, expect 2", len(signs), authRequire)
+	}
+	for i, addr := range authRequire {
+		if addr == refused.address || signs[i].GetPublicKey() != addr {
+			t.Fatalf("sign %d is %s, authRequire %v", i, signs[i].GetPublicKey(), authRequire)
+		}
+	}
+	if refused.calls != 1 {
+		t.Fatalf("refused endorser called %d times, expect 1", refused.calls)
+	}
+
+	// 剩余节点不足M个或不是背书失败时不再重试
+	if c.replaceEndorsers(&endorseError{failed: c.endorsers[:1]}) {
+		t.Fatal("replace endorsers should fail without enough endorsers")
+	}
+	if c.replaceEndorsers(errors.New("post tx failed")) {
+		t.Fatal("replace endorsers should fail on other errors")
+	}
+}
+
+func TestEndorserFees(t *testing.T) {
+	keys := newTestKeys(t, t.TempDir(), "default")
+	address := readTestFile(t, keys, "address")
+	first := &testEndorser{address: "first", feeAddr: "fee-first", fee: 100}
+	second := &testEndorser{address: "second", feeAddr: "fee-second", fee: 200}
+	c := newTestEndorseTrans(0,
+		EndorserConfig{Host: startTestEndorser(t, first), FeeAddr: first.feeAddr, Fee: int(first.fee)},
+		EndorserConfig{Host: startTestEndorser(t, second), FeeAddr: second.feeAddr, Fee: int(second.fee)})
+	c.Keys = keys
+	c.CryptoType = "default"
+	ctx := context.Background()
+	if _, err := c.selectEndorsers(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// 服务费交易向每个背书节点的收费地址支付，找零给发起者
+	utxoOutput := &pb.UtxoOutput{
+		UtxoList:      []*pb.Utxo{{RefTxid: []byte("utxo"), Amount: big.NewInt(1000).Bytes(), ToAddr: []byte(address)}},
+		TotalSelected: "1000",
+	}
+	feeTx, err := c.GenComplianceCheckTx(utxoOutput, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.payEndorsers(feeTx)
+	expect := map[string]int64{first.feeAddr: 100, second.feeAddr: 200, address: 700}
+	if len(feeTx.GetTxOutputs()) != len(expect) {
+		t.Fatalf("fee tx has %d outputs, expect %d", len(feeTx.GetTxOutputs()), len(expect))
+	}
+	for addr, amount := range expect {
+		if got := outputAmount(feeTx, addr); got != amount {
+			t.Fatalf("fee paid to %s is %d, expect %d", addr, got, amount)
+		}
+	}
+
+	// 两个背书节点都收到同一笔服务费交易并签名
+	signs, err := c.collectEndorsements(ctx, &pb.Transaction{Desc: []byte("endorse")})
+	if err != nil {
+		t.Fatalf("endorse with fee failed: %v", err)
+	}
+	if len(signs) != 2 {
+		t.Fatalf("got %d signs, expect 2", len(signs))
+	}
+	for _, e := range []*testEndorser{first, second} {
+		if !bytes.Equal(e.feeTx.GetTxid(), feeTx.GetTxid()) {
+			t.Fatalf("endorser %s got fee tx %x, expect %x", e.address, e.feeTx.GetTxid(), feeTx.GetTxid())
+		}
+	}
+
+	// 使用同一收费地址的节点合并为一个输出
+	c.endorsers = []*endorser{
+		{feeAddr: "fee-shared", fee: 400},
+		{feeAddr: "fee-shared", fee: 400},
+	}
+	outputs, total := c.endorserFees()
+	if len(outputs) != 1 || total.Int64() != 800 || big.NewInt(0).SetBytes(outputs[0].GetAmount()).Int64() != 800 {
+		t.Fatalf("shared fee outputs %v, total %s, expect one output of 800", outputs, total)
+	}
+}
+
+func TestReplaceEndorsersReuseFee(t *testing.T) {
+	keys := newTestKeys(t, t.TempDir(), "default")
+	address := readTestFile(t, keys, "address")
+	refused := &testEndorser{address: "refused", refuse: true, feeAddr: "fee-refused", fee: 100}
+	fast := &testEndorser{address: "fast", feeAddr: "fee-fast", fee: 200}
+	slow := &testEndorser{address: "slow", infoDelay: 300 * time.Millisecond, feeAddr: "fee-slow", fee: 300}
+	c := newTestEndorseTrans(2,
+		EndorserConfig{Host: startTestEndorser(t, refused), FeeAddr: refused.feeAddr, Fee: int(refused.fee)},
+		EndorserConfig{Host: startTestEndorser(t, fast), FeeAddr: fast.feeAddr, Fee: int(fast.fee)},
+		EndorserConfig{Host: startTestEndorser(t, slow), FeeAddr: slow.feeAddr, Fee: int(slow.fee)})
+	c.Keys = keys
+	c.CryptoType = "default"
+	c.Fee = "0"
+	ctx := context.Background()
+	utxoOutput := func(txid string) *pb.UtxoOutput {
+		return &pb.UtxoOutput{
+			UtxoList:      []*pb.Utxo{{RefTxid: []byte(txid), Amount: big.NewInt(1000).Bytes(), ToAddr: []byte(address)}},
+			TotalSelected: "1000",
+		}
+	}
+	// endorse 按GenCompleteTxAndPost的流程，只为未支付的节点生成服务费交易
+	var feeTxs []*pb.Transaction
+	endorse := func(txid string) ([]*pb.Transaction, error) {
+		if _, err := c.selectEndorsers(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if c.unpaidEndorsers() {
+			feeTx, err := c.GenComplianceCheckTx(utxoOutput(txid), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c.payEndorsers(feeTx)
+			feeTxs = append(feeTxs, feeTx)
+		}
+		tx, err := c.GenRealTx(&pb.PreExecWithSelectUTXOResponse{Response: &pb.InvokeResponse{}}, c.endorserFeeTxs())
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = c.collectEndorsements(ctx, tx)
+		return c.endorserFeeTxs(), err
+	}
+
+	// refused和fast被选中，共用第一笔服务费交易，refused拒绝背书
+	if _, err := endorse("utxo1"); err == nil || !c.replaceEndorsers(err) {
+		t.Fatalf("expect endorsement to fail and be retried, err: %v", err)
+	}
+	fee1 := fast.feeTx
+
+	// 重试时fast复用第一笔服务费交易，只为slow生成新的服务费交易
+	spent, err := endorse("utxo2")
+	if err != nil {
+		t.Fatalf("endorse with replaced endorsers failed: %v", err)
+	}
+	if len(feeTxs) != 2 || len(spent) != 2 {
+		t.Fatalf("built %d fee txs, tx spends %d, expect 2", len(feeTxs), len(spent))
+	}
+	if fast.calls != 2 || !bytes.Equal(fast.feeTx.GetTxid(), fee1.GetTxid()) {
+		t.Fatalf("fast endorser got fee tx %x in %d calls, expect %x reused", fast.feeTx.GetTxid(), fast.calls, fee1.GetTxid())
+	}
+	if !bytes.Equal(slow.feeTx.GetTxid(), feeTxs[1].GetTxid()) {
+		t.Fatalf("slow endorser got fee tx %x, expect %x", slow.feeTx.GetTxid(), feeTxs[1].GetTxid())
+	}
+	// 每个节点只被支付一次
+	expect := map[string]int64{refused.feeAddr: 100, fast.feeAddr: 200, slow.feeAddr: 300}
+	for addr, amount := range expect {
+		paid := int64(0)
+		for _, feeTx := range feeTxs {
+			paid += outputAmount(feeTx, addr)
+		}
+		if paid != amount {
+			t.Fatalf("paid %d to %s, expect %d", paid, addr, amount)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/xuperchain/xupercore/lib/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	scom "github.com/xuperchain/xuperchain/service/common"
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+// xchain-cli退出码，脚本可依赖这些取值，新增错误只能追加不能修改已有取值
+const (
+	ExitSuccess      = 0 // 成功
+	ExitGeneral      = 1 // 未分类的客户端错误
+	ExitUsage        = 2 // 命令行参数错误
+	ExitConnection   = 3 // 连接失败或超时
+	ExitNotFound     = 4 // 链、交易、区块等不存在
+	ExitInsufficient = 5 // 余额、手续费或gas不足
+	ExitTxInvalid    = 6 // 交易校验或签名失败
+	ExitRefused      = 7 // 节点拒绝服务或未就绪
+	ExitServer       = 8 // 节点内部或未知错误
+)
+
+// 节点错误码到退出码的映射，未列出的非SUCCESS错误码退出码为ExitServer
+var xchainErrToExitCode = map[pb.XChainErrorEnum]int{
+	pb.XChainErrorEnum_SUCCESS:                        ExitSuccess,
+	pb.XChainErrorEnum_CONNECT_REFUSE:                 ExitConnection,
+	pb.XChainErrorEnum_TX_NOT_FOUND_ERROR:             ExitNotFound,
+	pb.XChainErrorEnum_BLOCKCHAIN_NOTEXIST:            ExitNotFound,
+	pb.XChainErrorEnum_UTXOVM_NOT_FOUND_ERROR:         ExitNotFound,
+	pb.XChainErrorEnum_NOT_ENOUGH_UTXO_ERROR:          ExitInsufficient,
+	pb.XChainErrorEnum_TX_FEE_NOT_ENOUGH_ERROR:        ExitInsufficient,
+	pb.XChainErrorEnum_GAS_NOT_ENOUGH_ERROR:           ExitInsufficient,
+	pb.XChainErrorEnum_UTXOVM_ALREADY_UNCONFIRM_ERROR: ExitTxInvalid,
+	pb.XChainErrorEnum_INPUT_OUTPUT_NOT_EQUAL_ERROR:   ExitTxInvalid,
+	pb.XChainErrorEnum_TX_SIGN_ERROR:                  ExitTxInvalid,
+	pb.XChainErrorEnum_VALIDATE_ERROR:                 ExitTxInvalid,
+	pb.XChainErrorEnum_TX_DUPLICATE_ERROR:             ExitTxInvalid,
+	pb.XChainErrorEnum_TXDATA_SIGN_ERROR:              ExitTxInvalid,
+	pb.XChainErrorEnum_TX_SLE_ERROR:                   ExitTxInvalid,
+	pb.XChainErrorEnum_UTXO_SIGN_ERROR:                ExitTxInvalid,
+	pb.XChainErrorEnum_RWSET_INVALID_ERROR:            ExitTxInvalid,
+	pb.XChainErrorEnum_RWACL_INVALID_ERROR:            ExitTxInvalid,
+	pb.XChainErrorEnum_TX_VERSION_INVALID_ERROR:       ExitTxInvalid,
+	pb.XChainErrorEnum_TX_VERIFICATION_ERROR:          ExitTxInvalid,
+	pb.XChainErrorEnum_SERVICE_REFUSED_ERROR:          ExitRefused,
+	pb.XChainErrorEnum_NOT_READY_ERROR:                ExitRefused,
+	pb.XChainErrorEnum_COMPLIANCE_CHECK_NOT_APPROVED:  ExitRefused,
+	pb.XChainErrorEnum_ACCOUNT_CONTRACT_STATUS_ERROR:  ExitRefused,
+}
+
+// 传输层错误的grpc状态码到退出码的映射，未列出的退出码为ExitServer
+var grpcCodeToExitCode = map[codes.Code]int{
+	codes.Unavailable:      ExitConnection,
+	codes.DeadlineExceeded: ExitConnection,
+	codes.Canceled:         ExitConnection,
+	codes.Unimplemented:    ExitRefused,
+	codes.Unauthenticated:  ExitRefused,
+	codes.PermissionDenied: ExitRefused,
+}
+
+// 客户端本地错误没有节点错误码，code为-1
+const clientErrCode = -1
+
+// 节点返回的标准错误格式：Err:status-code-msg
+var serverErrPattern = regexp.MustCompile(`Err:(\d+)-(\d+)-`)
+
+// CliError 带节点错误码和logid的错误，--json-errors按此结构输出
+// Code/Name: 节点错误码pb.XChainErrorEnum，客户端本地错误为-1和USAGE_ERROR/CLIENT_ERROR
+// ExitCode: 进程退出码
+type CliError struct {
+	Code     int32  `json:"code"`
+	Name     string `json:"name"`
+	Message  string `json:"message"`
+	Logid    string `json:"logid,omitempty"`
+	ExitCode int    `json:"exitCode"`
+
+	// rpc调用失败时的原始状态，调用方仍可用status.Code判断grpc状态码
+	grpcStatus *status.Status
+}
+
+func (e *CliError) Error() string {
+	if e.Logid == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s, logid:%s", e.Message, e.Logid)
+}
+
+// GRPCStatus 返回rpc调用失败时的原始状态，非rpc错误的状态码为Unknown
+func (e *CliError) GRPCStatus() *status.Status {
+	if e.grpcStatus == nil {
+		return status.New(codes.Unknown, e.Error())
+	}
+	return e.grpcStatus
+}
+
+// headerError 节点在Header中返回的错误
+func headerError(header *pb.Header) error {
+	if header == nil || header.Error == pb.XChainErrorEnum_SUCCESS {
+		return nil
+	}
+	return newXchainError(header.Error, header.Error.String(), header.GetLogid())
+}
+
+func newXchainError(xerr pb.XChainErrorEnum, msg, logid string) *CliError {
+	code, ok := xchainErrToExitCode[xerr]
+	if !ok {
+		code = ExitServer
+	}
+	return &CliError{
+		Code:     int32(xerr),
+		Name:     xerr.String(),
+		Message:  msg,
+		Logid:    logid,
+		ExitCode: code,
+	}
+}
+
+// usageError 命令行参数错误
+func usageError(err error) error {
+	return &CliError{
+		Code:     clientErrCode,
+		Name:     "USAGE_ERROR",
+		Message:  err.Error(),
+		ExitCode: ExitUsage,
+	}
+}
+
+// toCliError 将任意错误归类，不能识别的错误退出码为ExitGeneral
+func toCliError(err error) *CliError {
+	var cliErr *CliError
+	if errors.As(err, &cliErr) {
+		// 调用方包装过的错误保留外层说明
+		e := *cliErr
+		e.Message = strings.Replace(err.Error(), cliErr.Error(), cliErr.Message, 1)
+		return &e
+	}
+	return &CliError{
+		Code:     clientErrCode,
+		Name:     "CLIENT_ERROR",
+		Message:  err.Error(),
+		ExitCode: ExitGeneral,
+	}
+}
+
+// grpcError 将rpc调用错误转为CliError：节点标准错误按错误码映射，
+// 传输层错误中连接失败和超时为CONNECT_REFUSE，其他为UNKNOW_ERROR，退出码按grpc状态码映射
+func grpcError(err error, logid string) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	if m := serverErrPattern.FindStringSubmatch(st.Message()); m != nil {
+		code, _ := strconv.Atoi(m[2])
+		xerr, ok := scom.StdErrToXchainErrMap[code]
+		if !ok {
+			xerr = pb.XChainErrorEnum_UNKNOW_ERROR
+		}
+		e := newXchainError(xerr, st.Message(), logid)
+		e.grpcStatus = st
+		return e
+	}
+	xerr := pb.XChainErrorEnum_UNKNOW_ERROR
+	code, ok := grpcCodeToExitCode[st.Code()]
+	if !ok {
+		code = ExitServer
+	}
+	if code == ExitConnection {
+		xerr = pb.XChainErrorEnum_CONNECT_REFUSE
+	}
+	e := newXchainError(xerr, err.Error(), logid)
+	e.ExitCode = code
+	e.grpcStatus = st
+	return e
+}
+
+// errorInterceptor 请求未带logid时补上，rpc失败时错误中带上logid便于到节点日志排查
+func errorInterceptor(ctx context.Context, method string, req, reply interface{},
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	logid := ensureLogid(req)
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	if err != nil {
+		return grpcError(err, logid)
+	}
+	return nil
+}
+
+func ensureLogid(req interface{}) string {
+	v := reflect.ValueOf(req)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return ""
+	}
+	field := v.Elem().FieldByName("Header")
+	if !field.IsValid() || field.Type() != reflect.TypeOf(&pb.Header{}) || !field.CanSet() {
+		return ""
+	}
+	if field.IsNil() {
+		field.Set(reflect.ValueOf(&pb.Header{}))
+	}
+	header := field.Interface().(*pb.Header)
+	if header.Logid == "" {
+		header.Logid = utils.GenLogId()
+	}
+	return header.Logid
+}
+
+// printError 输出错误，jsonErrors为true时输出json
+func printError(w io.Writer, err *CliError, jsonErrors bool) {
+	if !jsonErrors {
+		fmt.Fprintln(w, err.Error())
+		return
+	}
+	buf, _ := json.Marshal(err)
+	fmt.Fprintln(w, string(buf))
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+// oldEndorser 模拟不支持GetEndorserInfo的旧版本背书节点
+type oldEndorser struct {
+	pb.UnimplementedXendorserServer
+}
+
+func TestErrorInterceptorKeepsStatus(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	pb.RegisterXendorserServer(server, &oldEndorser{})
+	go server.Serve(lis)
+	defer server.Stop()
+
+	ctx := context.Background()
+	conn, err := dialNode(ctx, lis.Addr().String(), TLSOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	// 错误转为CliError后仍能取到grpc状态码
+	_, err = pb.NewXendorserClient(conn).GetEndorserInfo(ctx, &pb.EndorserInfoRequest{})
+	var cliErr *CliError
+	if !errors.As(err, &cliErr) {
+		t.Fatalf("expect CliError, got %T: %v", err, err)
+	}
+	if code := status.Code(err); code != codes.Unimplemented {
+		t.Fatalf("status code is %s, expect Unimplemented", code)
+	}
+	if cliErr.ExitCode != ExitRefused || cliErr.Logid == "" {
+		t.Fatalf("exit code %d, logid %q", cliErr.ExitCode, cliErr.Logid)
+	}
+
+	// 旧版本背书节点使用配置的地址
+	c := newTestEndorseTrans(1)
+	e, err := c.endorserInfo(ctx, EndorserConfig{Host: lis.Addr().String(), Address: "configured"})
+	if err != nil {
+		t.Fatalf("fallback to configured address failed: %v", err)
+	}
+	if e.address != "configured" {
+		t.Fatalf("endorser address is %s, expect configured", e.address)
+	}
+
+	// 客户端本地错误的状态码为Unknown
+	if code := status.Code(usageError(errors.New("bad flag"))); code != codes.Unknown {
+		t.Fatalf("status code of local error is %s, expect Unknown", code)
+	}
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xupercore/lib/utils"
+)
+
+const (
+	// feeAuto --fee的取值，表示按节点估算结果设置手续费
+	feeAuto = "auto"
+	// defaultFeeMargin 估算手续费的默认安全余量
+	defaultFeeMargin = 0.1
+)
+
+// estimateFee 调用EstimateFee估算手续费，并在建议值上增加margin比例的余量
+func estimateFee(ctx context.Context, client pb.XchainClient, req *pb.InvokeRPCRequest,
+	margin float64) (int64, error) {
+	if len(req.GetRequests()) == 0 {
+		return 0, nil
+	}
+	if margin < 0 {
+		return 0, fmt.Errorf("invalid fee margin: %v", margin)
+	}
+	estReq := &pb.EstimateFeeRequest{
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+		Bcname:      req.GetBcname(),
+		Requests:    req.GetRequests(),
+		Initiator:   req.GetInitiator(),
+		AuthRequire: req.GetAuthRequire(),
+	}
+	reply, err := client.EstimateFee(ctx, estReq)
+	if err != nil {
+		return 0, fmt.Errorf("estimate fee failed.err:%w", err)
+	}
+	if err := headerError(reply.Header); err != nil {
+		return 0, err
+	}
+
+	fee := int64(math.Ceil(float64(reply.SuggestedFee) * (1 + margin)))
+	fmt.Printf("The estimated fee is: %d (gas used: %d, recent median: %d of %d txs, margin: %v)\n",
+		fee, reply.GasUsed, reply.RecentFeeMedian, reply.RecentSamples, margin)
+	return fee, nil
+}
+
+// resolveAutoFee --fee auto时用估算结果替换Fee
+func (c *CommTrans) resolveAutoFee(ctx context.Context, req *pb.InvokeRPCRequest) error {
+	if c.Fee != feeAuto {
+		return nil
+	}
+	fee, err := estimateFee(ctx, c.XchainClient, req, c.RootOptions.FeeMargin)
+	if err != nil {
+		return err
+	}
+	c.Fee = strconv.FormatInt(fee, 10)
+	return nil
+}
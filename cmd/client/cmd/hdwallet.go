@@ -0,0 +1,217 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/spf13/viper"
+	"github.com/xuperchain/crypto/core/account"
+	hdapi "github.com/xuperchain/crypto/core/hdwallet/api"
+	"github.com/xuperchain/crypto/core/hdwallet/keychain"
+	crypto_client "github.com/xuperchain/xupercore/lib/crypto/client"
+)
+
+// 未配置hdPath时--index使用的派生路径，实际派生路径为hdPath/index
+const defaultHDPath = "m/44'/0'/0'/0"
+
+// 助记词语言，与account newkeys --lang对应
+var mnemonicLangs = []int{1, 2}
+
+// 按--index派生的密钥，key为密钥目录和派生路径
+var derivedKeys sync.Map
+
+// hdKey 派生出的密钥，PrivateKey和PublicKey为与private.key、public.key相同的json格式
+type hdKey struct {
+	Path       string
+	Address    string
+	PublicKey  string
+	PrivateKey string
+}
+
+// parseHDPath 解析m/44'/0'/0'/0/1格式的派生路径，'或h表示硬化派生
+func parseHDPath(path string) ([]uint32, error) {
+	parts := strings.Split(strings.TrimSpace(path), "/")
+	if len(parts) == 0 || parts[0] != "m" {
+		return nil, fmt.Errorf("invalid hd path %s, should start with m", path)
+	}
+	indexes := make([]uint32, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		hardened := strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h")
+		part = strings.TrimRight(part, "'h")
+		n, err := strconv.ParseUint(part, 10, 32)
+		if err != nil || n >= hdapi.HardenedKeyStart {
+			return nil, fmt.Errorf("invalid hd path %s", path)
+		}
+		index := uint32(n)
+		if hardened {
+			index += hdapi.HardenedKeyStart
+		}
+		indexes = append(indexes, index)
+	}
+	return indexes, nil
+}
+
+// formatHDPath 将派生序号格式化为m/44'/0'/0'/0/1格式的路径
+func formatHDPath(indexes []uint32) string {
+	parts := []string{"m"}
+	for _, index := range indexes {
+		if index >= hdapi.HardenedKeyStart {
+			parts = append(parts, fmt.Sprintf("%d'", index-hdapi.HardenedKeyStart))
+		} else {
+			parts = append(parts, strconv.FormatUint(uint64(index), 10))
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// parseExtendedPublicKey 解析base58编码的扩展公钥
+func parseExtendedPublicKey(xpub string) (key *keychain.ExtendedKey, err error) {
+	// NewKeyFromString未检查长度，格式错误时会panic
+	defer func() {
+		if r := recover(); r != nil {
+			key, err = nil, fmt.Errorf("invalid extended public key %s", xpub)
+		}
+	}()
+	key, err = keychain.NewKeyFromString(strings.TrimSpace(xpub))
+	if err != nil {
+		return nil, fmt.Errorf("invalid extended public key %s.err:%v", xpub, err)
+	}
+	if key.IsPrivate {
+		return nil, fmt.Errorf("%s is an extended private key, expect an extended public key", xpub)
+	}
+	return key, nil
+}
+
+// masterKeyFromMnemonic 从助记词恢复根密钥，自动识别中英文助记词
+func masterKeyFromMnemonic(mnemonic string) (*keychain.ExtendedKey, error) {
+	var err error
+	for _, lang := range mnemonicLangs {
+		var cryptoType string
+		cryptoType, err = mnemonicCryptoType(mnemonic, lang)
+		if err != nil {
+			continue
+		}
+		if cryptoType != crypto_client.CryptoTypeDefault {
+			return nil, fmt.Errorf("hd derivation only supports mnemonic of the default crypto plugin, got %s", cryptoType)
+		}
+		var master string
+		master, err = hdapi.GenerateMasterKeyByMnemonic(mnemonic, lang)
+		if err != nil {
+			continue
+		}
+		key := new(keychain.ExtendedKey)
+		if err := json.Unmarshal([]byte(master), key); err != nil {
+			return nil, err
+		}
+		return key, nil
+	}
+	return nil, fmt.Errorf("invalid mnemonic.err:%v", err)
+}
+
+// childKey 派生子密钥，扩展公钥只能派生非硬化的子公钥
+func childKey(key *keychain.ExtendedKey, index uint32) (*keychain.ExtendedKey, error) {
+	// 库中扩展公钥派生硬化子密钥时不会报错，但结果是错误的
+	if !key.IsPrivate && index >= hdapi.HardenedKeyStart {
+		return nil, fmt.Errorf("can not derive hardened child from an extended public key")
+	}
+	return key.Child(index)
+}
+
+// deriveChild 按路径逐级派生
+func deriveChild(key *keychain.ExtendedKey, path []uint32) (*keychain.ExtendedKey, error) {
+	for _, index := range path {
+		child, err := childKey(key, index)
+		if err != nil {
+			return nil, err
+		}
+		key = child
+	}
+	return key, nil
+}
+
+// newHDKey 生成派生密钥的地址和json格式的公私钥，扩展公钥只有地址和公钥
+func newHDKey(path string, key *keychain.ExtendedKey) (*hdKey, error) {
+	pub, err := key.ECPublicKey()
+	if err != nil {
+		return nil, err
+	}
+	hk := &hdKey{Path: path}
+	if hk.Address, err = account.GetAddressFromPublicKey(pub); err != nil {
+		return nil, err
+	}
+	if hk.PublicKey, err = account.GetEcdsaPublicKeyJsonFormatFromPublicKey(pub); err != nil {
+		return nil, err
+	}
+	if !key.IsPrivate {
+		return hk, nil
+	}
+	priv, err := key.ECPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	if hk.PrivateKey, err = account.GetEcdsaPrivateKeyJsonFormat(priv); err != nil {
+		return nil, err
+	}
+	return hk, nil
+}
+
+// hdBasePath 配置的派生路径前缀
+func hdBasePath() string {
+	base := viper.GetString("hdPath")
+	if base == "" {
+		base = defaultHDPath
+	}
+	return strings.TrimRight(base, "/")
+}
+
+// hdIndexPath --index对应的派生路径，未指定--index时返回空
+func hdIndexPath() string {
+	// 未绑定--index时viper返回0，需要区分未设置
+	index := viper.GetInt("index")
+	if viper.Get("index") == nil || index < 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s/%d", hdBasePath(), index)
+}
+
+// hdIndexKey 指定--index时从keypath下的助记词派生签名密钥，未指定时返回nil
+func hdIndexKey(keypath string) (*hdKey, error) {
+	path := hdIndexPath()
+	if path == "" {
+		return nil, nil
+	}
+	cacheKey := keypath + "@" + path
+	if key, ok := derivedKeys.Load(cacheKey); ok {
+		return key.(*hdKey), nil
+	}
+
+	mnemonic, err := mnemonicFile.read(keypath)
+	if err != nil {
+		return nil, fmt.Errorf("--index requires a mnemonic in %s.err:%v", keypath, err)
+	}
+	indexes, err := parseHDPath(path)
+	if err != nil {
+		return nil, err
+	}
+	master, err := masterKeyFromMnemonic(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	child, err := deriveChild(master, indexes)
+	if err != nil {
+		return nil, fmt.Errorf("derive %s failed.err:%v", path, err)
+	}
+	key, err := newHDKey(path, child)
+	if err != nil {
+		return nil, err
+	}
+	derivedKeys.Store(cacheKey, key)
+	return key, nil
+}
@@ -0,0 +1,324 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/xuperchain/crypto/core/account"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/crypto/sha3"
+)
+
+// account export/import支持的密钥文件格式
+const (
+	keyFormatPEM      = "pem"
+	keyFormatKeystore = "keystore-json"
+)
+
+// keystore json的scrypt参数，与以太坊keystore的标准参数和轻量参数一致
+const (
+	scryptStandardN = 1 << 18
+	scryptStandardP = 1
+	scryptLightN    = 1 << 12
+	scryptLightP    = 6
+	scryptR         = 8
+	scryptDKLen     = 32
+)
+
+const (
+	keystoreVersion = 3
+	keystoreCipher  = "aes-128-ctr"
+	pemPKCS8Type    = "PRIVATE KEY"
+	pemSEC1Type     = "EC PRIVATE KEY"
+)
+
+// keystoreJSON 以太坊v3格式的keystore文件，address为xuper地址
+type keystoreJSON struct {
+	Version int            `json:"version"`
+	ID      string         `json:"id"`
+	Address string         `json:"address,omitempty"`
+	Crypto  keystoreCrypto `json:"crypto"`
+}
+
+type keystoreCrypto struct {
+	Cipher       string                 `json:"cipher"`
+	CipherText   string                 `json:"ciphertext"`
+	CipherParams keystoreCipherParams   `json:"cipherparams"`
+	KDF          string                 `json:"kdf"`
+	KDFParams    map[string]interface{} `json:"kdfparams"`
+	MAC          string                 `json:"mac"`
+}
+
+type keystoreCipherParams struct {
+	IV string `json:"iv"`
+}
+
+// encodePEM 私钥编码为PKCS#8 PEM
+func encodePEM(key *ecdsa.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("marshal pkcs8 private key failed.err:%v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: pemPKCS8Type, Bytes: der}), nil
+}
+
+// decodePEM 解析PKCS#8或SEC1格式的PEM私钥
+func decodePEM(content []byte) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, errors.New("no pem block found")
+	}
+	if x509.IsEncryptedPEMBlock(block) {
+		return nil, errors.New("encrypted pem is not supported, use keystore-json instead")
+	}
+	switch block.Type {
+	case pemPKCS8Type:
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parse pkcs8 private key failed.err:%v", err)
+		}
+		ecKey, ok := key.(*ecdsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type %T, expect ecdsa", key)
+		}
+		return ecKey, checkCurve(ecKey)
+	case pemSEC1Type:
+		key, err := x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parse ec private key failed.err:%v", err)
+		}
+		return key, checkCurve(key)
+	default:
+		return nil, fmt.Errorf("unsupported pem type %s", block.Type)
+	}
+}
+
+// checkCurve 默认密码学插件只支持P-256
+func checkCurve(key *ecdsa.PrivateKey) error {
+	if key.Curve != elliptic.P256() {
+		return fmt.Errorf("curve %s is not supported, expect P-256", key.Curve.Params().Name)
+	}
+	return nil
+}
+
+// encryptKeystore 使用scrypt和aes-128-ctr加密私钥，light为true时使用轻量scrypt参数
+func encryptKeystore(key *ecdsa.PrivateKey, address, passwd string, light bool) ([]byte, error) {
+	n, p := scryptStandardN, scryptStandardP
+	if light {
+		n, p = scryptLightN, scryptLightP
+	}
+	salt, err := randomBytes(32)
+	if err != nil {
+		return nil, err
+	}
+	iv, err := randomBytes(aes.BlockSize)
+	if err != nil {
+		return nil, err
+	}
+	derived, err := scrypt.Key([]byte(passwd), salt, n, scryptR, p, scryptDKLen)
+	if err != nil {
+		return nil, fmt.Errorf("scrypt failed.err:%v", err)
+	}
+	plain := paddedBytes(key.D, 32)
+	cipherText, err := aesCTR(derived[:16], iv, plain)
+	if err != nil {
+		return nil, err
+	}
+	id, err := randomUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	ks := &keystoreJSON{
+		Version: keystoreVersion,
+		ID:      id,
+		Address: address,
+		Crypto: keystoreCrypto{
+			Cipher:       keystoreCipher,
+			CipherText:   hex.EncodeToString(cipherText),
+			CipherParams: keystoreCipherParams{IV: hex.EncodeToString(iv)},
+			KDF:          "scrypt",
+			KDFParams: map[string]interface{}{
+				"n":     n,
+				"r":     scryptR,
+				"p":     p,
+				"dklen": scryptDKLen,
+				"salt":  hex.EncodeToString(salt),
+			},
+			MAC: hex.EncodeToString(keystoreMAC(derived, cipherText)),
+		},
+	}
+	return json.MarshalIndent(ks, "", "  ")
+}
+
+// decryptKeystore 解密keystore json，支持scrypt和pbkdf2，返回私钥和文件中记录的地址
+func decryptKeystore(content []byte, passwd string) (*ecdsa.PrivateKey, string, error) {
+	ks := new(keystoreJSON)
+	if err := json.Unmarshal(content, ks); err != nil {
+		return nil, "", fmt.Errorf("parse keystore json failed.err:%v", err)
+	}
+	if ks.Version != keystoreVersion {
+		return nil, "", fmt.Errorf("unsupported keystore version %d", ks.Version)
+	}
+	if ks.Crypto.Cipher != keystoreCipher {
+		return nil, "", fmt.Errorf("unsupported cipher %s", ks.Crypto.Cipher)
+	}
+	cipherText, err := hex.DecodeString(ks.Crypto.CipherText)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid ciphertext.err:%v", err)
+	}
+	iv, err := hex.DecodeString(ks.Crypto.CipherParams.IV)
+	if err != nil || len(iv) != aes.BlockSize {
+		return nil, "", errors.New("invalid cipherparams.iv")
+	}
+	mac, err := hex.DecodeString(ks.Crypto.MAC)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid mac.err:%v", err)
+	}
+	derived, err := keystoreDerivedKey(ks.Crypto, passwd)
+	if err != nil {
+		return nil, "", err
+	}
+	if !bytes.Equal(keystoreMAC(derived, cipherText), mac) {
+		return nil, "", errors.New("decrypt keystore failed, please check your password")
+	}
+	plain, err := aesCTR(derived[:16], iv, cipherText)
+	if err != nil {
+		return nil, "", err
+	}
+	key, err := privateKeyFromD(plain)
+	if err != nil {
+		return nil, "", err
+	}
+	return key, strings.TrimPrefix(ks.Address, "0x"), nil
+}
+
+// keystoreDerivedKey 按kdfparams从密码派生密钥
+func keystoreDerivedKey(c keystoreCrypto, passwd string) ([]byte, error) {
+	salt, err := hex.DecodeString(kdfString(c.KDFParams, "salt"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid kdfparams.salt.err:%v", err)
+	}
+	dkLen := kdfInt(c.KDFParams, "dklen")
+	if dkLen < 32 {
+		return nil, fmt.Errorf("invalid kdfparams.dklen %d", dkLen)
+	}
+	switch c.KDF {
+	case "scrypt":
+		derived, err := scrypt.Key([]byte(passwd), salt, kdfInt(c.KDFParams, "n"),
+			kdfInt(c.KDFParams, "r"), kdfInt(c.KDFParams, "p"), dkLen)
+		if err != nil {
+			return nil, fmt.Errorf("scrypt failed.err:%v", err)
+		}
+		return derived, nil
+	case "pbkdf2":
+		if prf := kdfString(c.KDFParams, "prf"); prf != "hmac-sha256" {
+			return nil, fmt.Errorf("unsupported pbkdf2 prf %s", prf)
+		}
+		return pbkdf2.Key([]byte(passwd), salt, kdfInt(c.KDFParams, "c"), dkLen, sha256.New), nil
+	default:
+		return nil, fmt.Errorf("unsupported kdf %s", c.KDF)
+	}
+}
+
+func kdfString(params map[string]interface{}, name string) string {
+	s, _ := params[name].(string)
+	return s
+}
+
+func kdfInt(params map[string]interface{}, name string) int {
+	switch v := params[name].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	}
+	return 0
+}
+
+// keystoreMAC keccak256(derivedKey[16:32] || ciphertext)
+func keystoreMAC(derived, cipherText []byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	h.Write(derived[16:32])
+	h.Write(cipherText)
+	return h.Sum(nil)
+}
+
+func aesCTR(key, iv, in []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, len(in))
+	cipher.NewCTR(block, iv).XORKeyStream(out, in)
+	return out, nil
+}
+
+// privateKeyFromD 由P-256私钥标量恢复私钥并计算公钥
+func privateKeyFromD(d []byte) (*ecdsa.PrivateKey, error) {
+	curve := elliptic.P256()
+	k := new(big.Int).SetBytes(d)
+	if k.Sign() == 0 || k.Cmp(curve.Params().N) >= 0 {
+		return nil, errors.New("invalid private key")
+	}
+	key := &ecdsa.PrivateKey{D: k}
+	key.PublicKey.Curve = curve
+	key.PublicKey.X, key.PublicKey.Y = curve.ScalarBaseMult(paddedBytes(k, 32))
+	return key, nil
+}
+
+func paddedBytes(n *big.Int, size int) []byte {
+	buf := make([]byte, size)
+	b := n.Bytes()
+	copy(buf[size-len(b):], b)
+	return buf
+}
+
+func randomBytes(n int) ([]byte, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, fmt.Errorf("read random failed.err:%v", err)
+	}
+	return buf, nil
+}
+
+// randomUUID 生成keystore id使用的v4 uuid
+func randomUUID() (string, error) {
+	b, err := randomBytes(16)
+	if err != nil {
+		return "", err
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
+
+// keyDirFiles 由私钥生成密钥目录中的地址和json格式的公私钥
+func keyDirFiles(key *ecdsa.PrivateKey) (address, publicKey, privateKey string, err error) {
+	if address, err = account.GetAddressFromPublicKey(&key.PublicKey); err != nil {
+		return
+	}
+	if publicKey, err = account.GetEcdsaPublicKeyJsonFormat(key); err != nil {
+		return
+	}
+	privateKey, err = account.GetEcdsaPrivateKeyJsonFormat(key)
+	return
+}
@@ -0,0 +1,201 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/chzyer/readline"
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/viper"
+	aesUtil "github.com/xuperchain/crypto/core/aes"
+	"github.com/xuperchain/crypto/core/hash"
+)
+
+// 私钥密码的环境变量，优先于passwordFile，都未指定时在终端提示输入
+const keyPasswordEnv = "XCHAIN_KEY_PASSWORD"
+
+// 已解密的私钥和助记词，每个文件在进程内只解密一次
+var decryptedKeys sync.Map
+
+// secretFile 密钥目录中可以加密保存的文件
+type secretFile struct {
+	name string
+	// 内容是否是明文，也用于检查解密时密码是否正确
+	isPlain func(content []byte) bool
+}
+
+var (
+	// 明文私钥是json
+	privateKeyFile = secretFile{name: "private.key", isPlain: func(content []byte) bool {
+		return json.Valid(bytes.TrimSpace(content))
+	}}
+	// 明文助记词是空格分隔的单词
+	mnemonicFile = secretFile{name: "mnemonic", isPlain: func(content []byte) bool {
+		return bytes.Contains(bytes.TrimSpace(content), []byte(" "))
+	}}
+)
+
+// isEncryptedKey 加密私钥是base64编码的密文
+func isEncryptedKey(content string) bool {
+	return !privateKeyFile.isPlain([]byte(content))
+}
+
+// encryptSecret 与account decrypt使用相同的格式：base64(aes(明文, DoubleSha256(密码)))
+func encryptSecret(plain []byte, passwd string) (string, error) {
+	cipher, err := aesUtil.Encrypt(bytes.TrimSpace(plain), hash.DoubleSha256([]byte(passwd)))
+	if err != nil {
+		return "", fmt.Errorf("encrypt failed.err:%v", err)
+	}
+	return base64.StdEncoding.EncodeToString(cipher), nil
+}
+
+func (f secretFile) decryptContent(content, passwd string) (string, error) {
+	cipher, err := base64.StdEncoding.DecodeString(strings.TrimSpace(content))
+	if err != nil {
+		return "", fmt.Errorf("base64 decode %s failed.err:%v", f.name, err)
+	}
+	plain, err := aesUtil.Decrypt(cipher, hash.DoubleSha256([]byte(passwd)))
+	// 密码错误时解密结果不是合法的明文
+	if err != nil || !f.isPlain(plain) {
+		return "", fmt.Errorf("decrypt %s failed, please check your password", f.name)
+	}
+	return string(bytes.TrimSpace(plain)), nil
+}
+
+// decrypt 解密文件内容，密码依次取环境变量、passwordFile、终端输入
+func (f secretFile) decrypt(file, content string) (string, error) {
+	if plain, ok := decryptedKeys.Load(file); ok {
+		return plain.(string), nil
+	}
+	passwd, err := readKeyPassword(fmt.Sprintf("Password of %s", file), false)
+	if err != nil {
+		return "", err
+	}
+	plain, err := f.decryptContent(content, passwd)
+	if err != nil {
+		return "", fmt.Errorf("%s: %v", file, err)
+	}
+	decryptedKeys.Store(file, plain)
+	return plain, nil
+}
+
+// read 读取dir下的文件，加密时在内存中解密
+func (f secretFile) read(dir string) (string, error) {
+	file := filepath.Join(dir, f.name)
+	content, err := readKeys(file)
+	if err != nil || f.isPlain([]byte(content)) {
+		return content, err
+	}
+	return f.decrypt(file, content)
+}
+
+// encryptInPlace 原地加密dir下的文件，文件不存在或已加密时不做处理
+func (f secretFile) encryptInPlace(dir string, passwd string) error {
+	file := filepath.Join(dir, f.name)
+	content, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) || (err == nil && !f.isPlain(content)) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	cipher, err := encryptSecret(content, passwd)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(file, []byte(cipher), 0600); err != nil {
+		return err
+	}
+	return os.Chmod(file, 0600)
+}
+
+// encryptKeyDir 加密密钥目录中的私钥和助记词
+func encryptKeyDir(dir string, passwd string) error {
+	for _, f := range []secretFile{privateKeyFile, mnemonicFile} {
+		if err := f.encryptInPlace(dir, passwd); err != nil {
+			return fmt.Errorf("encrypt %s failed.err:%v", f.name, err)
+		}
+	}
+	return nil
+}
+
+// writeKeyDir 按account newkeys的格式保存密钥目录，encrypt为true时使用passwd加密私钥
+func writeKeyDir(dir, address, publicKey, privateKey string, encrypt bool, passwd string) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create output dir:%s", err)
+	}
+	files := map[string]string{
+		"address":           address,
+		"public.key":        publicKey,
+		privateKeyFile.name: privateKey,
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			return fmt.Errorf("failed to save %s:%s", name, err)
+		}
+	}
+	if encrypt {
+		return encryptKeyDir(dir, passwd)
+	}
+	return nil
+}
+
+// readKeyPassword 读取私钥密码，confirm为true时终端输入需要确认
+func readKeyPassword(label string, confirm bool) (string, error) {
+	if passwd, ok := os.LookupEnv(keyPasswordEnv); ok {
+		return passwd, nil
+	}
+	if file := viper.GetString("passwordFile"); file != "" {
+		buf, err := ioutil.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("read password file failed.err:%v", err)
+		}
+		return strings.TrimRight(string(buf), "\r\n"), nil
+	}
+	if !readline.IsTerminal(int(os.Stdin.Fd())) {
+		return "", fmt.Errorf("private key is encrypted, set %s or --password-file", keyPasswordEnv)
+	}
+
+	passwd, err := promptPassword(label)
+	if err != nil || !confirm {
+		return passwd, err
+	}
+	again, err := promptPassword("Confirm password")
+	if err != nil {
+		return "", err
+	}
+	if again != passwd {
+		return "", errors.New("passwords do not match")
+	}
+	return passwd, nil
+}
+
+func promptPassword(label string) (string, error) {
+	prompt := promptui.Prompt{
+		Label: label,
+		Validate: func(input string) error {
+			if len(input) < 4 {
+				return errors.New("Password must at least 4 characters")
+			}
+			return nil
+		},
+		Mask: '*',
+	}
+	passwd, err := prompt.Run()
+	if err != nil {
+		return "", fmt.Errorf("read password failed.err:%v", err)
+	}
+	return passwd, nil
+}
@@ -0,0 +1,24 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewMempoolCommand new mempool cmd
+func NewMempoolCommand(cli *Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mempool",
+		Short: "Inspect unconfirmed transactions: list, stats",
+	}
+	cmd.AddCommand(NewMempoolListCommand(cli))
+	cmd.AddCommand(NewMempoolStatsCommand(cli))
+	return cmd
+}
+
+func init() {
+	AddCommand(NewMempoolCommand)
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xupercore/lib/utils"
+)
+
+// MempoolListCommand list unconfirmed txs cmd
+type MempoolListCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	initiator string
+	contract  string
+	limit     int64
+}
+
+// NewMempoolListCommand new mempool list cmd
+func NewMempoolListCommand(cli *Cli) *cobra.Command {
+	c := new(MempoolListCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "list [options]",
+		Short: "list unconfirmed transactions, oldest first",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return c.list(ctx)
+		},
+	}
+	c.addFlags()
+	return c.cmd
+}
+
+func (c *MempoolListCommand) addFlags() {
+	c.cmd.Flags().StringVar(&c.initiator, "initiator", "", "only list txs of the initiator")
+	c.cmd.Flags().StringVar(&c.contract, "contract", "", "only list txs calling the contract")
+	c.cmd.Flags().Int64Var(&c.limit, "limit", 100, "max count of txs to list")
+}
+
+func (c *MempoolListCommand) list(ctx context.Context) error {
+	req := &pb.ListPendingTxsRequest{
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+		Bcname: c.cli.RootOptions.Name,
+		Filter: &pb.PendingTxFilter{
+			Initiator: c.initiator,
+			Contract:  c.contract,
+			Limit:     c.limit,
+		},
+	}
+	reply, err := c.cli.XchainClient().ListPendingTxs(ctx, req)
+	if err != nil {
+		return err
+	}
+	if err := headerError(reply.Header); err != nil {
+		return err
+	}
+
+	txs := make([]*PendingTx, 0, len(reply.Txs))
+	for _, tx := range reply.Txs {
+		txs = append(txs, FromPendingTxPB(tx))
+	}
+	if err := c.cli.PrintOutput(txs); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Listed %d of %d pending txs\n", len(txs), reply.Total)
+	return nil
+}
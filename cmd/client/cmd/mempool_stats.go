@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xupercore/lib/utils"
+)
+
+// MempoolStatsCommand mempool stats cmd
+type MempoolStatsCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+}
+
+// NewMempoolStatsCommand new mempool stats cmd
+func NewMempoolStatsCommand(cli *Cli) *cobra.Command {
+	c := new(MempoolStatsCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "stats",
+		Short: "statistics of unconfirmed transactions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return c.stats(ctx)
+		},
+	}
+	return c.cmd
+}
+
+func (c *MempoolStatsCommand) stats(ctx context.Context) error {
+	req := &pb.GetMempoolStatsRequest{
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+		Bcname: c.cli.RootOptions.Name,
+	}
+	reply, err := c.cli.XchainClient().GetMempoolStats(ctx, req)
+	if err != nil {
+		return err
+	}
+	if err := headerError(reply.Header); err != nil {
+		return err
+	}
+
+	stats := &MempoolStats{
+		Count:           reply.Count,
+		Bytes:           reply.Bytes,
+		OldestAgeMs:     reply.OldestAgeMs,
+		InitiatorCounts: reply.InitiatorCounts,
+	}
+	return c.cli.PrintOutput(stats)
+}
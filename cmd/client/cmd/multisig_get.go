@@ -10,8 +10,8 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc"
 
+	"github.com/xuperchain/xuperchain/service/common"
 	"github.com/xuperchain/xuperchain/service/pb"
 	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo"
 )
@@ -43,7 +43,7 @@ func NewGetComplianceCheckSignCommand(cli *Cli) *cobra.Command {
 }
 
 func (c *GetComplianceCheckSignCommand) initXendorserClient() error {
-	conn, err := grpc.Dial(c.cli.RootOptions.Host, grpc.WithInsecure(), grpc.WithMaxMsgSize(64<<20-1))
+	conn, err := dialNode(context.Background(), c.cli.RootOptions.Host, c.cli.RootOptions.TLS)
 	if err != nil {
 		return err
 	}
@@ -110,8 +110,12 @@ func (c *GetComplianceCheckSignCommand) get(ctx context.Context) error {
 		return err
 	}
 
-	//组装小费tx
-	feeTx, err := ct.GenComplianceCheckTx(utxoOutput)
+	//组装小费tx，Desc中记录待背书交易的摘要
+	feeDesc, err := common.MakeEndorserFeeDesc(tx)
+	if err != nil {
+		return err
+	}
+	feeTx, err := ct.GenComplianceCheckTx(utxoOutput, feeDesc)
 	if err != nil {
 		fmt.Println("gen compliance check tx error", err)
 		return err
@@ -138,8 +142,8 @@ func (c *GetComplianceCheckSignCommand) get(ctx context.Context) error {
 		fmt.Println("check here new XendorserClient error", err)
 		return err
 	}
-	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
-		return fmt.Errorf("Failed to get sign for tx:%s, logid:%s", reply.Header.Error.String(), reply.Header.Logid)
+	if err := headerError(reply.Header); err != nil {
+		return fmt.Errorf("Failed to get sign for tx:%w", err)
 	}
 	signInfo := reply.GetEndorserSign()
 	signJSON, err3 := json.MarshalIndent(signInfo, "", "  ")
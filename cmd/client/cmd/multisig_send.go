@@ -218,8 +218,8 @@ func (c *MultisigSendCommand) sendTx(ctx context.Context, tx *pb.Transaction) (s
 		return "", err
 	}
 
-	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
-		return "", fmt.Errorf("Failed to post tx:%s, logid:%s", reply.Header.Error.String(), reply.Header.Logid)
+	if err := headerError(reply.Header); err != nil {
+		return "", fmt.Errorf("Failed to post tx:%w", err)
 	}
 
 	return hex.EncodeToString(txStatus.Txid), nil
@@ -77,6 +77,7 @@ func (c *MultisigSignCommand) sign() error {
 		if err != nil {
 			return err
 		}
+		// 部分签名需要私钥参与计算，只支持密钥目录中的私钥
 		fromScrkey, err := readPrivateKey(c.cli.RootOptions.Keys)
 		if err != nil {
 			return err
@@ -118,7 +119,7 @@ func (c *MultisigSignCommand) sign() error {
 	} else {
 		signTx, err := c.genSignTx(tx)
 		if err != nil {
-			return errors.New("Sign tx error")
+			return fmt.Errorf("Sign tx error.err:%w", err)
 		}
 
 		err = c.genSignFile(fromPubkey, signTx)
@@ -129,19 +130,14 @@ func (c *MultisigSignCommand) sign() error {
 	return nil
 }
 
-// GetSignTx use privatekey to get sign
+// GetSignTx use the configured signer to get sign
 func (c *MultisigSignCommand) genSignTx(tx *pb.Transaction) ([]byte, error) {
-	// create crypto client
-	cryptoClient, err := crypto_client.CreateCryptoClient(c.cli.RootOptions.Crypto)
-	if err != nil {
-		return nil, errors.New("Create crypto client error")
-	}
-	fromScrkey, err := readPrivateKey(c.cli.RootOptions.Keys)
+	signer, err := accountSigner(c.cli.RootOptions.Keys, c.cli.RootOptions.Crypto)
 	if err != nil {
 		return nil, err
 	}
 
-	signTx, err := common.ComputeTxSign(cryptoClient, tx, []byte(fromScrkey))
+	signTx, err := signer.SignTx(tx)
 	if err != nil {
 		return nil, err
 	}
@@ -6,8 +6,6 @@ package cmd
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 
 	"github.com/spf13/cobra"
 
@@ -41,11 +39,5 @@ func (n *NetURLGetCommand) getNetURL(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	output, err := json.MarshalIndent(res.RawUrl, "", "")
-	if err != nil {
-		fmt.Println(err)
-		return err
-	}
-	fmt.Println(string(output))
-	return nil
+	return n.cli.PrintOutput(res.RawUrl)
 }
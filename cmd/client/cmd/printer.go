@@ -0,0 +1,195 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+
+	"gopkg.in/yaml.v2"
+)
+
+// -o支持的输出格式，未指定时为json
+const (
+	outputJSON     = "json"
+	outputYAML     = "yaml"
+	outputTable    = "table"
+	outputTemplate = "go-template="
+)
+
+// PrintOutput 按-o指定的格式输出types.go中的视图对象
+func (c *Cli) PrintOutput(v interface{}) error {
+	return printOutput(os.Stdout, c.RootOptions.Output, v)
+}
+
+func printOutput(w io.Writer, format string, v interface{}) error {
+	switch {
+	case format == "" || format == outputJSON:
+		buf, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(buf))
+		return err
+	case format == outputYAML:
+		data, err := toJSONValue(v)
+		if err != nil {
+			return err
+		}
+		buf, err := yaml.Marshal(data)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(buf)
+		return err
+	case format == outputTable:
+		return printTable(w, v)
+	case strings.HasPrefix(format, outputTemplate):
+		tmpl, err := template.New("output").Parse(strings.TrimPrefix(format, outputTemplate))
+		if err != nil {
+			return fmt.Errorf("parse output template failed.err:%v", err)
+		}
+		data, err := toJSONValue(v)
+		if err != nil {
+			return err
+		}
+		return tmpl.Execute(w, data)
+	default:
+		return fmt.Errorf("unsupported output format: %s, expect json|yaml|table|go-template=", format)
+	}
+}
+
+// toJSONValue 转为json对应的通用结构，yaml和模板使用与json相同的字段名和取值
+func toJSONValue(v interface{}) (interface{}, error) {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(buf))
+	decoder.UseNumber()
+	var data interface{}
+	if err := decoder.Decode(&data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// printTable 列表每个元素输出一行，单个对象每个字段输出一行
+func printTable(w io.Writer, v interface{}) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	switch {
+	case !rv.IsValid():
+	case (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array) && rv.Type().Elem().Kind() != reflect.Uint8:
+		elemType := rv.Type().Elem()
+		for elemType.Kind() == reflect.Ptr {
+			elemType = elemType.Elem()
+		}
+		if elemType.Kind() != reflect.Struct {
+			fmt.Fprintln(tw, "VALUE")
+			for i := 0; i < rv.Len(); i++ {
+				fmt.Fprintln(tw, tableCell(rv.Index(i)))
+			}
+			break
+		}
+		fields := tableFields(elemType)
+		names := make([]string, 0, len(fields))
+		for _, field := range fields {
+			names = append(names, strings.ToUpper(field.name))
+		}
+		fmt.Fprintln(tw, strings.Join(names, "\t"))
+		for i := 0; i < rv.Len(); i++ {
+			elem := reflect.Indirect(rv.Index(i))
+			cells := make([]string, 0, len(fields))
+			for _, field := range fields {
+				if elem.IsValid() {
+					cells = append(cells, tableCell(elem.FieldByIndex(field.index)))
+				} else {
+					cells = append(cells, "")
+				}
+			}
+			fmt.Fprintln(tw, strings.Join(cells, "\t"))
+		}
+	case rv.Kind() == reflect.Struct:
+		fmt.Fprintln(tw, "FIELD\tVALUE")
+		for _, field := range tableFields(rv.Type()) {
+			fmt.Fprintf(tw, "%s\t%s\n", field.name, tableCell(rv.FieldByIndex(field.index)))
+		}
+	case rv.Kind() == reflect.Map:
+		keys := make([]string, 0, rv.Len())
+		values := make(map[string]reflect.Value, rv.Len())
+		for _, key := range rv.MapKeys() {
+			name := fmt.Sprint(key.Interface())
+			keys = append(keys, name)
+			values[name] = rv.MapIndex(key)
+		}
+		sort.Strings(keys)
+		fmt.Fprintln(tw, "KEY\tVALUE")
+		for _, key := range keys {
+			fmt.Fprintf(tw, "%s\t%s\n", key, tableCell(values[key]))
+		}
+	default:
+		fmt.Fprintln(tw, tableCell(rv))
+	}
+	return tw.Flush()
+}
+
+type tableField struct {
+	name  string
+	index []int
+}
+
+// tableFields 按json标签取导出字段，忽略json:"-"
+func tableFields(t reflect.Type) []tableField {
+	var fields []tableField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		name := field.Name
+		if tag := field.Tag.Get("json"); tag != "" {
+			if tag == "-" {
+				continue
+			}
+			if n := strings.Split(tag, ",")[0]; n != "" {
+				name = n
+			}
+		}
+		fields = append(fields, tableField{name: name, index: field.Index})
+	}
+	return fields
+}
+
+// tableCell 单元格取json编码的值，字符串去掉引号，复杂结构为单行json
+func tableCell(v reflect.Value) string {
+	if !v.IsValid() || !v.CanInterface() {
+		return ""
+	}
+	// 取地址使指针接收者的MarshalJSON生效，如BigInt
+	if v.CanAddr() {
+		v = v.Addr()
+	}
+	buf, err := json.Marshal(v.Interface())
+	if err != nil {
+		return fmt.Sprint(v.Interface())
+	}
+	if string(buf) == "null" {
+		return ""
+	}
+	var s string
+	if json.Unmarshal(buf, &s) == nil {
+		return s
+	}
+	return string(buf)
+}
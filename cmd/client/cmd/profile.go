@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
+)
+
+// Profile 一组命名的连接配置，包括节点、链名、密钥目录、加密类型、TLS和背书配置
+type Profile struct {
+	Host               string                 `yaml:"host,omitempty"`
+	Name               string                 `yaml:"name,omitempty"`
+	Keys               string                 `yaml:"keys,omitempty"`
+	Crypto             string                 `yaml:"crypto,omitempty"`
+	TLS                *TLSOptions            `yaml:"tls,omitempty"`
+	EndorseServiceHost string                 `yaml:"endorseServiceHost,omitempty"`
+	ComplianceCheck    *ComplianceCheckConfig `yaml:"complianceCheck,omitempty"`
+}
+
+// ProfileConfig 保存在profileFile中的全部profile和当前使用的profile
+type ProfileConfig struct {
+	Current  string              `yaml:"current,omitempty"`
+	Profiles map[string]*Profile `yaml:"profiles,omitempty"`
+}
+
+// profileFilePath profile文件路径，未配置profileFile时为~/.xchain-cli/profiles.yaml
+func profileFilePath(file string) (string, error) {
+	if file != "" {
+		return file, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("get home dir failed.err:%v", err)
+	}
+	return filepath.Join(home, ".xchain-cli", "profiles.yaml"), nil
+}
+
+// loadProfiles 读取profile文件，文件不存在时返回空配置
+func loadProfiles(file string) (*ProfileConfig, error) {
+	pc := &ProfileConfig{
+		Profiles: make(map[string]*Profile),
+	}
+	path, err := profileFilePath(file)
+	if err != nil {
+		return nil, err
+	}
+	buf, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return pc, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read profile file failed.err:%v", err)
+	}
+	if err := yaml.Unmarshal(buf, pc); err != nil {
+		return nil, fmt.Errorf("unmarshal profile file %s failed.err:%v", path, err)
+	}
+	if pc.Profiles == nil {
+		pc.Profiles = make(map[string]*Profile)
+	}
+	return pc, nil
+}
+
+func saveProfiles(file string, pc *ProfileConfig) error {
+	path, err := profileFilePath(file)
+	if err != nil {
+		return err
+	}
+	buf, err := yaml.Marshal(pc)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, buf, 0600)
+}
+
+// applyProfile 把profile作为viper的默认值，合并顺序为：profile、配置文件、命令行参数，
+// 后者覆盖前者；--profile指定的profile不存在时报错
+func applyProfile(name, file string) error {
+	pc, err := loadProfiles(file)
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		name = pc.Current
+		if name == "" {
+			return nil
+		}
+		if _, ok := pc.Profiles[name]; !ok {
+			fmt.Fprintf(os.Stderr, "current profile %s not found, ignored\n", name)
+			return nil
+		}
+	}
+	profile, ok := pc.Profiles[name]
+	if !ok {
+		return fmt.Errorf("profile %s not found", name)
+	}
+
+	buf, err := yaml.Marshal(profile)
+	if err != nil {
+		return err
+	}
+	settings := make(map[string]interface{})
+	if err := yaml.Unmarshal(buf, &settings); err != nil {
+		return err
+	}
+	for key, value := range settings {
+		viper.SetDefault(key, value)
+	}
+	return nil
+}
+
+// NewProfileCommand new profile cmd
+func NewProfileCommand(cli *Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "profile",
+		Short: "Manage named connection profiles: add, use, list, show",
+	}
+	cmd.AddCommand(NewProfileAddCommand(cli))
+	cmd.AddCommand(NewProfileUseCommand(cli))
+	cmd.AddCommand(NewProfileListCommand(cli))
+	cmd.AddCommand(NewProfileShowCommand(cli))
+	return cmd
+}
+
+func init() {
+	AddCommand(NewProfileCommand)
+}
@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// ProfileAddCommand profile add cmd
+type ProfileAddCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	tls            bool
+	tlsCert        string
+	tlsServer      string
+	tlsCA          string
+	endorseHost    string
+	endorsers      []string
+	endorserQuorum int
+	use            bool
+}
+
+// NewProfileAddCommand new profile add cmd
+func NewProfileAddCommand(cli *Cli) *cobra.Command {
+	c := new(ProfileAddCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "add [options] name",
+		Short: "add or update a profile with the given global flags and options",
+		Example: `
+xchain-cli profile add testnet -H 127.0.0.1:37101 --name xuper --keys ./data/keys
+xchain-cli profile add prod -H 10.0.0.1:37101 --tls --tls-cert ./data/tls --tls-server localhost --use
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.add(cmd, args[0])
+		},
+	}
+	c.addFlags()
+	return c.cmd
+}
+
+func (c *ProfileAddCommand) addFlags() {
+	c.cmd.Flags().BoolVar(&c.tls, "tls", false, "enable tls")
+	c.cmd.Flags().StringVar(&c.tlsCert, "tls-cert", "", "directory of cert.crt, key.pem and private.key")
+	c.cmd.Flags().StringVar(&c.tlsServer, "tls-server", "", "server name in the node certificate")
+	c.cmd.Flags().StringVar(&c.tlsCA, "tls-ca", "", "custom CA file")
+	c.cmd.Flags().StringVar(&c.endorseHost, "endorse-host", "", "endorse service host")
+	c.cmd.Flags().StringSliceVar(&c.endorsers, "endorsers", nil, "endorsers in host or host=address format")
+	c.cmd.Flags().IntVar(&c.endorserQuorum, "endorser-quorum", 0, "number of endorsers required to sign")
+	c.cmd.Flags().BoolVar(&c.use, "use", false, "use the profile after added")
+}
+
+func (c *ProfileAddCommand) add(cmd *cobra.Command, name string) error {
+	file := c.cli.RootOptions.ProfileFile
+	pc, err := loadProfiles(file)
+	if err != nil {
+		return err
+	}
+	profile, exist := pc.Profiles[name]
+	if !exist {
+		profile = new(Profile)
+		pc.Profiles[name] = profile
+	}
+
+	// 只保存显式指定的参数，已有profile的其他配置不变
+	flags := cmd.Flags()
+	if flags.Changed("host") {
+		profile.Host = c.cli.RootOptions.Host
+	}
+	if flags.Changed("name") {
+		profile.Name = c.cli.RootOptions.Name
+	}
+	if flags.Changed("keys") {
+		profile.Keys = c.cli.RootOptions.Keys
+	}
+	if flags.Changed("crypto") {
+		profile.Crypto = c.cli.RootOptions.Crypto
+	}
+	if flags.Changed("tls") || flags.Changed("tls-cert") || flags.Changed("tls-server") ||
+		flags.Changed("tls-ca") || flags.Changed("insecure-skip-verify") {
+		if profile.TLS == nil {
+			profile.TLS = new(TLSOptions)
+		}
+		if flags.Changed("tls") {
+			profile.TLS.Enable = c.tls
+		}
+		if flags.Changed("tls-cert") {
+			profile.TLS.Cert = c.tlsCert
+		}
+		if flags.Changed("tls-server") {
+			profile.TLS.Server = c.tlsServer
+		}
+		if flags.Changed("tls-ca") {
+			profile.TLS.CA = c.tlsCA
+		}
+		if flags.Changed("insecure-skip-verify") {
+			profile.TLS.InsecureSkipVerify = c.cli.RootOptions.TLS.InsecureSkipVerify
+		}
+	}
+	if flags.Changed("endorse-host") {
+		profile.EndorseServiceHost = c.endorseHost
+	}
+	if flags.Changed("endorsers") || flags.Changed("endorser-quorum") {
+		if profile.ComplianceCheck == nil {
+			profile.ComplianceCheck = new(ComplianceCheckConfig)
+		}
+		if flags.Changed("endorsers") {
+			profile.ComplianceCheck.Endorsers = parseEndorsers(c.endorsers)
+		}
+		if flags.Changed("endorser-quorum") {
+			profile.ComplianceCheck.EndorserQuorum = c.endorserQuorum
+		}
+	}
+	if c.use {
+		pc.Current = name
+	}
+
+	if err := saveProfiles(file, pc); err != nil {
+		return fmt.Errorf("save profile failed.err:%v", err)
+	}
+	if exist {
+		fmt.Printf("Profile %s updated\n", name)
+	} else {
+		fmt.Printf("Profile %s added\n", name)
+	}
+	return nil
+}
+
+// parseEndorsers 解析host或host=address格式的背书节点
+func parseEndorsers(list []string) []EndorserConfig {
+	endorsers := make([]EndorserConfig, 0, len(list))
+	for _, item := range list {
+		kv := strings.SplitN(item, "=", 2)
+		conf := EndorserConfig{Host: kv[0]}
+		if len(kv) == 2 {
+			conf.Address = kv[1]
+		}
+		endorsers = append(endorsers, conf)
+	}
+	return endorsers
+}
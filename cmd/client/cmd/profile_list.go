@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+// ProfileListCommand profile list cmd
+type ProfileListCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+}
+
+// NewProfileListCommand new profile list cmd
+func NewProfileListCommand(cli *Cli) *cobra.Command {
+	c := new(ProfileListCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "list",
+		Short: "list profiles, the active one is marked with *",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.list()
+		},
+	}
+	return c.cmd
+}
+
+func (c *ProfileListCommand) list() error {
+	pc, err := loadProfiles(c.cli.RootOptions.ProfileFile)
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(pc.Profiles))
+	for name := range pc.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CURRENT\tNAME\tHOST\tCHAIN\tKEYS")
+	for _, name := range names {
+		current := ""
+		if name == pc.Current {
+			current = "*"
+		}
+		p := pc.Profiles[name]
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", current, name, p.Host, p.Name, p.Keys)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// ProfileShowCommand profile show cmd
+type ProfileShowCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+}
+
+// NewProfileShowCommand new profile show cmd
+func NewProfileShowCommand(cli *Cli) *cobra.Command {
+	c := new(ProfileShowCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "show [name]",
+		Short: "show a profile, default the active one",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := c.cli.RootOptions.Profile
+			if len(args) > 0 {
+				name = args[0]
+			}
+			return c.show(name)
+		},
+	}
+	return c.cmd
+}
+
+func (c *ProfileShowCommand) show(name string) error {
+	pc, err := loadProfiles(c.cli.RootOptions.ProfileFile)
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		name = pc.Current
+	}
+	if name == "" {
+		return errors.New("no active profile")
+	}
+	profile, ok := pc.Profiles[name]
+	if !ok {
+		return fmt.Errorf("profile %s not found", name)
+	}
+	buf, err := yaml.Marshal(profile)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("# profile: %s\n%s", name, buf)
+	return nil
+}
@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// ProfileUseCommand profile use cmd
+type ProfileUseCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+}
+
+// NewProfileUseCommand new profile use cmd
+func NewProfileUseCommand(cli *Cli) *cobra.Command {
+	c := new(ProfileUseCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "use name",
+		Short: "set the active profile",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.use(args[0])
+		},
+	}
+	return c.cmd
+}
+
+func (c *ProfileUseCommand) use(name string) error {
+	file := c.cli.RootOptions.ProfileFile
+	pc, err := loadProfiles(file)
+	if err != nil {
+		return err
+	}
+	if _, ok := pc.Profiles[name]; !ok {
+		return fmt.Errorf("profile %s not found", name)
+	}
+	pc.Current = name
+	if err := saveProfiles(file, pc); err != nil {
+		return fmt.Errorf("save profile failed.err:%v", err)
+	}
+	fmt.Printf("Switched to profile %s\n", name)
+	return nil
+}
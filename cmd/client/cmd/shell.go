@@ -0,0 +1,284 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/chzyer/readline"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// shell中缓存读取过的密钥，nil时每次从文件读取
+var keyCache *sync.Map
+
+// shell内置命令
+const shellBuiltinHelp = `Builtin commands:
+  use chain <name>        switch the current chain
+  use account <keys dir>  switch the current account
+  connect <host>          reconnect to another node
+  exit, quit              leave the shell
+
+Other input runs as xchain-cli subcommands, e.g.
+  wasm invoke counter --method increase -a '{"key":"k"}'
+  --name, --keys, -o and --index apply to a single command only.`
+
+// ShellCommand shell cmd
+type ShellCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	historyFile string
+	// 补全和解析历史命令使用的命令树，不用于执行
+	tree *cobra.Command
+	// 补全用的合约和方法，来自节点查询和历史命令
+	contracts *contractIndex
+}
+
+// NewShellCommand new shell cmd
+func NewShellCommand(cli *Cli) *cobra.Command {
+	c := new(ShellCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "shell",
+		Short: "Start an interactive shell keeping the connection and keys in memory",
+		Long: `Start an interactive shell. The shell keeps one connection to the node and
+the keys read from the keys directory, supports tab completion of subcommands,
+contract names and methods, and remembers the command history.
+
+` + shellBuiltinHelp,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.run()
+		},
+	}
+	c.addFlags()
+	return c.cmd
+}
+
+func (c *ShellCommand) addFlags() {
+	c.cmd.Flags().StringVar(&c.historyFile, "history", "", "history file, default ~/.xchain-cli/history")
+}
+
+func (c *ShellCommand) run() error {
+	historyFile, err := c.historyPath()
+	if err != nil {
+		return err
+	}
+	c.cli.inShell = true
+	keyCache = new(sync.Map)
+	c.tree = c.newRoot()
+	c.contracts = newContractIndex(c.cli)
+	c.contracts.learnHistory(c.tree, historyFile)
+
+	rl, err := readline.NewEx(&readline.Config{
+		Prompt:            c.prompt(),
+		HistoryFile:       historyFile,
+		HistorySearchFold: true,
+		AutoComplete:      &shellCompleter{shell: c},
+		InterruptPrompt:   "^C",
+		EOFPrompt:         "exit",
+	})
+	if err != nil {
+		return fmt.Errorf("init shell failed.err:%v", err)
+	}
+	defer rl.Close()
+
+	fmt.Printf("Connected to %s. Type help for commands, exit to quit.\n", c.cli.RootOptions.Host)
+	for {
+		line, err := rl.Readline()
+		if err == readline.ErrInterrupt {
+			continue
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		args, err := splitArgs(line)
+		if err != nil {
+			c.printError(usageError(err))
+			continue
+		}
+		if len(args) == 0 {
+			continue
+		}
+		if args[0] == "exit" || args[0] == "quit" {
+			return nil
+		}
+		if err := c.exec(args); err != nil {
+			c.printError(err)
+		}
+		c.contracts.learn(c.tree, args)
+		rl.SetPrompt(c.prompt())
+	}
+}
+
+// exec 执行内置命令或子命令
+func (c *ShellCommand) exec(args []string) error {
+	switch args[0] {
+	case "use":
+		return c.use(args[1:])
+	case "connect":
+		if len(args) != 2 {
+			return usageError(errors.New("usage: connect <host>"))
+		}
+		host := c.cli.RootOptions.Host
+		c.cli.RootOptions.Host = args[1]
+		if err := c.cli.initXchainClient(); err != nil {
+			c.cli.RootOptions.Host = host
+			return err
+		}
+		c.contracts.reset()
+		fmt.Printf("Connected to %s\n", args[1])
+		return nil
+	}
+
+	opts, index := c.cli.RootOptions, viper.GetInt("index")
+	defer func() {
+		c.cli.RootOptions = opts
+		viper.Set("index", index)
+	}()
+	root := c.newRoot()
+	root.SetArgs(args)
+	return root.Execute()
+}
+
+func (c *ShellCommand) use(args []string) error {
+	if len(args) != 2 {
+		return usageError(errors.New("usage: use chain <name> | use account <keys dir>"))
+	}
+	switch args[0] {
+	case "chain":
+		c.cli.RootOptions.Name = args[1]
+	case "account":
+		if _, err := readAddress(args[1]); err != nil {
+			return fmt.Errorf("read account from %s failed.err:%v", args[1], err)
+		}
+		c.cli.RootOptions.Keys = args[1]
+	default:
+		return usageError(fmt.Errorf("unknown target %s, expect chain or account", args[0]))
+	}
+	c.contracts.reset()
+	return nil
+}
+
+// newRoot 每条命令使用新的命令树，避免上一条命令的参数值残留
+func (c *ShellCommand) newRoot() *cobra.Command {
+	root := &cobra.Command{
+		Use:           "",
+		Long:          shellBuiltinHelp,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+	}
+	root.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return usageError(err)
+	})
+
+	// --name、--keys、-o、--index只对当前命令生效
+	opts := c.cli.RootOptions
+	flags := root.PersistentFlags()
+	flags.String("name", opts.Name, "block chain name")
+	flags.String("keys", opts.Keys, "directory of keys")
+	flags.StringP("output", "o", opts.Output, "output format: json|yaml|table|go-template=...")
+	flags.Int("index", viper.GetInt("index"), "sign with the key derived at hdPath/index")
+	root.PersistentPreRun = func(cmd *cobra.Command, args []string) {
+		c.cli.RootOptions.Name, _ = flags.GetString("name")
+		c.cli.RootOptions.Keys, _ = flags.GetString("keys")
+		c.cli.RootOptions.Output, _ = flags.GetString("output")
+		c.cli.RootOptions.Index, _ = flags.GetInt("index")
+		viper.Set("index", c.cli.RootOptions.Index)
+	}
+
+	for _, f := range Commands {
+		sub := f(c.cli)
+		if sub.Name() == c.cmd.Name() {
+			continue
+		}
+		root.AddCommand(sub)
+	}
+	return root
+}
+
+func (c *ShellCommand) prompt() string {
+	account := c.cli.RootOptions.Keys
+	if addr, err := readAddress(c.cli.RootOptions.Keys); err == nil {
+		account = addr
+	}
+	return fmt.Sprintf("%s@%s> ", c.cli.RootOptions.Name, account)
+}
+
+func (c *ShellCommand) printError(err error) {
+	printError(os.Stderr, toCliError(err), c.cli.RootOptions.JSONErrors)
+}
+
+// historyPath 历史文件默认与profile文件在同一目录
+func (c *ShellCommand) historyPath() (string, error) {
+	file := c.historyFile
+	if file == "" {
+		profileFile, err := profileFilePath(c.cli.RootOptions.ProfileFile)
+		if err != nil {
+			return "", err
+		}
+		file = filepath.Join(filepath.Dir(profileFile), "history")
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		return "", fmt.Errorf("create history dir failed.err:%v", err)
+	}
+	return file, nil
+}
+
+// splitArgs 按shell规则切分命令行，支持单双引号和反斜杠转义
+func splitArgs(line string) ([]string, error) {
+	var args []string
+	var buf strings.Builder
+	var quote rune
+	inArg, escaped := false, false
+	for _, r := range line {
+		switch {
+		case escaped:
+			buf.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped, inArg = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				buf.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inArg = r, true
+		case r == ' ' || r == '\t':
+			if inArg {
+				args = append(args, buf.String())
+				buf.Reset()
+				inArg = false
+			}
+		default:
+			buf.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, errors.New("unterminated quote or escape")
+	}
+	if inArg {
+		args = append(args, buf.String())
+	}
+	return args, nil
+}
+
+func init() {
+	AddCommand(NewShellCommand)
+}
@@ -0,0 +1,313 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+// 补全时查询节点的超时时间
+const completeQueryTimeout = 3 * time.Second
+
+// 合约虚拟机子命令，其invoke和query的第一个参数为合约名
+var contractVMCommands = map[string]bool{"wasm": true, "native": true, "evm": true}
+
+// shellCompleter 补全子命令、参数、合约名和合约方法
+type shellCompleter struct {
+	shell *ShellCommand
+}
+
+// Do 实现readline.AutoCompleter，返回各候选项待补全的后缀和已输入的长度
+func (sc *shellCompleter) Do(line []rune, pos int) ([][]rune, int) {
+	input := string(line[:pos])
+	words := strings.Fields(input)
+	partial := ""
+	if len(words) > 0 && !strings.HasSuffix(input, " ") && !strings.HasSuffix(input, "\t") {
+		partial = words[len(words)-1]
+		words = words[:len(words)-1]
+	}
+
+	var suffixes [][]rune
+	for _, candidate := range sc.candidates(words, partial) {
+		if strings.HasPrefix(candidate, partial) {
+			suffixes = append(suffixes, []rune(candidate[len(partial):]+" "))
+		}
+	}
+	return suffixes, len([]rune(partial))
+}
+
+func (sc *shellCompleter) candidates(words []string, partial string) []string {
+	if len(words) == 0 {
+		return append([]string{"use", "connect", "help", "exit", "quit"}, commandNames(sc.shell.tree)...)
+	}
+	switch words[0] {
+	case "use":
+		if len(words) == 1 {
+			return []string{"chain", "account"}
+		}
+		return nil
+	case "connect", "exit", "quit":
+		return nil
+	}
+
+	parsed := parseCommandLine(sc.shell.tree, words)
+	if parsed.pendingFlag != "" {
+		if parsed.pendingFlag == "method" && parsed.contract != "" {
+			return sc.shell.contracts.methods(parsed.contract, parsed.flags["abi"])
+		}
+		return nil
+	}
+	if strings.HasPrefix(partial, "-") {
+		return flagNames(parsed.cmd)
+	}
+	if parsed.cmd.HasSubCommands() {
+		return commandNames(parsed.cmd)
+	}
+	if parsed.isContractCall && len(parsed.args) == 0 {
+		return sc.shell.contracts.names()
+	}
+	return nil
+}
+
+func commandNames(cmd *cobra.Command) []string {
+	var names []string
+	for _, sub := range cmd.Commands() {
+		if sub.IsAvailableCommand() {
+			names = append(names, sub.Name())
+		}
+	}
+	return names
+}
+
+func flagNames(cmd *cobra.Command) []string {
+	var names []string
+	add := func(f *pflag.Flag) {
+		if !f.Hidden {
+			names = append(names, "--"+f.Name)
+		}
+	}
+	cmd.LocalFlags().VisitAll(add)
+	cmd.InheritedFlags().VisitAll(add)
+	sort.Strings(names)
+	return names
+}
+
+// parsedLine 按命令树解析的命令行
+type parsedLine struct {
+	cmd   *cobra.Command
+	args  []string
+	flags map[string]string
+	// 最后一个参数是等待取值的flag
+	pendingFlag string
+	// wasm/native/evm的invoke或query，contract为第一个参数
+	isContractCall bool
+	contract       string
+}
+
+func parseCommandLine(root *cobra.Command, words []string) *parsedLine {
+	p := &parsedLine{cmd: root, flags: make(map[string]string)}
+	for i := 0; i < len(words); i++ {
+		word := words[i]
+		if strings.HasPrefix(word, "-") && len(word) > 1 {
+			name := strings.TrimLeft(word, "-")
+			if kv := strings.SplitN(name, "=", 2); len(kv) == 2 {
+				p.flags[kv[0]] = kv[1]
+				continue
+			}
+			flag := lookupFlag(p.cmd, name, strings.HasPrefix(word, "--"))
+			if flag == nil || flag.NoOptDefVal != "" {
+				continue
+			}
+			if i+1 == len(words) {
+				p.pendingFlag = flag.Name
+				break
+			}
+			p.flags[flag.Name] = words[i+1]
+			i++
+			continue
+		}
+		if len(p.args) == 0 {
+			if sub := findSubCommand(p.cmd, word); sub != nil {
+				p.cmd = sub
+				continue
+			}
+		}
+		p.args = append(p.args, word)
+	}
+	if parent := p.cmd.Parent(); parent != nil && contractVMCommands[parent.Name()] &&
+		(p.cmd.Name() == "invoke" || p.cmd.Name() == "query") {
+		p.isContractCall = true
+		if len(p.args) > 0 {
+			p.contract = p.args[0]
+		}
+	}
+	return p
+}
+
+func lookupFlag(cmd *cobra.Command, name string, long bool) *pflag.Flag {
+	for _, flags := range []*pflag.FlagSet{cmd.Flags(), cmd.InheritedFlags()} {
+		if long {
+			if flag := flags.Lookup(name); flag != nil {
+				return flag
+			}
+		} else if flag := flags.ShorthandLookup(name[:1]); flag != nil {
+			return flag
+		}
+	}
+	return nil
+}
+
+func findSubCommand(cmd *cobra.Command, name string) *cobra.Command {
+	for _, sub := range cmd.Commands() {
+		if sub.Name() == name || sub.HasAlias(name) {
+			return sub
+		}
+	}
+	return nil
+}
+
+// contractIndex 补全用的合约名和方法
+// 合约名来自当前账户在节点上部署的合约，合约和方法也会从执行过的命令中学习
+type contractIndex struct {
+	cli     *Cli
+	fetched bool
+	// 合约名到已使用过的方法
+	contracts map[string]map[string]bool
+}
+
+func newContractIndex(cli *Cli) *contractIndex {
+	return &contractIndex{
+		cli:       cli,
+		contracts: make(map[string]map[string]bool),
+	}
+}
+
+// reset 切换链、账户或节点后重新查询合约
+func (ci *contractIndex) reset() {
+	ci.fetched = false
+}
+
+func (ci *contractIndex) names() []string {
+	if !ci.fetched {
+		ci.fetch()
+	}
+	names := make([]string, 0, len(ci.contracts))
+	for name := range ci.contracts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// methods 合约方法，evm合约指定了--abi时从abi文件中读取
+func (ci *contractIndex) methods(contract, abiFile string) []string {
+	set := make(map[string]bool)
+	for method := range ci.contracts[contract] {
+		set[method] = true
+	}
+	for _, method := range abiMethods(abiFile) {
+		set[method] = true
+	}
+	methods := make([]string, 0, len(set))
+	for method := range set {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+	return methods
+}
+
+// fetch 查询当前账户地址下各合约账户部署的合约，失败时只使用学习到的合约
+func (ci *contractIndex) fetch() {
+	ci.fetched = true
+	address, err := readAddress(ci.cli.RootOptions.Keys)
+	if err != nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), completeQueryTimeout)
+	defer cancel()
+	reply, err := ci.cli.XchainClient().GetAddressContracts(ctx, &pb.AddressContractsRequest{
+		Bcname:  ci.cli.RootOptions.Name,
+		Address: address,
+	})
+	if err != nil || headerError(reply.Header) != nil {
+		return
+	}
+	for _, list := range reply.GetContracts() {
+		for _, status := range list.GetContractStatus() {
+			ci.add(status.GetContractName(), "")
+		}
+	}
+}
+
+func (ci *contractIndex) add(contract, method string) {
+	if contract == "" {
+		return
+	}
+	if ci.contracts[contract] == nil {
+		ci.contracts[contract] = make(map[string]bool)
+	}
+	if method != "" {
+		ci.contracts[contract][method] = true
+	}
+}
+
+// learn 从执行过的命令中记录合约名和方法
+func (ci *contractIndex) learn(root *cobra.Command, args []string) {
+	parsed := parseCommandLine(root, args)
+	if parsed.isContractCall {
+		ci.add(parsed.contract, parsed.flags["method"])
+	}
+}
+
+// learnHistory 从历史命令中学习合约名和方法
+func (ci *contractIndex) learnHistory(root *cobra.Command, file string) {
+	f, err := os.Open(file)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if args, err := splitArgs(scanner.Text()); err == nil && len(args) > 0 {
+			ci.learn(root, args)
+		}
+	}
+}
+
+func abiMethods(file string) []string {
+	if file == "" {
+		return nil
+	}
+	buf, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil
+	}
+	var entries []struct {
+		Type string `json:"type"`
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(buf, &entries); err != nil {
+		return nil
+	}
+	var methods []string
+	for _, entry := range entries {
+		if entry.Type == "function" {
+			methods = append(methods, entry.Name)
+		}
+	}
+	return methods
+}
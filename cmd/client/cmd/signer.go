@@ -0,0 +1,354 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+	cryptoHash "github.com/xuperchain/xupercore/lib/crypto/hash"
+	"github.com/xuperchain/xupercore/lib/utils"
+	"google.golang.org/grpc"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+// 支持的签名方式
+const (
+	signerTypeFile     = "file"
+	signerTypeKeystore = "keystore"
+	signerTypeRemote   = "remote"
+)
+
+const defaultSignerTimeoutMs = 10000
+
+// Signer 交易签名接口，私钥可以保存在本地密钥目录、加密的keystore或独立的签名服务中
+type Signer interface {
+	// Address 签名账户地址
+	Address() (string, error)
+	// PublicKey json格式公钥
+	PublicKey() (string, error)
+	// SignTx 对交易摘要签名
+	SignTx(tx *pb.Transaction) ([]byte, error)
+	// SignSelectUtxo 锁定utxo时的用户签名，见selectUtxoDigest
+	SignSelectUtxo(bcName, address, totalNeed string) ([]byte, error)
+}
+
+// digestSigner 可以对任意摘要签名的本地Signer，用于account sign；
+// 签名服务不提供任意摘要签名，避免被用作签名预言机
+type digestSigner interface {
+	signDigest(digest []byte) ([]byte, error)
+}
+
+// 配置的签名服务只创建一次，keystore只解密一次
+var configuredSigners sync.Map
+
+// accountSigner keypath为--keys指定的账户时使用配置的签名方式，否则使用目录中的私钥
+func accountSigner(keypath, cryptoType string) (Signer, error) {
+	var opt SignerOptions
+	if err := viper.UnmarshalKey("signer", &opt); err != nil {
+		return nil, fmt.Errorf("invalid signer config.err:%v", err)
+	}
+	if opt.Type == "" || opt.Type == signerTypeFile ||
+		filepath.Clean(keypath) != filepath.Clean(viper.GetString("keys")) {
+		return NewFileSigner(keypath, cryptoType), nil
+	}
+
+	cacheKey := fmt.Sprintf("%+v", opt)
+	if signer, ok := configuredSigners.Load(cacheKey); ok {
+		return signer.(Signer), nil
+	}
+	var signer Signer
+	switch opt.Type {
+	case signerTypeKeystore:
+		if opt.Keystore == "" {
+			return nil, fmt.Errorf("signer.keystore is required by %s signer", opt.Type)
+		}
+		signer = NewKeystoreSigner(opt.Keystore)
+	case signerTypeRemote:
+		if opt.Host == "" {
+			return nil, fmt.Errorf("signer.host is required by %s signer", opt.Type)
+		}
+		signer = NewRemoteSigner(opt.Host, opt.TLS, opt.TimeoutMs)
+	default:
+		return nil, fmt.Errorf("unsupported signer type %s, expect file|keystore|remote", opt.Type)
+	}
+	configuredSigners.Store(cacheKey, signer)
+	return signer, nil
+}
+
+// fileSigner 使用密钥目录中的私钥签名，支持加密私钥和--index派生密钥
+type fileSigner struct {
+	keypath    string
+	cryptoType string
+}
+
+// NewFileSigner new signer of keys directory
+func NewFileSigner(keypath, cryptoType string) Signer {
+	return &fileSigner{keypath: keypath, cryptoType: cryptoType}
+}
+
+func (s *fileSigner) Address() (string, error) {
+	key, err := hdIndexKey(s.keypath)
+	if err != nil {
+		return "", err
+	}
+	if key != nil {
+		return key.Address, nil
+	}
+	return readKeys(filepath.Join(s.keypath, "address"))
+}
+
+func (s *fileSigner) PublicKey() (string, error) {
+	key, err := hdIndexKey(s.keypath)
+	if err != nil {
+		return "", err
+	}
+	if key != nil {
+		return key.PublicKey, nil
+	}
+	return readKeys(filepath.Join(s.keypath, "public.key"))
+}
+
+func (s *fileSigner) SignTx(tx *pb.Transaction) ([]byte, error) {
+	sk, err := readPrivateKey(s.keypath)
+	if err != nil {
+		return nil, err
+	}
+	return signTxWithKey(s.cryptoType, tx, sk)
+}
+
+func (s *fileSigner) SignSelectUtxo(bcName, address, totalNeed string) ([]byte, error) {
+	return s.signDigest(selectUtxoDigest(bcName, address, totalNeed))
+}
+
+func (s *fileSigner) signDigest(digest []byte) ([]byte, error) {
+	sk, err := readPrivateKey(s.keypath)
+	if err != nil {
+		return nil, err
+	}
+	return signDigestWithKey(s.cryptoType, digest, sk)
+}
+
+// keystoreSigner 使用keystore json中的私钥签名，第一次使用时读取密码解密
+type keystoreSigner struct {
+	file string
+
+	once       sync.Once
+	err        error
+	address    string
+	publicKey  string
+	privateKey string
+}
+
+// NewKeystoreSigner new signer of keystore json file
+func NewKeystoreSigner(file string) Signer {
+	return &keystoreSigner{file: file}
+}
+
+func (s *keystoreSigner) load() error {
+	s.once.Do(func() {
+		content, err := ioutil.ReadFile(s.file)
+		if err != nil {
+			s.err = fmt.Errorf("read keystore failed.err:%v", err)
+			return
+		}
+		passwd, err := readKeyPassword("Keystore password", false)
+		if err != nil {
+			s.err = err
+			return
+		}
+		key, address, err := decryptKeystore(content, passwd)
+		if err != nil {
+			s.err = err
+			return
+		}
+		if s.address, s.publicKey, s.privateKey, err = keyDirFiles(key); err != nil {
+			s.err = fmt.Errorf("convert keystore key failed.err:%v", err)
+			return
+		}
+		if address != "" && address != s.address {
+			s.err = fmt.Errorf("keystore address %s does not match the private key %s", address, s.address)
+		}
+	})
+	return s.err
+}
+
+func (s *keystoreSigner) Address() (string, error) {
+	if err := s.load(); err != nil {
+		return "", err
+	}
+	return s.address, nil
+}
+
+func (s *keystoreSigner) PublicKey() (string, error) {
+	if err := s.load(); err != nil {
+		return "", err
+	}
+	return s.publicKey, nil
+}
+
+func (s *keystoreSigner) SignTx(tx *pb.Transaction) ([]byte, error) {
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	return signTxWithKey("", tx, s.privateKey)
+}
+
+func (s *keystoreSigner) SignSelectUtxo(bcName, address, totalNeed string) ([]byte, error) {
+	return s.signDigest(selectUtxoDigest(bcName, address, totalNeed))
+}
+
+func (s *keystoreSigner) signDigest(digest []byte) ([]byte, error) {
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	return signDigestWithKey("", digest, s.privateKey)
+}
+
+// remoteSigner 通过签名服务签名，CLI进程不接触私钥
+type remoteSigner struct {
+	host    string
+	tls     TLSOptions
+	timeout time.Duration
+
+	mutex  sync.Mutex
+	conn   *grpc.ClientConn
+	client pb.XsignerClient
+	info   *pb.SignerInfoResponse
+}
+
+// NewRemoteSigner new signer of xsigner service, timeoutMs <= 0 means the default timeout
+func NewRemoteSigner(host string, tls TLSOptions, timeoutMs int) Signer {
+	if timeoutMs <= 0 {
+		timeoutMs = defaultSignerTimeoutMs
+	}
+	return &remoteSigner{
+		host:    host,
+		tls:     tls,
+		timeout: time.Duration(timeoutMs) * time.Millisecond,
+	}
+}
+
+func (s *remoteSigner) getClient() (pb.XsignerClient, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.client != nil {
+		return s.client, nil
+	}
+	conn, err := dialNode(context.Background(), s.host, s.tls)
+	if err != nil {
+		return nil, fmt.Errorf("connect signer %s failed.err:%w", s.host, err)
+	}
+	s.conn = conn
+	s.client = pb.NewXsignerClient(conn)
+	return s.client, nil
+}
+
+func (s *remoteSigner) signerInfo() (*pb.SignerInfoResponse, error) {
+	client, err := s.getClient()
+	if err != nil {
+		return nil, err
+	}
+	s.mutex.Lock()
+	info := s.info
+	s.mutex.Unlock()
+	if info != nil {
+		return info, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	info, err = client.GetSignerInfo(ctx, &pb.SignerInfoRequest{Header: &pb.Header{Logid: utils.GenLogId()}})
+	if err != nil {
+		return nil, fmt.Errorf("get signer info from %s failed.err:%w", s.host, err)
+	}
+	s.mutex.Lock()
+	s.info = info
+	s.mutex.Unlock()
+	return info, nil
+}
+
+func (s *remoteSigner) Address() (string, error) {
+	info, err := s.signerInfo()
+	if err != nil {
+		return "", err
+	}
+	return info.Address, nil
+}
+
+func (s *remoteSigner) PublicKey() (string, error) {
+	info, err := s.signerInfo()
+	if err != nil {
+		return "", err
+	}
+	return info.PublicKey, nil
+}
+
+func (s *remoteSigner) SignTx(tx *pb.Transaction) ([]byte, error) {
+	client, err := s.getClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	resp, err := client.SignTx(ctx, &pb.SignTxRequest{Header: &pb.Header{Logid: utils.GenLogId()}, Tx: tx})
+	if err != nil {
+		return nil, fmt.Errorf("sign tx by %s failed.err:%w", s.host, err)
+	}
+	return resp.Sign, nil
+}
+
+func (s *remoteSigner) SignSelectUtxo(bcName, address, totalNeed string) ([]byte, error) {
+	client, err := s.getClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	req := &pb.SignSelectUtxoRequest{
+		Header:    &pb.Header{Logid: utils.GenLogId()},
+		Bcname:    bcName,
+		Address:   address,
+		TotalNeed: totalNeed,
+	}
+	resp, err := client.SignSelectUtxo(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("sign select utxo by %s failed.err:%w", s.host, err)
+	}
+	return resp.Sign, nil
+}
+
+// selectUtxoDigest 锁定utxo的用户签名摘要，与节点SelectUTXO的校验一致
+func selectUtxoDigest(bcName, address, totalNeed string) []byte {
+	return cryptoHash.DoubleSha256([]byte(bcName + address + totalNeed + "true"))
+}
+
+// signTxWithKey 使用json格式私钥签名交易
+func signTxWithKey(cryptoType string, tx *pb.Transaction, jsonSk string) ([]byte, error) {
+	cryptoClient, err := newCryptoClient(cryptoType, jsonSk)
+	if err != nil {
+		return nil, err
+	}
+	return computeTxSign(cryptoClient, tx, jsonSk)
+}
+
+// signDigestWithKey 使用json格式私钥签名摘要
+func signDigestWithKey(cryptoType string, digest []byte, jsonSk string) ([]byte, error) {
+	cryptoClient, err := newCryptoClient(cryptoType, jsonSk)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := cryptoClient.GetEcdsaPrivateKeyFromJsonStr(jsonSk)
+	if err != nil {
+		return nil, err
+	}
+	return cryptoClient.SignECDSA(privateKey, digest)
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/xuperchain/xuperchain/service/common"
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+// SignerServer xsigner服务的参考实现，使用本地的Signer签名，私钥只保存在签名服务进程中
+type SignerServer struct {
+	signer Signer
+	logger *log.Logger
+}
+
+// NewSignerServer new xsigner server, every sign request is logged by logger
+func NewSignerServer(signer Signer, logger *log.Logger) *SignerServer {
+	return &SignerServer{
+		signer: signer,
+		logger: logger,
+	}
+}
+
+// GetSignerInfo get address and public key of the signer
+func (s *SignerServer) GetSignerInfo(ctx context.Context, req *pb.SignerInfoRequest) (*pb.SignerInfoResponse, error) {
+	address, err := s.signer.Address()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "read address failed.err:%v", err)
+	}
+	publicKey, err := s.signer.PublicKey()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "read public key failed.err:%v", err)
+	}
+	return &pb.SignerInfoResponse{
+		Header:    responseHeader(req.GetHeader()),
+		Address:   address,
+		PublicKey: publicKey,
+	}, nil
+}
+
+// SignTx sign the digest of tx
+func (s *SignerServer) SignTx(ctx context.Context, req *pb.SignTxRequest) (*pb.SignResponse, error) {
+	if req.GetTx() == nil {
+		return nil, status.Error(codes.InvalidArgument, "tx is required")
+	}
+	digest, err := common.MakeTxDigestHash(req.GetTx())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "make tx digest failed.err:%v", err)
+	}
+	s.logger.Printf("sign tx. logid:%s client:%s initiator:%s authRequire:%v digest:%x",
+		req.GetHeader().GetLogid(), clientAddr(ctx), req.GetTx().GetInitiator(), req.GetTx().GetAuthRequire(), digest)
+
+	sign, err := s.signer.SignTx(req.GetTx())
+	if err != nil {
+		s.logger.Printf("sign tx failed. logid:%s err:%v", req.GetHeader().GetLogid(), err)
+		return nil, status.Errorf(codes.Internal, "sign tx failed.err:%v", err)
+	}
+	return &pb.SignResponse{Header: responseHeader(req.GetHeader()), Sign: sign}, nil
+}
+
+// SignSelectUtxo sign the select utxo request of the signer account, the digest is made by the server
+func (s *SignerServer) SignSelectUtxo(ctx context.Context, req *pb.SignSelectUtxoRequest) (*pb.SignResponse, error) {
+	address, err := s.signer.Address()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "read address failed.err:%v", err)
+	}
+	if req.GetBcname() == "" || req.GetTotalNeed() == "" {
+		return nil, status.Error(codes.InvalidArgument, "bcname and totalNeed are required")
+	}
+	if req.GetAddress() != address {
+		return nil, status.Errorf(codes.PermissionDenied, "address %s is not the signer address %s", req.GetAddress(), address)
+	}
+	s.logger.Printf("sign select utxo. logid:%s client:%s bcname:%s address:%s totalNeed:%s",
+		req.GetHeader().GetLogid(), clientAddr(ctx), req.GetBcname(), req.GetAddress(), req.GetTotalNeed())
+
+	sign, err := s.signer.SignSelectUtxo(req.GetBcname(), req.GetAddress(), req.GetTotalNeed())
+	if err != nil {
+		s.logger.Printf("sign select utxo failed. logid:%s err:%v", req.GetHeader().GetLogid(), err)
+		return nil, status.Errorf(codes.Internal, "sign select utxo failed.err:%v", err)
+	}
+	return &pb.SignResponse{Header: responseHeader(req.GetHeader()), Sign: sign}, nil
+}
+
+func responseHeader(header *pb.Header) *pb.Header {
+	return &pb.Header{Logid: header.GetLogid()}
+}
+
+func clientAddr(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
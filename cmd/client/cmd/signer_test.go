@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+// newTestKeys 在临时目录中创建账户，返回密钥目录
+func newTestKeys(t *testing.T, workspace, cryptoType string) string {
+	keys := filepath.Join(workspace, "keys")
+	newkeys := NewAccountNewkeysCommand(newTestCli(cryptoType, keys))
+	newkeys.SetArgs([]string{"-o", keys, "--strength", "1", "--lang", "en"})
+	if err := newkeys.Execute(); err != nil {
+		t.Fatalf("%s newkeys failed: %v", cryptoType, err)
+	}
+	return keys
+}
+
+func TestKeystoreSigner(t *testing.T) {
+	os.Setenv(keyPasswordEnv, "test-password")
+	defer os.Unsetenv(keyPasswordEnv)
+
+	workspace, err := ioutil.TempDir("", "xchain-cli-signer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(workspace)
+	keys := newTestKeys(t, workspace, "default")
+
+	// keystore只支持默认密码学插件
+	keystore := filepath.Join(workspace, "keystore.json")
+	export := NewAccountExportCommand(newTestCli("default", keys))
+	export.SetArgs([]string{"--file", keystore, "--light"})
+	if err := export.Execute(); err != nil {
+		t.Fatalf("export keystore failed: %v", err)
+	}
+
+	signer := NewKeystoreSigner(keystore)
+	address := readTestFile(t, keys, "address")
+	if got, err := signer.Address(); err != nil || got != address {
+		t.Fatalf("keystore signer address %s, expect %s, err: %v", got, address, err)
+	}
+	testSignAndVerify(t, "default", signer, readTestFile(t, keys, "public.key"), address)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	defer viper.Set("signer", nil)
+	defer viper.Set("keys", nil)
+
+	for _, cryptoType := range testCryptoTypes {
+		workspace, err := ioutil.TempDir("", "xchain-cli-signer")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(workspace)
+		keys := newTestKeys(t, workspace, cryptoType)
+		address := readTestFile(t, keys, "address")
+
+		// 签名服务使用密钥目录中的私钥，通过unix socket提供服务
+		sock := filepath.Join(workspace, "signer.sock")
+		lis, err := net.Listen("unix", sock)
+		if err != nil {
+			t.Fatal(err)
+		}
+		server := grpc.NewServer()
+		pb.RegisterXsignerServer(server, NewSignerServer(NewFileSigner(keys, "default"),
+			log.New(ioutil.Discard, "", 0)))
+		go server.Serve(lis)
+		defer server.Stop()
+
+		// --keys指定的账户使用配置的签名服务，其他目录仍使用本地私钥
+		viper.Set("keys", keys)
+		viper.Set("signer", map[string]interface{}{"type": signerTypeRemote, "host": "unix:" + sock})
+		signer, err := accountSigner(keys, "default")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := signer.(*remoteSigner); !ok {
+			t.Fatalf("signer of --keys is %T, expect remote signer", signer)
+		}
+		if other, _ := accountSigner(workspace, "default"); other == signer {
+			t.Fatalf("signer of other directory should not be the configured signer")
+		}
+		if got, err := readAddress(keys); err != nil || got != address {
+			t.Fatalf("%s remote signer address %s, expect %s, err: %v", cryptoType, got, address, err)
+		}
+		testSignAndVerify(t, cryptoType, signer, readTestFile(t, keys, "public.key"), address)
+
+		// 签名服务只为签名账户锁定utxo签名
+		if _, err := signer.SignSelectUtxo("xuper", "otheraddress", "100"); err == nil {
+			t.Fatalf("%s remote signer should refuse select utxo of other address", cryptoType)
+		}
+	}
+}
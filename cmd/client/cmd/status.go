@@ -6,9 +6,6 @@ package cmd
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
-	"fmt"
 
 	"github.com/spf13/cobra"
 
@@ -62,19 +59,14 @@ func (s *StatusCommand) printXchainStatus(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
-		return errors.New(reply.Header.Error.String())
+	if err := headerError(reply.Header); err != nil {
+		return err
 	}
 	status := FromSystemStatusPB(reply.GetSystemsStatus())
-	if s.extractSpecificInfo(status) {
-		return nil
-	}
-	output, err := json.MarshalIndent(status, "", "  ")
-	if err != nil {
-		fmt.Println(err)
+	if handled, err := s.extractSpecificInfo(status); handled {
+		return err
 	}
-	fmt.Println(string(output))
-	return nil
+	return s.cli.PrintOutput(status)
 }
 
 // convert to flag(viewOption)
@@ -92,8 +84,7 @@ func (s *StatusCommand) convertToFlag() pb.ViewOption {
 	}
 }
 
-func (s *StatusCommand) extractSpecificInfo(status *SystemStatus) bool {
-	handled := false
+func (s *StatusCommand) extractSpecificInfo(status *SystemStatus) (bool, error) {
 	if s.ledger {
 		type LedgerInfo struct {
 			Name       string     `json:"name"`
@@ -106,12 +97,7 @@ func (s *StatusCommand) extractSpecificInfo(status *SystemStatus) bool {
 				LedgerMeta: chainStatus.LedgerMeta,
 			})
 		}
-		output, err := json.MarshalIndent(ledgerInfos, "", "  ")
-		if err != nil {
-			fmt.Println(err)
-		}
-		fmt.Println(string(output))
-		handled = true
+		return true, s.cli.PrintOutput(ledgerInfos)
 	} else if s.utxo {
 		type UtxoMetaInfo struct {
 			Name     string   `json:"name"`
@@ -124,12 +110,7 @@ func (s *StatusCommand) extractSpecificInfo(status *SystemStatus) bool {
 				UtxoMeta: chainStatus.UtxoMeta,
 			})
 		}
-		output, err := json.MarshalIndent(utxoMetaInfos, "", "  ")
-		if err != nil {
-			fmt.Println(err)
-		}
-		fmt.Println(string(output))
-		handled = true
+		return true, s.cli.PrintOutput(utxoMetaInfos)
 	} else if s.branch {
 		type BranchInfo struct {
 			Name             string   `json:"name"`
@@ -144,22 +125,12 @@ func (s *StatusCommand) extractSpecificInfo(status *SystemStatus) bool {
 				BranchBlockid:    chainStatus.BranchBlockid,
 			})
 		}
-		output, err := json.MarshalIndent(branchInfos, "", "  ")
-		if err != nil {
-			fmt.Println(err)
-		}
-		fmt.Println(string(output))
-		handled = true
+		return true, s.cli.PrintOutput(branchInfos)
 	} else if s.peers {
 		peers := status.Peers
-		output, err := json.MarshalIndent(peers, "", "  ")
-		if err != nil {
-			fmt.Println(err)
-		}
-		fmt.Println(string(output))
-		handled = true
+		return true, s.cli.PrintOutput(peers)
 	}
-	return handled
+	return false, nil
 }
 
 func init() {
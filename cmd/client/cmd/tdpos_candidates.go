@@ -6,8 +6,6 @@ package cmd
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 
 	"github.com/spf13/cobra"
 
@@ -44,11 +42,5 @@ func (c *QueryCandidatesCommand) queryCandidates(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	output, err := json.MarshalIndent(res.CandidatesInfo, "", "  ")
-	if err != nil {
-		fmt.Println(err)
-		return err
-	}
-	fmt.Println(string(output))
-	return nil
+	return c.cli.PrintOutput(res.CandidatesInfo)
 }
@@ -6,7 +6,6 @@ package cmd
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/spf13/cobra"
 
@@ -50,7 +49,12 @@ func (c *QueryCheckResultCommand) queryCheckResult(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	fmt.Printf("term:%d\n", c.term)
-	fmt.Printf("checkResult:%v\n", response.CheckResult)
-	return nil
+	type CheckResult struct {
+		Term        int64    `json:"term"`
+		CheckResult []string `json:"checkResult"`
+	}
+	return c.cli.PrintOutput(&CheckResult{
+		Term:        c.term,
+		CheckResult: response.CheckResult,
+	})
 }
@@ -6,8 +6,6 @@ package cmd
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 
 	"github.com/spf13/cobra"
 
@@ -52,11 +50,5 @@ func (c *QueryNominateRecordsCommand) queryNominateRecords(ctx context.Context)
 	if err != nil {
 		return err
 	}
-	output, err := json.MarshalIndent(response.NominateRecords, "", "  ")
-	if err != nil {
-		fmt.Println(err)
-		return err
-	}
-	fmt.Println(string(output))
-	return nil
+	return c.cli.PrintOutput(response.NominateRecords)
 }
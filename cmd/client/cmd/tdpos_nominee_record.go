@@ -6,7 +6,6 @@ package cmd
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/spf13/cobra"
 
@@ -51,7 +50,12 @@ func (c *QueryNomineeRecordsCommand) queryNomineeRecord(ctx context.Context) err
 		return err
 	}
 
-	fmt.Printf("nominee:%s\n", c.addr)
-	fmt.Printf("txid:%s\n", response.Txid)
-	return nil
+	type NomineeRecord struct {
+		Nominee string `json:"nominee"`
+		Txid    string `json:"txid"`
+	}
+	return c.cli.PrintOutput(&NomineeRecord{
+		Nominee: c.addr,
+		Txid:    response.Txid,
+	})
 }
@@ -3,8 +3,6 @@ package cmd
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 
 	"github.com/spf13/cobra"
 
@@ -41,11 +39,5 @@ func (c *QueryStatusCommand) queryConsensusStatus(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	output, err := json.MarshalIndent(response.GetStatus(), "", "  ")
-	if err != nil {
-		fmt.Println(err)
-		return err
-	}
-	fmt.Println(string(output))
-	return nil
+	return c.cli.PrintOutput(response.GetStatus())
 }
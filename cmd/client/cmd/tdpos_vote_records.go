@@ -6,7 +6,6 @@ package cmd
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/spf13/cobra"
@@ -53,11 +52,5 @@ func (c *QueryVoteRecordsCommand) queryVoteRecords(ctx context.Context) error {
 		return err
 	}
 
-	output, err := json.MarshalIndent(response.VoteTxidRecords, "", "  ")
-	if err != nil {
-		fmt.Println(err)
-		return err
-	}
-	fmt.Println(string(output))
-	return nil
+	return c.cli.PrintOutput(response.VoteTxidRecords)
 }
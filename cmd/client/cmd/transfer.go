@@ -7,10 +7,11 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io/ioutil"
-	"path/filepath"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -61,6 +62,9 @@ type TransferCommand struct {
 	from        string
 	accountPath string
 	debug       bool
+
+	wait        int64
+	waitTimeout time.Duration
 }
 
 // NewTransferCommand new transfer cmd
@@ -83,33 +87,59 @@ func (t *TransferCommand) addFlags() {
 	t.cmd.Flags().StringVar(&t.to, "to", "", "common transfer transaction to whom")
 	t.cmd.Flags().StringVar(&t.amount, "amount", "0", "transfer tokens")
 	t.cmd.Flags().StringVar(&t.descfile, "desc", "", "desc file of tx, eg. contract or tdpos consensus")
-	t.cmd.Flags().StringVar(&t.fee, "fee", "0", "fee of one tx")
+	t.cmd.Flags().StringVar(&t.fee, "fee", "0", "fee of one tx, auto to estimate by node")
 	t.cmd.Flags().Int64Var(&t.frozenHeight, "frozen", 0, "frozen height of one tx")
 	t.cmd.Flags().Int32Var(&t.version, "txversion", utxo.TxVersion, "tx version")
 	t.cmd.Flags().StringVar(&t.from, "from", "", "account name")
 	t.cmd.Flags().StringVar(&t.accountPath, "accountPath", "", "key path of account")
 	t.cmd.Flags().BoolVar(&t.debug, "debug", false, "debug print tx instead of posting")
+	addWaitFlags(t.cmd.Flags(), &t.wait, &t.waitTimeout)
 }
 
 func readKeys(file string) (string, error) {
+	if keyCache != nil {
+		if key, ok := keyCache.Load(file); ok {
+			return key.(string), nil
+		}
+	}
 	buf, err := ioutil.ReadFile(file)
 	if err != nil {
 		return "", err
 	}
 	buf = bytes.TrimSpace(buf)
+	if keyCache != nil {
+		keyCache.Store(file, string(buf))
+	}
 	return string(buf), nil
 }
 
+// readAddress 读取签名账户地址，--keys指定的账户使用配置的签名方式
 func readAddress(keypath string) (string, error) {
-	return readKeys(filepath.Join(keypath, "address"))
+	signer, err := accountSigner(keypath, "")
+	if err != nil {
+		return "", err
+	}
+	return signer.Address()
 }
 
 func readPublicKey(keypath string) (string, error) {
-	return readKeys(filepath.Join(keypath, "public.key"))
+	signer, err := accountSigner(keypath, "")
+	if err != nil {
+		return "", err
+	}
+	return signer.PublicKey()
 }
 
+// readPrivateKey 读取私钥，加密的私钥在内存中解密
 func readPrivateKey(keypath string) (string, error) {
-	return readKeys(filepath.Join(keypath, "private.key"))
+	key, err := hdIndexKey(keypath)
+	if err != nil {
+		return "", err
+	}
+	if key != nil {
+		return key.PrivateKey, nil
+	}
+	return privateKeyFile.read(keypath)
 }
 
 type invokeRequestWraper struct {
@@ -157,7 +187,17 @@ func (t *TransferCommand) transfer(ctx context.Context) error {
 		return err
 	}
 	fmt.Printf("%s\n", txid)
-	return nil
+	if t.wait <= 0 || t.debug {
+		return nil
+	}
+
+	rawTxid, _ := hex.DecodeString(txid)
+	status, err := waitTxConfirmed(ctx, t.cli.XchainClient(), t.cli.EventClient(),
+		opt.BlockchainName, rawTxid, t.wait, t.waitTimeout)
+	if status != nil {
+		printTxStatus(status)
+	}
+	return err
 }
 
 func init() {
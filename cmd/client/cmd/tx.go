@@ -16,9 +16,11 @@ type TxCommand struct {
 func NewTxCommand(cli *Cli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "tx",
-		Short: "Operate tx command, query",
+		Short: "Operate tx command, query, proof, status",
 	}
 	cmd.AddCommand(NewTxQueryCommand(cli))
+	cmd.AddCommand(NewTxProofCommand(cli))
+	cmd.AddCommand(NewTxStatusCommand(cli))
 	return cmd
 }
 
@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/xuperchain/xuperchain/service/common"
+	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xupercore/lib/utils"
+)
+
+// TxProofCommand tx merkle proof cmd
+type TxProofCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+}
+
+// NewTxProofCommand new tx proof cmd
+func NewTxProofCommand(cli *Cli) *cobra.Command {
+	t := new(TxProofCommand)
+	t.cli = cli
+	t.cmd = &cobra.Command{
+		Use:   "proof txid",
+		Short: "query and verify the merkle inclusion proof of transaction",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return t.queryProof(ctx, args[0])
+		},
+	}
+	return t.cmd
+}
+
+func (t *TxProofCommand) queryProof(ctx context.Context, txid string) error {
+	client := t.cli.XchainClient()
+	rawTxid, err := hex.DecodeString(txid)
+	if err != nil {
+		return fmt.Errorf("bad txid:%s", txid)
+	}
+	req := &pb.TxProofRequest{
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+		Bcname: t.cli.RootOptions.Name,
+		Txid:   rawTxid,
+	}
+	reply, err := client.GetTxProof(ctx, req)
+	if err != nil {
+		return err
+	}
+	if err := headerError(reply.Header); err != nil {
+		return err
+	}
+
+	if err := t.cli.PrintOutput(FromTxProofPB(reply)); err != nil {
+		return err
+	}
+
+	// 服务端返回的txid不可信，使用请求的txid校验
+	reply.Txid = rawTxid
+	if err := common.VerifyTxProof(reply); err != nil {
+		return fmt.Errorf("verify tx proof failed.err:%v", err)
+	}
+	fmt.Fprintf(os.Stderr, "Tx %s is included in block %x at height %d\n",
+		txid, reply.BlockHeader.Blockid, reply.BlockHeader.Height)
+	return nil
+}
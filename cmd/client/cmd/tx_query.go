@@ -7,7 +7,6 @@ package cmd
 import (
 	"context"
 	"encoding/hex"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -69,8 +68,8 @@ func (t *TxQueryCommand) queryTx(ctx context.Context, txid string) error {
 		return err
 	}
 
-	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
-		return errors.New(reply.Header.Error.String())
+	if err := headerError(reply.Header); err != nil {
+		return err
 	}
 	if reply.Tx == nil {
 		return errors.New("tx not found")
@@ -84,10 +83,5 @@ func (t *TxQueryCommand) queryTx(ctx context.Context, txid string) error {
 			return err
 		}
 	}
-	output, err := json.MarshalIndent(tx, "", "  ")
-	if err != nil {
-		fmt.Println(err)
-	}
-	fmt.Println(string(output))
-	return nil
+	return t.cli.PrintOutput(tx)
 }
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// TxStatusCommand tx status cmd
+type TxStatusCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	wait        int64
+	waitTimeout time.Duration
+}
+
+// NewTxStatusCommand new tx status cmd
+func NewTxStatusCommand(cli *Cli) *cobra.Command {
+	t := new(TxStatusCommand)
+	t.cli = cli
+	t.cmd = &cobra.Command{
+		Use:   "status txid",
+		Short: "query lifecycle status of transaction: unknown, pending, confirmed or failed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			return t.queryStatus(ctx, args[0])
+		},
+	}
+	addWaitFlags(t.cmd.Flags(), &t.wait, &t.waitTimeout)
+	return t.cmd
+}
+
+func (t *TxStatusCommand) queryStatus(ctx context.Context, txid string) error {
+	rawTxid, err := hex.DecodeString(txid)
+	if err != nil {
+		return fmt.Errorf("bad txid:%s", txid)
+	}
+	if t.wait > 0 {
+		status, err := waitTxConfirmed(ctx, t.cli.XchainClient(), t.cli.EventClient(),
+			t.cli.RootOptions.Name, rawTxid, t.wait, t.waitTimeout)
+		if status != nil {
+			if err := t.cli.PrintOutput(FromTxStatusPB(rawTxid, status)); err != nil {
+				return err
+			}
+		}
+		return err
+	}
+
+	status, err := queryTxStatus(ctx, t.cli.XchainClient(), t.cli.RootOptions.Name, rawTxid)
+	if err != nil {
+		return err
+	}
+	return t.cli.PrintOutput(FromTxStatusPB(rawTxid, status))
+}
@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/spf13/pflag"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xupercore/lib/utils"
+)
+
+const (
+	defaultWaitTimeout = 60 * time.Second
+	// 事件服务不可用时的轮询间隔
+	txPollInterval = time.Second
+)
+
+// addWaitFlags 添加等待交易确认的参数
+func addWaitFlags(flags *pflag.FlagSet, wait *int64, timeout *time.Duration) {
+	flags.Int64Var(wait, "wait", 0, "wait until the tx gets N confirmations, 0 means not to wait")
+	flags.DurationVar(timeout, "wait-timeout", defaultWaitTimeout, "timeout of --wait")
+}
+
+func queryTxStatus(ctx context.Context, client pb.XchainClient, bcname string,
+	txid []byte) (*pb.GetTxStatusResponse, error) {
+	req := &pb.GetTxStatusRequest{
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+		Bcname: bcname,
+		Txid:   txid,
+	}
+	reply, err := client.GetTxStatus(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := headerError(reply.Header); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// waitTxConfirmed 等待交易被主干区块包含且确认数达到confirmations，
+// 每收到一个新区块事件查询一次状态
+func waitTxConfirmed(ctx context.Context, client pb.XchainClient, eventClient pb.EventServiceClient,
+	bcname string, txid []byte, confirmations int64, timeout time.Duration) (*pb.GetTxStatusResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	notify := make(chan struct{}, 1)
+	go watchNewBlocks(ctx, eventClient, bcname, notify)
+
+	// 交易曾在节点上出现过，之后查不到说明被丢弃
+	var seen bool
+	for {
+		status, err := queryTxStatus(ctx, client, bcname, txid)
+		if err != nil {
+			return nil, err
+		}
+		switch status.State {
+		case pb.GetTxStatusResponse_FAILED:
+			return status, fmt.Errorf("tx failed: %s", status.Reason)
+		case pb.GetTxStatusResponse_CONFIRMED:
+			if status.Confirmations >= confirmations {
+				return status, nil
+			}
+			seen = true
+		case pb.GetTxStatusResponse_PENDING:
+			seen = true
+		case pb.GetTxStatusResponse_UNKNOWN:
+			if seen {
+				return status, errors.New("tx was dropped")
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, fmt.Errorf("wait for tx timeout, last state: %s", status.State)
+		case <-notify:
+		}
+	}
+}
+
+// watchNewBlocks 订阅区块事件，每个新区块通知一次；事件服务不可用时退化为定时轮询
+func watchNewBlocks(ctx context.Context, eventClient pb.EventServiceClient, bcname string, notify chan<- struct{}) {
+	if eventClient != nil {
+		filter := &pb.BlockFilter{
+			Bcname:    bcname,
+			ExcludeTx: true,
+		}
+		buf, _ := proto.Marshal(filter)
+		request := &pb.SubscribeRequest{
+			Type:   pb.SubscribeType_BLOCK,
+			Filter: buf,
+		}
+		stream, err := eventClient.Subscribe(ctx, request)
+		for err == nil {
+			if _, err = stream.Recv(); err == nil {
+				notifyNewBlock(notify)
+			}
+		}
+	}
+
+	ticker := time.NewTicker(txPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			notifyNewBlock(notify)
+		}
+	}
+}
+
+func notifyNewBlock(notify chan<- struct{}) {
+	select {
+	case notify <- struct{}{}:
+	default:
+	}
+}
+
+func printTxStatus(status *pb.GetTxStatusResponse) {
+	switch status.State {
+	case pb.GetTxStatusResponse_CONFIRMED:
+		fmt.Printf("Tx confirmed in block %x at height %d, confirmations: %d\n",
+			status.Blockid, status.Height, status.Confirmations)
+	case pb.GetTxStatusResponse_FAILED:
+		fmt.Printf("Tx failed in block %x at height %d, reason: %s\n", status.Blockid, status.Height, status.Reason)
+	case pb.GetTxStatusResponse_PENDING:
+		fmt.Printf("Tx pending, reason: %s\n", status.Reason)
+	default:
+		fmt.Println("Tx unknown")
+	}
+}
@@ -312,6 +312,69 @@ func FromInternalBlockPB(block *pb.InternalBlock) *InternalBlock {
 	return iblock
 }
 
+// TxProof proto.TxProof
+type TxProof struct {
+	Txid        HexID          `json:"txid"`
+	BlockHeader *InternalBlock `json:"blockHeader"`
+	TxIndex     int64          `json:"txIndex"`
+	MerklePath  []HexID        `json:"merklePath"`
+	Status      string         `json:"status"`
+	Distance    int64          `json:"distance"`
+	IsTrunkTip  bool           `json:"isTrunkTip"`
+}
+
+// FromTxProofPB tx merkle proof
+func FromTxProofPB(proof *pb.TxProof) *TxProof {
+	p := &TxProof{
+		Txid:       proof.Txid,
+		TxIndex:    proof.TxIndex,
+		Status:     proof.Status.String(),
+		Distance:   proof.Distance,
+		IsTrunkTip: proof.IsTrunkTip,
+	}
+	if proof.BlockHeader != nil {
+		p.BlockHeader = FromInternalBlockPB(proof.BlockHeader)
+	}
+	p.MerklePath = make([]HexID, len(proof.MerklePath))
+	for i := range proof.MerklePath {
+		p.MerklePath[i] = proof.MerklePath[i]
+	}
+	return p
+}
+
+// PendingTx proto.PendingTx
+type PendingTx struct {
+	Txid              HexID    `json:"txid"`
+	Initiator         string   `json:"initiator"`
+	ReceivedTimestamp int64    `json:"receivedTimestamp"`
+	Size              int64    `json:"size"`
+	Contracts         []string `json:"contracts,omitempty"`
+	Depends           []HexID  `json:"depends,omitempty"`
+}
+
+// FromPendingTxPB unconfirmed tx summary
+func FromPendingTxPB(tx *pb.PendingTx) *PendingTx {
+	ptx := &PendingTx{
+		Txid:              tx.Txid,
+		Initiator:         tx.Initiator,
+		ReceivedTimestamp: tx.ReceivedTimestamp,
+		Size:              tx.Size,
+		Contracts:         tx.Contracts,
+	}
+	for _, depend := range tx.Depends {
+		ptx.Depends = append(ptx.Depends, depend)
+	}
+	return ptx
+}
+
+// MempoolStats mempool statistics
+type MempoolStats struct {
+	Count           int64            `json:"count"`
+	Bytes           int64            `json:"bytes"`
+	OldestAgeMs     int64            `json:"oldestAgeMs"`
+	InitiatorCounts map[string]int64 `json:"initiatorCounts"`
+}
+
 // FromPBJustify use pb.QuorumCert to construct local QuorumCert in block
 func FromPBJustify(qc *pb.QuorumCert) *QuorumCert {
 	justify := &QuorumCert{}
@@ -528,3 +591,203 @@ func FromFilteredBlockPB(pbblock *pb.FilteredBlock) *FilteredBlock {
 	}
 	return block
 }
+
+// UtxoKey proto.UtxoKey
+type UtxoKey struct {
+	RefTxid string `json:"refTxid"`
+	Offset  string `json:"offset"`
+	Amount  string `json:"amount"`
+}
+
+// UtxoRecord proto.UtxoRecord
+type UtxoRecord struct {
+	UtxoCount  string     `json:"utxoCount"`
+	UtxoAmount string     `json:"utxoAmount"`
+	Item       []*UtxoKey `json:"item"`
+}
+
+// UtxoRecordDetail proto.UtxoRecordDetail
+type UtxoRecordDetail struct {
+	Bcname           string      `json:"bcname"`
+	AccountName      string      `json:"accountName"`
+	OpenUtxoRecord   *UtxoRecord `json:"openUtxoRecord"`
+	LockedUtxoRecord *UtxoRecord `json:"lockedUtxoRecord"`
+	FrozenUtxoRecord *UtxoRecord `json:"frozenUtxoRecord"`
+}
+
+// FromUtxoRecordDetailPB convert pb.UtxoRecordDetail to UtxoRecordDetail
+func FromUtxoRecordDetailPB(detail *pb.UtxoRecordDetail) *UtxoRecordDetail {
+	return &UtxoRecordDetail{
+		Bcname:           detail.Bcname,
+		AccountName:      detail.AccountName,
+		OpenUtxoRecord:   fromUtxoRecordPB(detail.OpenUtxoRecord),
+		LockedUtxoRecord: fromUtxoRecordPB(detail.LockedUtxoRecord),
+		FrozenUtxoRecord: fromUtxoRecordPB(detail.FrozenUtxoRecord),
+	}
+}
+
+func fromUtxoRecordPB(record *pb.UtxoRecord) *UtxoRecord {
+	if record == nil {
+		return nil
+	}
+	r := &UtxoRecord{
+		UtxoCount:  record.UtxoCount,
+		UtxoAmount: record.UtxoAmount,
+	}
+	for _, item := range record.Item {
+		r.Item = append(r.Item, &UtxoKey{
+			RefTxid: item.RefTxid,
+			Offset:  item.Offset,
+			Amount:  item.Amount,
+		})
+	}
+	return r
+}
+
+// ContractStatus proto.ContractStatus
+type ContractStatus struct {
+	ContractName string `json:"contractName"`
+	Txid         string `json:"txid"`
+	Desc         HexID  `json:"desc"`
+	IsBanned     bool   `json:"isBanned"`
+	Timestamp    int64  `json:"timestamp"`
+	Runtime      string `json:"runtime"`
+}
+
+// FromContractStatusPB convert pb.ContractStatus to ContractStatus
+func FromContractStatusPB(status *pb.ContractStatus) *ContractStatus {
+	return &ContractStatus{
+		ContractName: status.ContractName,
+		Txid:         status.Txid,
+		Desc:         status.Desc,
+		IsBanned:     status.IsBanned,
+		Timestamp:    status.Timestamp,
+		Runtime:      status.Runtime,
+	}
+}
+
+// ConsensusStatus proto.ConsensusStatus
+type ConsensusStatus struct {
+	Version        string `json:"version"`
+	ConsensusName  string `json:"consensusName"`
+	StartHeight    string `json:"startHeight"`
+	ValidatorsInfo string `json:"validatorsInfo"`
+}
+
+// PermissionModel proto.PermissionModel
+type PermissionModel struct {
+	Rule        string  `json:"rule"`
+	AcceptValue float64 `json:"acceptValue"`
+}
+
+// AkSets proto.AkSets
+type AkSets struct {
+	Sets       map[string][]string `json:"sets"`
+	Expression string              `json:"expression"`
+}
+
+// ACL proto.Acl with confirmed status
+type ACL struct {
+	Pm        *PermissionModel   `json:"pm"`
+	AksWeight map[string]float64 `json:"aksWeight"`
+	AkSets    *AkSets            `json:"akSets,omitempty"`
+	Confirmed bool               `json:"confirmed"`
+}
+
+// FromACLPB convert pb.Acl to ACL
+func FromACLPB(acl *pb.Acl, confirmed bool) *ACL {
+	a := &ACL{
+		AksWeight: acl.GetAksWeight(),
+		Confirmed: confirmed,
+	}
+	if pm := acl.GetPm(); pm != nil {
+		a.Pm = &PermissionModel{
+			Rule:        pm.Rule.String(),
+			AcceptValue: pm.AcceptValue,
+		}
+	}
+	if sets := acl.GetAkSets(); sets != nil {
+		a.AkSets = &AkSets{
+			Sets:       make(map[string][]string, len(sets.Sets)),
+			Expression: sets.Expression,
+		}
+		for name, set := range sets.Sets {
+			a.AkSets.Sets[name] = set.GetAks()
+		}
+	}
+	return a
+}
+
+// ContractResponse proto.ContractResponse
+type ContractResponse struct {
+	Status  int32  `json:"status"`
+	Message string `json:"message"`
+	Body    string `json:"body"`
+}
+
+// FromContractResponsePB convert pb.ContractResponse to ContractResponse
+func FromContractResponsePB(resp *pb.ContractResponse) *ContractResponse {
+	return &ContractResponse{
+		Status:  resp.GetStatus(),
+		Message: resp.GetMessage(),
+		Body:    string(resp.GetBody()),
+	}
+}
+
+// TxState proto.GetTxStatusResponse
+type TxState struct {
+	Txid          HexID  `json:"txid"`
+	State         string `json:"state"`
+	Blockid       HexID  `json:"blockid,omitempty"`
+	Height        int64  `json:"height"`
+	Confirmations int64  `json:"confirmations"`
+	Reason        string `json:"reason,omitempty"`
+}
+
+// FromTxStatusPB convert pb.GetTxStatusResponse to TxState
+func FromTxStatusPB(txid []byte, status *pb.GetTxStatusResponse) *TxState {
+	return &TxState{
+		Txid:          txid,
+		State:         status.State.String(),
+		Blockid:       status.Blockid,
+		Height:        status.Height,
+		Confirmations: status.Confirmations,
+		Reason:        status.Reason,
+	}
+}
+
+// Balance balance of an address or account
+type Balance struct {
+	Address string `json:"address"`
+	Bcname  string `json:"bcname"`
+	Balance string `json:"balance"`
+	Frozen  bool   `json:"frozen"`
+}
+
+// DerivedKey key derived from mnemonic or extended public key
+type DerivedKey struct {
+	Path      string `json:"path"`
+	Address   string `json:"address"`
+	PublicKey string `json:"publicKey,omitempty"`
+}
+
+// ExtendedPublicKey watch-only extended public key
+type ExtendedPublicKey struct {
+	Path string `json:"path"`
+	Xpub string `json:"xpub"`
+}
+
+// MessageSignature signature of a message signed by account sign
+type MessageSignature struct {
+	Address   string `json:"address"`
+	Crypto    string `json:"crypto"`
+	PublicKey string `json:"publicKey"`
+	Signature string `json:"signature"`
+}
+
+// SignatureVerification result of account verify
+type SignatureVerification struct {
+	Address string `json:"address"`
+	Crypto  string `json:"crypto"`
+	Valid   bool   `json:"valid"`
+}
@@ -6,8 +6,6 @@ package cmd
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 
 	"github.com/spf13/cobra"
 
@@ -57,11 +55,5 @@ func (c *ListUtxoCommand) queryUtxoRecords(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	output, err := json.MarshalIndent(response, "", "  ")
-	if err != nil {
-		return err
-	}
-	fmt.Println(string(output))
-
-	return nil
+	return c.cli.PrintOutput(FromUtxoRecordDetailPB(response))
 }
@@ -85,13 +85,14 @@ func (c *watchCommand) watch(ctx context.Context) error {
 
 func (c *watchCommand) printBlock(pbblock *pb.FilteredBlock) {
 	block := FromFilteredBlockPB(pbblock)
-	var buf []byte
 	if c.oneline {
-		buf, _ = json.Marshal(block)
-	} else {
-		buf, _ = json.MarshalIndent(block, "", "  ")
+		buf, _ := json.Marshal(block)
+		fmt.Println(string(buf))
+		return
+	}
+	if err := c.cli.PrintOutput(block); err != nil {
+		fmt.Println(err)
 	}
-	fmt.Println(string(buf))
 }
 
 func init() {
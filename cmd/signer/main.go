@@ -0,0 +1,231 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ *
+ * Usage: Reference signer service for xchain-cli, keys stay in this process.
+ *        ./xchain-signer --keys data/keys --listen unix:./data/signer.sock
+ *        ./xchain-signer --keystore data/keystore.json --listen 127.0.0.1:37300 \
+ *            --tls-cert server.crt --tls-key server.key --tls-client-ca ca.crt
+ */
+
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/xuperchain/xuperchain/cmd/client/cmd"
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+var (
+	Version   = ""
+	BuildTime = ""
+	CommitID  = ""
+)
+
+// signerOptions 签名服务的启动参数
+type signerOptions struct {
+	keys         string
+	keystore     string
+	crypto       string
+	listen       string
+	passwordFile string
+	tlsCert      string
+	tlsKey       string
+	tlsClientCA  string
+	insecureTCP  bool
+}
+
+func main() {
+	if err := NewSignerCommand().Execute(); err != nil {
+		log.Fatalf("start signer failed.err:%v", err)
+	}
+}
+
+// NewSignerCommand new xchain-signer command
+func NewSignerCommand() *cobra.Command {
+	opt := new(signerOptions)
+	rootCmd := &cobra.Command{
+		Use:   "xchain-signer",
+		Short: "xchain-signer is a reference signer service for xchain-cli.",
+		Long: `xchain-signer keeps private keys in a separate process and signs transactions
+for xchain-cli configured with signer.type remote. Only one of --keys and
+--keystore can be used. Every sign request is logged to stderr.
+
+Listen on a unix socket (unix:path) to restrict access by file permission,
+or on ip:port with --tls-cert, --tls-key and --tls-client-ca to accept only
+clients with certificates. Listening on ip:port without them is refused
+unless --insecure-tcp is set.`,
+		Version:       fmt.Sprintf("%s-%s %s", Version, CommitID, BuildTime),
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example:       "xchain-signer --keys ./data/keys --listen unix:./data/signer.sock",
+		RunE: func(c *cobra.Command, args []string) error {
+			return serve(opt)
+		},
+	}
+	flags := rootCmd.Flags()
+	flags.StringVar(&opt.keys, "keys", "", "directory of keys, same as xchain-cli --keys")
+	flags.StringVar(&opt.keystore, "keystore", "", "keystore json file, see xchain-cli account export")
+	flags.StringVar(&opt.crypto, "crypto", "default", "crypto type when it can not be detected from the private key")
+	flags.StringVar(&opt.listen, "listen", "unix:./data/signer.sock", "listen address, ip:port or unix:path")
+	flags.StringVar(&opt.passwordFile, "password-file", "", "file containing the password of encrypted private key or keystore")
+	flags.StringVar(&opt.tlsCert, "tls-cert", "", "server certificate, enable tls with --tls-key")
+	flags.StringVar(&opt.tlsKey, "tls-key", "", "server private key")
+	flags.StringVar(&opt.tlsClientCA, "tls-client-ca", "", "CA of client certificates, require client certificates when set")
+	flags.BoolVar(&opt.insecureTCP, "insecure-tcp", false, "allow listening on ip:port without client certificates, anyone who can connect can sign")
+	return rootCmd
+}
+
+func serve(opt *signerOptions) error {
+	signer, err := newSigner(opt)
+	if err != nil {
+		return err
+	}
+	// 启动前解密私钥，需要输入密码时在启动时输入
+	address, err := signer.Address()
+	if err != nil {
+		return fmt.Errorf("load key failed.err:%v", err)
+	}
+	if _, err := signer.PublicKey(); err != nil {
+		return fmt.Errorf("load key failed.err:%v", err)
+	}
+
+	logger := log.New(os.Stderr, "", log.LstdFlags)
+	var serverOpts []grpc.ServerOption
+	if opt.tlsCert != "" || opt.tlsKey != "" {
+		creds, err := serverCreds(opt)
+		if err != nil {
+			return err
+		}
+		serverOpts = append(serverOpts, grpc.Creds(creds))
+	} else if opt.tlsClientCA != "" {
+		return errors.New("--tls-client-ca requires --tls-cert and --tls-key")
+	}
+	if err := checkListen(opt, logger); err != nil {
+		return err
+	}
+
+	lis, err := listen(opt.listen)
+	if err != nil {
+		return err
+	}
+	server := grpc.NewServer(serverOpts...)
+	pb.RegisterXsignerServer(server, cmd.NewSignerServer(signer, logger))
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigs
+		logger.Printf("signer is stopping")
+		server.GracefulStop()
+	}()
+
+	logger.Printf("signer of %s is listening on %s", address, opt.listen)
+	return server.Serve(lis)
+}
+
+func newSigner(opt *signerOptions) (cmd.Signer, error) {
+	if opt.passwordFile != "" {
+		// 与xchain-cli的--password-file一致，解密时读取
+		viper.Set("passwordFile", opt.passwordFile)
+	}
+	switch {
+	case opt.keys != "" && opt.keystore != "":
+		return nil, errors.New("--keys and --keystore can not be used together")
+	case opt.keys != "":
+		return cmd.NewFileSigner(opt.keys, opt.crypto), nil
+	case opt.keystore != "":
+		return cmd.NewKeystoreSigner(opt.keystore), nil
+	default:
+		return nil, errors.New("one of --keys and --keystore is required")
+	}
+}
+
+// checkListen 监听ip:port时要求TLS双向认证，否则任何能连接的客户端都可以签名；
+// --insecure-tcp可以跳过检查，此时输出警告
+func checkListen(opt *signerOptions, logger *log.Logger) error {
+	if strings.HasPrefix(opt.listen, "unix:") || (opt.tlsCert != "" && opt.tlsClientCA != "") {
+		return nil
+	}
+	if !opt.insecureTCP {
+		return fmt.Errorf("listen on %s requires --tls-cert, --tls-key and --tls-client-ca, "+
+			"use unix:path or set --insecure-tcp to listen without client certificates", opt.listen)
+	}
+	logger.Printf("WARNING: signer is listening on %s without client certificates, "+
+		"ANYONE WHO CAN CONNECT CAN SIGN WITH THIS KEY. Use it only for testing.", opt.listen)
+	return nil
+}
+
+// listen 监听ip:port或unix:path，unix socket只允许当前用户访问
+func listen(addr string) (net.Listener, error) {
+	if !strings.HasPrefix(addr, "unix:") {
+		lis, err := net.Listen("tcp", addr)
+		if err != nil {
+			return nil, fmt.Errorf("listen %s failed.err:%v", addr, err)
+		}
+		return lis, nil
+	}
+
+	path := strings.TrimPrefix(strings.TrimPrefix(addr, "unix:"), "//")
+	// 清理上次异常退出遗留的socket文件
+	if fi, err := os.Stat(path); err == nil && fi.Mode()&os.ModeSocket != 0 {
+		os.Remove(path)
+	}
+	// socket文件创建时即为0600，避免Listen后再chmod之间被其他用户连接
+	mask := syscall.Umask(0177)
+	lis, err := net.Listen("unix", path)
+	syscall.Umask(mask)
+	if err != nil {
+		return nil, fmt.Errorf("listen %s failed.err:%v", addr, err)
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		lis.Close()
+		return nil, fmt.Errorf("stat %s failed.err:%v", path, err)
+	}
+	if fi.Mode().Perm() != 0600 {
+		lis.Close()
+		return nil, fmt.Errorf("permission of %s is %o, expect 600", path, fi.Mode().Perm())
+	}
+	return lis, nil
+}
+
+// serverCreds 服务端TLS凭证，指定--tls-client-ca时使用双向认证
+func serverCreds(opt *signerOptions) (credentials.TransportCredentials, error) {
+	certificate, err := tls.LoadX509KeyPair(opt.tlsCert, opt.tlsKey)
+	if err != nil {
+		return nil, fmt.Errorf("load server cert failed.err:%v", err)
+	}
+	conf := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+	}
+	if opt.tlsClientCA != "" {
+		bs, err := ioutil.ReadFile(opt.tlsClientCA)
+		if err != nil {
+			return nil, fmt.Errorf("read client ca failed.err:%v", err)
+		}
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(bs) {
+			return nil, fmt.Errorf("no valid certificate in %s", opt.tlsClientCA)
+		}
+		conf.ClientCAs = certPool
+		conf.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return credentials.NewTLS(conf), nil
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+)
+
+func TestListenUnixPermission(t *testing.T) {
+	// 宽松的umask下socket文件仍只允许当前用户访问
+	mask := syscall.Umask(0)
+	defer syscall.Umask(mask)
+
+	path := filepath.Join(t.TempDir(), "signer.sock")
+	lis, err := listen("unix:" + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Mode().Perm() != 0600 {
+		t.Fatalf("socket permission is %o, expect 600", fi.Mode().Perm())
+	}
+	if restored := syscall.Umask(0); restored != 0 {
+		t.Fatalf("umask is %o after listen, expect 0 restored", restored)
+	}
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2021, Baidu.com, Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	sconf "github.com/xuperchain/xuperchain/service/config"
+	econf "github.com/xuperchain/xupercore/kernel/common/xconfig"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// CheckConfigCommand check node config cmd
+type CheckConfigCommand struct {
+	BaseCmd
+	// 环境配置文件
+	EnvConf string
+}
+
+// GetCheckConfigCommand new check config cmd
+func GetCheckConfigCommand() *CheckConfigCommand {
+	c := new(CheckConfigCommand)
+	c.Cmd = &cobra.Command{
+		Use:     "checkConfig",
+		Short:   "Check server config and report every problem found.",
+		Example: "xchain checkConfig --conf ./conf/env.yaml",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.check(os.Stdout, cmd.Flags())
+		},
+	}
+
+	c.Cmd.Flags().StringVarP(&c.EnvConf,
+		"conf", "c", "./conf/env.yaml", "engine environment config file path")
+	sconf.AddServConfFlags(c.Cmd.Flags())
+
+	return c
+}
+
+func (c *CheckConfigCommand) check(w io.Writer, flags *pflag.FlagSet) error {
+	envConf, err := econf.LoadEnvConf(c.EnvConf)
+	if err != nil {
+		return err
+	}
+
+	servConfPath := envConf.GenConfFilePath(envConf.ServConf)
+	tlsPath := envConf.GenDataAbsPath(envConf.TlsDir)
+	err = sconf.CheckServConf(servConfPath, tlsPath, flags)
+	if err == nil {
+		fmt.Fprintf(w, "server config %s is ok\n", servConfPath)
+		return nil
+	}
+
+	errs, ok := err.(sconf.ConfErrors)
+	if !ok {
+		return err
+	}
+	fmt.Fprintf(w, "server config %s has %d problem(s):\n", servConfPath, len(errs))
+	for i, e := range errs {
+		fmt.Fprintf(w, "  %d. %v\n", i+1, e)
+	}
+	return fmt.Errorf("check server config failed")
+}
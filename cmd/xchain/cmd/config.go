@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2021, Baidu.com, Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	sconf "github.com/xuperchain/xuperchain/service/config"
+	econf "github.com/xuperchain/xupercore/kernel/common/xconfig"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ConfigCommand config cmd
+type ConfigCommand struct {
+	BaseCmd
+}
+
+// GetConfigCommand new config cmd
+func GetConfigCommand() *ConfigCommand {
+	c := new(ConfigCommand)
+	c.Cmd = &cobra.Command{
+		Use:   "config",
+		Short: "Operate node config.",
+	}
+	c.Cmd.AddCommand(GetConfigDumpCommand().GetCmd())
+
+	return c
+}
+
+// ConfigDumpCommand dump effective server config cmd
+type ConfigDumpCommand struct {
+	BaseCmd
+	// 环境配置文件
+	EnvConf string
+}
+
+// GetConfigDumpCommand new config dump cmd
+func GetConfigDumpCommand() *ConfigDumpCommand {
+	c := new(ConfigDumpCommand)
+	c.Cmd = &cobra.Command{
+		Use:     "dump",
+		Short:   "Print the effective server config and where each value came from.",
+		Example: "xchain config dump --conf ./conf/env.yaml --rpcPort 37102",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.dump(os.Stdout, cmd.Flags())
+		},
+	}
+
+	c.Cmd.Flags().StringVarP(&c.EnvConf,
+		"conf", "c", "./conf/env.yaml", "engine environment config file path")
+	sconf.AddServConfFlags(c.Cmd.Flags())
+
+	return c
+}
+
+func (c *ConfigDumpCommand) dump(w io.Writer, flags *pflag.FlagSet) error {
+	envConf, err := econf.LoadEnvConf(c.EnvConf)
+	if err != nil {
+		return err
+	}
+
+	servConfPath := envConf.GenConfFilePath(envConf.ServConf)
+	servConf, err := sconf.LoadServConfWithFlags(servConfPath, flags)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "# server config: %s\n", servConfPath)
+	for _, item := range servConf.Items() {
+		// json编码的值同时也是合法的yaml
+		value, err := json.Marshal(item.Value)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%s: %s # %s\n", item.Key, value, item.Source)
+	}
+
+	return nil
+}
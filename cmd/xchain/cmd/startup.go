@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
 	"os/signal"
 	"sync"
@@ -28,7 +29,11 @@ import (
 	_ "github.com/xuperchain/xupercore/lib/crypto/client"
 	_ "github.com/xuperchain/xupercore/lib/storage/kvdb/leveldb"
 
+	// import要使用的背书模块
+	_ "github.com/xuperchain/xuperchain/service/endorser/policy"
+
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 type StartupCmd struct {
@@ -48,21 +53,23 @@ func GetStartupCmd() *StartupCmd {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return StartupXchain(envCfgPath)
+			return StartupXchain(envCfgPath, cmd.Flags())
 		},
 	}
 
 	// 设置命令行参数并绑定变量
 	startupCmdIns.Cmd.Flags().StringVarP(&envCfgPath, "conf", "c", "",
 		"engine environment config file path")
+	// 服务配置覆盖参数
+	sconf.AddServConfFlags(startupCmdIns.Cmd.Flags())
 
 	return startupCmdIns
 }
 
 // 启动节点
-func StartupXchain(envCfgPath string) error {
+func StartupXchain(envCfgPath string, flags *pflag.FlagSet) error {
 	// 加载基础配置
-	envConf, servConf, err := loadConf(envCfgPath)
+	envConf, servConf, err := loadConf(envCfgPath, flags)
 	if err != nil {
 		return err
 	}
@@ -88,7 +95,7 @@ func StartupXchain(envCfgPath string) error {
 	servChan := runServ(serv)
 
 	// 阻塞等待进程退出指令
-	sigChan := make(chan os.Signal)
+	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
 	go func() {
 		// 退出调用幂等
@@ -112,7 +119,7 @@ func StartupXchain(envCfgPath string) error {
 	return nil
 }
 
-func loadConf(envCfgPath string) (*econf.EnvConf, *sconf.ServConf, error) {
+func loadConf(envCfgPath string, flags *pflag.FlagSet) (*econf.EnvConf, *sconf.ServConf, error) {
 	// 加载环境配置
 	envConf, err := econf.LoadEnvConf(envCfgPath)
 	if err != nil {
@@ -120,10 +127,14 @@ func loadConf(envCfgPath string) (*econf.EnvConf, *sconf.ServConf, error) {
 	}
 
 	// 加载服务配置
-	servConf, err := sconf.LoadServConf(envConf.GenConfFilePath(envConf.ServConf))
+	servConf, err := sconf.LoadServConfWithFlags(envConf.GenConfFilePath(envConf.ServConf), flags)
 	if err != nil {
 		return nil, nil, err
 	}
+	err = servConf.Validate(envConf.GenDataAbsPath(envConf.TlsDir))
+	if err != nil {
+		return nil, nil, fmt.Errorf("check server config failed.err:%v", err)
+	}
 
 	return envConf, servConf, nil
 }
@@ -44,6 +44,10 @@ func NewServiceCommand() (*cobra.Command, error) {
 	rootCmd.AddCommand(cmd.GetCreateChainCommand().GetCmd())
 	// cmd ledgerPrune
 	rootCmd.AddCommand(cmd.GetPruneLedgerCommand().GetCmd())
+	// cmd config
+	rootCmd.AddCommand(cmd.GetConfigCommand().GetCmd())
+	// cmd checkConfig
+	rootCmd.AddCommand(cmd.GetCheckConfigCommand().GetCmd())
 
 	return rootCmd, nil
 }
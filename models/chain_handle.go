@@ -140,6 +140,11 @@ func (t *ChainHandle) GetAccountByAK(address string) ([]string, error) {
 	return reader.NewContractReader(t.chain.Context(), t.genXctx()).GetAccountByAK(address)
 }
 
+// GetUnconfirmedTx 获取全部未确认交易，被依赖的交易在前
+func (t *ChainHandle) GetUnconfirmedTx() ([]*lpb.Transaction, error) {
+	return t.chain.Context().State.GetUnconfirmedTx(false)
+}
+
 func (t *ChainHandle) genXctx() xctx.XContext {
 	return &xctx.BaseCtx{
 		XLog:  t.reqCtx.GetLog(),
@@ -0,0 +1,136 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+	crypto_client "github.com/xuperchain/xupercore/lib/crypto/client"
+	"github.com/xuperchain/xupercore/lib/crypto/hash"
+)
+
+// CrossQueryEndorsement 背书节点对跨链查询请求的背书结果
+type CrossQueryEndorsement struct {
+	// 背书节点服务地址，仅用于错误信息
+	Host            string
+	EndorserAddress string
+	ResponseData    []byte
+	Sign            *pb.SignatureInfo
+}
+
+// CrossQueryResult 达到法定数的跨链查询结果
+type CrossQueryResult struct {
+	Response     *pb.CrossQueryResponse
+	ResponseData []byte
+	// 返回该结果且签名有效的可信背书地址
+	Signers []string
+}
+
+// CrossQueryDigest 背书节点对跨链查询签名的摘要：sha256(RequestData || ResponseData)
+func CrossQueryDigest(requestData, responseData []byte) []byte {
+	data := make([]byte, 0, len(requestData)+len(responseData))
+	data = append(data, requestData...)
+	data = append(data, responseData...)
+	return hash.UsingSha256(data)
+}
+
+// VerifyCrossQuerySign 校验背书签名，签名公钥必须与背书地址对应
+func VerifyCrossQuerySign(requestData []byte, e *CrossQueryEndorsement) error {
+	if e.Sign == nil {
+		return errors.New("endorser sign is empty")
+	}
+
+	cryptoClient, err := crypto_client.CreateCryptoClientFromJSONPublicKey([]byte(e.Sign.GetPublicKey()))
+	if err != nil {
+		return fmt.Errorf("create crypto client failed.err:%v", err)
+	}
+	publicKey, err := cryptoClient.GetEcdsaPublicKeyFromJsonStr(e.Sign.GetPublicKey())
+	if err != nil {
+		return fmt.Errorf("parse public key failed.err:%v", err)
+	}
+	addr, err := cryptoClient.GetAddressFromPublicKey(publicKey)
+	if err != nil {
+		return err
+	}
+	if addr != e.EndorserAddress {
+		return fmt.Errorf("public key belongs to %s, not endorser %s", addr, e.EndorserAddress)
+	}
+
+	digest := CrossQueryDigest(requestData, e.ResponseData)
+	ok, err := cryptoClient.VerifyECDSA(publicKey, e.Sign.GetSign(), digest)
+	if err != nil || !ok {
+		return fmt.Errorf("verify sign failed.err:%v", err)
+	}
+	return nil
+}
+
+// VerifyCrossQueryQuorum 校验各背书节点的签名，要求至少quorum个不同的可信背书节点返回相同的结果
+func VerifyCrossQueryQuorum(requestData []byte, endorsements []*CrossQueryEndorsement,
+	trusted []string, quorum int) (*CrossQueryResult, error) {
+	if quorum <= 0 {
+		return nil, errors.New("quorum must be positive")
+	}
+	trustedSet := make(map[string]bool, len(trusted))
+	for _, addr := range trusted {
+		trustedSet[addr] = true
+	}
+
+	// 按响应内容分组，同一背书地址只计一次
+	var groups []*CrossQueryResult
+	counted := make(map[string]bool)
+	var problems []string
+	for _, e := range endorsements {
+		if !trustedSet[e.EndorserAddress] {
+			problems = append(problems, fmt.Sprintf("%s: endorser %s is not trusted", e.Host, e.EndorserAddress))
+			continue
+		}
+		if err := VerifyCrossQuerySign(requestData, e); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", e.Host, err))
+			continue
+		}
+		if counted[e.EndorserAddress] {
+			continue
+		}
+		counted[e.EndorserAddress] = true
+
+		var group *CrossQueryResult
+		for _, g := range groups {
+			if bytes.Equal(g.ResponseData, e.ResponseData) {
+				group = g
+				break
+			}
+		}
+		if group == nil {
+			group = &CrossQueryResult{ResponseData: e.ResponseData}
+			groups = append(groups, group)
+		}
+		group.Signers = append(group.Signers, e.EndorserAddress)
+	}
+
+	for _, g := range groups {
+		if len(g.Signers) < quorum {
+			continue
+		}
+		g.Response = &pb.CrossQueryResponse{}
+		if err := json.Unmarshal(g.ResponseData, g.Response); err != nil {
+			return nil, fmt.Errorf("unmarshal cross query response failed.err:%v", err)
+		}
+		return g, nil
+	}
+
+	best := 0
+	for _, g := range groups {
+		if len(g.Signers) > best {
+			best = len(g.Signers)
+		}
+	}
+	msg := fmt.Sprintf("cross query quorum not reached: need %d identical responses, got at most %d in %d distinct responses",
+		quorum, best, len(groups))
+	if len(problems) > 0 {
+		msg += ": " + strings.Join(problems, "; ")
+	}
+	return nil, errors.New(msg)
+}
@@ -0,0 +1,78 @@
+package common
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+	crypto_client "github.com/xuperchain/xupercore/lib/crypto/client"
+)
+
+func newTestEndorsement(t *testing.T, dir string, requestData, responseData []byte) *CrossQueryEndorsement {
+	cryptoClient, err := crypto_client.CreateCryptoClient(crypto_client.CryptoTypeDefault)
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.MkdirAll(dir, 0755)
+	if err := cryptoClient.ExportNewAccount(dir); err != nil {
+		t.Fatal(err)
+	}
+	addr, _ := ioutil.ReadFile(filepath.Join(dir, "address"))
+	sk, _ := ioutil.ReadFile(filepath.Join(dir, "private.key"))
+	pk, _ := ioutil.ReadFile(filepath.Join(dir, "public.key"))
+
+	privateKey, err := cryptoClient.GetEcdsaPrivateKeyFromJsonStr(string(sk))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sign, err := cryptoClient.SignECDSA(privateKey, CrossQueryDigest(requestData, responseData))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &CrossQueryEndorsement{
+		Host:            dir,
+		EndorserAddress: string(addr),
+		ResponseData:    responseData,
+		Sign:            &pb.SignatureInfo{PublicKey: string(pk), Sign: sign},
+	}
+}
+
+func TestVerifyCrossQueryQuorum(t *testing.T) {
+	dir, err := ioutil.TempDir("", "crossquery")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	req := []byte(`{"bcname":"xuper"}`)
+	resp := []byte(`{"response":{"status":200,"body":"MQ=="}}`)
+	e1 := newTestEndorsement(t, filepath.Join(dir, "e1"), req, resp)
+	e2 := newTestEndorsement(t, filepath.Join(dir, "e2"), req, resp)
+	e3 := newTestEndorsement(t, filepath.Join(dir, "e3"), req, []byte(`{"response":{"status":200,"body":"Mg=="}}`))
+	trusted := []string{e1.EndorserAddress, e2.EndorserAddress, e3.EndorserAddress}
+
+	res, err := VerifyCrossQueryQuorum(req, []*CrossQueryEndorsement{e1, e3, e2, e1}, trusted, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Signers) != 2 || string(res.Response.GetResponse().GetBody()) != "1" {
+		t.Errorf("unexpected result: %v", res)
+	}
+
+	// 重复的背书地址只计一次
+	if _, err := VerifyCrossQueryQuorum(req, []*CrossQueryEndorsement{e1, e1, e3}, trusted, 2); err == nil {
+		t.Error("expect quorum not reached with duplicated endorser")
+	}
+	// 不可信的背书节点不计入
+	if _, err := VerifyCrossQueryQuorum(req, []*CrossQueryEndorsement{e1, e2}, trusted[:1], 2); err == nil {
+		t.Error("expect quorum not reached with untrusted endorser")
+	}
+	// 篡改响应后签名无效
+	forged := *e2
+	forged.ResponseData = e3.ResponseData
+	if _, err := VerifyCrossQueryQuorum(req, []*CrossQueryEndorsement{e3, &forged}, trusted, 2); err == nil {
+		t.Error("expect quorum not reached with forged response")
+	}
+}
@@ -0,0 +1,129 @@
+package common
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/ledger"
+	"github.com/xuperchain/xupercore/lib/crypto/hash"
+)
+
+// MakeMerkleTree 按账本的算法由区块内txid构造merkle树：
+// 叶子补齐为2的幂，缺右孩子时与自身拼接，最后一个节点为根
+func MakeMerkleTree(txids [][]byte) [][]byte {
+	txCount := len(txids)
+	if txCount == 0 {
+		return nil
+	}
+	leafSize := 1
+	for leafSize < txCount {
+		leafSize *= 2
+	}
+	treeSize := leafSize*2 - 1
+	tree := make([][]byte, treeSize)
+	copy(tree, txids)
+
+	noneLeafOffset := leafSize
+	for i := 0; i < treeSize-1; i += 2 {
+		switch {
+		case tree[i] == nil:
+			tree[noneLeafOffset] = nil
+		case tree[i+1] == nil:
+			tree[noneLeafOffset] = hash.DoubleSha256(bytes.Join([][]byte{tree[i], tree[i]}, []byte{}))
+		default:
+			tree[noneLeafOffset] = hash.DoubleSha256(bytes.Join([][]byte{tree[i], tree[i+1]}, []byte{}))
+		}
+		noneLeafOffset++
+	}
+	return tree
+}
+
+// MakeMerklePath 返回txid在merkle树中的叶子序号和自底向上的兄弟节点，
+// 兄弟节点为空表示与自身拼接
+func MakeMerklePath(tree [][]byte, txid []byte) (int64, [][]byte, error) {
+	leafSize := (len(tree) + 1) / 2
+	index := -1
+	for i := 0; i < leafSize; i++ {
+		if bytes.Equal(tree[i], txid) {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return 0, nil, errors.New("txid not found in merkle tree")
+	}
+
+	// 扁平数组中节点i的父节点为leafSize+i/2，每层起点均为偶数，
+	// 因此叶子序号的第k位即第k层节点在左(0)还是在右(1)
+	var path [][]byte
+	for i := index; i < len(tree)-1; i = leafSize + i/2 {
+		path = append(path, tree[i^1])
+	}
+	return int64(index), path, nil
+}
+
+// MakeBlockMerklePath 返回txid在区块merkle树中的路径，区块未保存merkle树时按交易重新构造
+func MakeBlockMerklePath(block *pb.InternalBlock, txid []byte) (int64, [][]byte, error) {
+	tree := block.GetMerkleTree()
+	if len(tree) == 0 {
+		txids := make([][]byte, 0, len(block.GetTransactions()))
+		for _, tx := range block.GetTransactions() {
+			txids = append(txids, tx.GetTxid())
+		}
+		tree = MakeMerkleTree(txids)
+	}
+	if len(tree) == 0 || !bytes.Equal(tree[len(tree)-1], block.GetMerkleRoot()) {
+		return 0, nil, errors.New("merkle tree mismatch with merkle root")
+	}
+	return MakeMerklePath(tree, txid)
+}
+
+// VerifyMerklePath 由txid和路径逐层计算，检查结果是否等于merkle根
+func VerifyMerklePath(txid []byte, index int64, path [][]byte, root []byte) error {
+	if len(txid) == 0 {
+		return errors.New("txid is empty")
+	}
+	if index < 0 || index>>uint(len(path)) != 0 {
+		return fmt.Errorf("tx index %d out of range of %d levels", index, len(path))
+	}
+
+	node := txid
+	for level, sibling := range path {
+		var concat [][]byte
+		switch {
+		case index>>uint(level)&1 == 1:
+			if len(sibling) == 0 {
+				return fmt.Errorf("left sibling at level %d is empty", level)
+			}
+			concat = [][]byte{sibling, node}
+		case len(sibling) == 0:
+			concat = [][]byte{node, node}
+		default:
+			concat = [][]byte{node, sibling}
+		}
+		node = hash.DoubleSha256(bytes.Join(concat, []byte{}))
+	}
+	if !bytes.Equal(node, root) {
+		return errors.New("merkle path does not match merkle root")
+	}
+	return nil
+}
+
+// VerifyTxProof 校验区块头的blockid和txid到merkle根的路径，
+// 区块头本身是否可信由调用方通过区块链校验
+func VerifyTxProof(proof *pb.TxProof) error {
+	header := proof.GetBlockHeader()
+	if header == nil {
+		return errors.New("block header is empty")
+	}
+	blockid, err := ledger.MakeBlockID(BlockToXledger(header))
+	if err != nil {
+		return fmt.Errorf("make block id failed.err:%v", err)
+	}
+	if !bytes.Equal(blockid, header.GetBlockid()) {
+		return errors.New("block header mismatch with blockid")
+	}
+	return VerifyMerklePath(proof.GetTxid(), proof.GetTxIndex(), proof.GetMerklePath(), header.GetMerkleRoot())
+}
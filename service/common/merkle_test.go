@@ -0,0 +1,75 @@
+package common
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/ledger"
+)
+
+func TestMakeMerklePath(t *testing.T) {
+	for txCount := 1; txCount <= 9; txCount++ {
+		var txids [][]byte
+		for i := 0; i < txCount; i++ {
+			txids = append(txids, []byte(fmt.Sprintf("tx%d", i)))
+		}
+		tree := MakeMerkleTree(txids)
+		root := tree[len(tree)-1]
+
+		for i, txid := range txids {
+			index, path, err := MakeMerklePath(tree, txid)
+			if err != nil || index != int64(i) {
+				t.Fatalf("unexpected path of %s: %d %v", txid, index, err)
+			}
+			if err := VerifyMerklePath(txid, index, path, root); err != nil {
+				t.Errorf("verify path of %s in %d txs failed: %v", txid, txCount, err)
+			}
+			if err := VerifyMerklePath(txid, index^1, path, root); err == nil && txCount > 1 {
+				t.Errorf("expect wrong index of %s in %d txs to fail", txid, txCount)
+			}
+		}
+	}
+
+	if _, _, err := MakeMerklePath(MakeMerkleTree([][]byte{[]byte("tx0")}), []byte("tx1")); err == nil {
+		t.Error("expect error for txid not in tree")
+	}
+}
+
+func TestVerifyTxProof(t *testing.T) {
+	txids := [][]byte{[]byte("tx0"), []byte("tx1"), []byte("tx2")}
+	tree := MakeMerkleTree(txids)
+	header := &pb.InternalBlock{
+		Version:    1,
+		PreHash:    []byte("prehash"),
+		MerkleRoot: tree[len(tree)-1],
+		Height:     10,
+		Timestamp:  1600000000,
+		TxCount:    int32(len(txids)),
+	}
+	blockid, err := ledger.MakeBlockID(BlockToXledger(header))
+	if err != nil {
+		t.Fatal(err)
+	}
+	header.Blockid = blockid
+
+	index, path, err := MakeMerklePath(tree, txids[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof := &pb.TxProof{Txid: txids[2], BlockHeader: header, TxIndex: index, MerklePath: path}
+	if err := VerifyTxProof(proof); err != nil {
+		t.Fatal(err)
+	}
+
+	// 篡改merkle根后blockid不匹配
+	header.MerkleRoot = tree[0]
+	if err := VerifyTxProof(proof); err == nil {
+		t.Error("expect error with tampered merkle root")
+	}
+	header.MerkleRoot = tree[len(tree)-1]
+	proof.Txid = []byte("tx3")
+	if err := VerifyTxProof(proof); err == nil {
+		t.Error("expect error with txid not in block")
+	}
+}
@@ -1,6 +1,7 @@
 package common
 
 import (
+	"encoding/hex"
 	"fmt"
 
 	"github.com/xuperchain/xuperchain/service/pb"
@@ -49,3 +50,15 @@ func MakeTxDigestHash(tx *pb.Transaction) ([]byte, error) {
 	}
 	return digestHash, nil
 }
+
+// EndorserFeeDescPrefix 背书服务费交易的Desc前缀，后接被背书交易摘要的hex编码
+const EndorserFeeDescPrefix = "endorser_fee:"
+
+// MakeEndorserFeeDesc 生成绑定被背书交易的服务费交易Desc
+func MakeEndorserFeeDesc(tx *pb.Transaction) ([]byte, error) {
+	digest, err := MakeTxDigestHash(tx)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(EndorserFeeDescPrefix + hex.EncodeToString(digest)), nil
+}
@@ -1,13 +1,34 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/xuperchain/xupercore/lib/utils"
 
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 )
 
+// ServConfEnvPrefix 服务配置环境变量前缀，如XCHAIN_SERV_RPCPORT覆盖rpcPort
+const ServConfEnvPrefix = "XCHAIN_SERV"
+
+// 配置项取值来源，优先级：命令行 > 环境变量 > 配置文件 > 默认值
+const (
+	ConfSourceDefault = "default"
+	ConfSourceFile    = "file"
+	ConfSourceEnv     = "env"
+	ConfSourceFlag    = "flag"
+)
+
 type ServConf struct {
 	// rpc server listen port
 	RpcPort            int      `yaml:"rpcPort,omitempty"`
@@ -27,11 +48,175 @@ type ServConf struct {
 	InitConnWindowSize int32    `yaml:"initConnWindowSize,omitempty"`
 	TlsServerName      string   `yaml:"tlsServerName,omitempty"`
 	EventAddrMaxConn   int      `yaml:"eventAddrMaxConn,omitempty"`
+	// 背书模块自定义配置文件，由模块自行解析，相对路径基于conf目录
+	EndorserModuleConf string `yaml:"endorserModuleConf,omitempty"`
+	// proxy背书模块的负载均衡策略：random、roundRobin、leastLatency
+	EndorserPolicy string `yaml:"endorserPolicy,omitempty"`
+	// proxy背书请求失败后最多重试其他背书节点的次数
+	EndorserRetry int `yaml:"endorserRetry,omitempty"`
+	// proxy背书单次请求超时时间(毫秒)
+	EndorserTimeoutMs int `yaml:"endorserTimeoutMs,omitempty"`
+	// proxy背书节点通过GetEndorserInfo主动健康检查的间隔(毫秒)，0表示不检查
+	EndorserHealthCheckMs int `yaml:"endorserHealthCheckMs,omitempty"`
+	// proxy背书节点连续失败多少次后熔断，0表示不熔断
+	EndorserBreakerThreshold int `yaml:"endorserBreakerThreshold,omitempty"`
+	// proxy背书节点熔断持续时间(毫秒)，之后放行一个探测请求，成功后关闭熔断
+	EndorserBreakerCooldownMs int `yaml:"endorserBreakerCooldownMs,omitempty"`
+	// proxy背书是否使用tls连接上游背书节点，证书与服务端tls共用
+	EndorserTlsEnable bool `yaml:"endorserTlsEnable,omitempty"`
+	// 上游背书节点tls证书的server name，为空时使用tlsServerName
+	EndorserTlsServerName string `yaml:"endorserTlsServerName,omitempty"`
+	// 背书签名密钥，可按链配置，未配置时使用data/endorser/keys下的明文密钥
+	EndorserKeys []EndorserKeyConf `yaml:"endorserKeys,omitempty"`
+	// ComplianceCheck使用的合规规则文件，相对路径基于conf目录，为空表示不检查交易内容
+	EndorserComplianceRules string `yaml:"endorserComplianceRules,omitempty"`
+	// ComplianceCheck收取的背书服务费，可按链配置，未配置时不校验服务费交易
+	EndorserFees []EndorserFeeConf `yaml:"endorserFees,omitempty"`
+	// 背书记录库目录，相对路径基于data目录，为空表示不保存背书记录
+	EndorserAuditPath string `yaml:"endorserAuditPath,omitempty"`
+
+	// 各配置项取值来源，key为配置项名
+	sources map[string]string
+}
+
+const (
+	// grpc流控窗口下限，小于该值的配置会被grpc忽略
+	minWindowSize = 64 << 10
+	maxPort       = 65535
+)
+
+// proxy背书模块负载均衡策略
+const (
+	EndorserPolicyRandom       = "random"
+	EndorserPolicyRoundRobin   = "roundRobin"
+	EndorserPolicyLeastLatency = "leastLatency"
+)
+
+// TlsFiles 开启tls时tls目录下必须存在的文件
+var TlsFiles = []string{"cert.crt", "key.pem", "private.key"}
+
+// EndorserModuleValidator 背书模块自身配置项的校验，tlsPath为空时不检查tls文件
+type EndorserModuleValidator func(cfg *ServConf, tlsPath string) []error
+
+var (
+	endorserModuleMu sync.RWMutex
+	endorserModules  = make(map[string]EndorserModuleValidator)
+)
+
+// RegisterEndorserModule 登记背书模块及其配置校验，validate可以为nil；
+// 背书模块注册时调用，Validate据此检查endorserModule
+func RegisterEndorserModule(name string, validate EndorserModuleValidator) {
+	endorserModuleMu.Lock()
+	defer endorserModuleMu.Unlock()
+	endorserModules[name] = validate
+}
+
+// EndorserModules 返回已登记的背书模块名
+func EndorserModules() []string {
+	endorserModuleMu.RLock()
+	defer endorserModuleMu.RUnlock()
+	list := make([]string, 0, len(endorserModules))
+	for name := range endorserModules {
+		list = append(list, name)
+	}
+	sort.Strings(list)
+	return list
+}
+
+// TlsFileErrors 检查tlsPath下tls文件是否存在，key为要求tls文件的配置项，tlsPath为空时不检查
+func TlsFileErrors(tlsPath, key string) []error {
+	if tlsPath == "" {
+		return nil
+	}
+	var errs []error
+	for _, name := range TlsFiles {
+		file := filepath.Join(tlsPath, name)
+		if !utils.FileIsExist(file) {
+			errs = append(errs, fmt.Errorf("tls file %s not found, required when %s is true", file, key))
+		}
+	}
+	return errs
+}
+
+// 背书密钥来源
+const (
+	// 明文密钥目录，包含address、public.key、private.key
+	EndorserKeyTypeFile = "file"
+	// 加密私钥目录，private.key使用口令加密，口令从环境变量读取
+	EndorserKeyTypeEncrypted = "encrypted"
+	// PKCS#11风格的密钥令牌，私钥不出令牌
+	EndorserKeyTypeToken = "token"
+)
+
+// EndorserKeyConf 背书签名密钥配置
+type EndorserKeyConf struct {
+	// 使用该密钥的链名，为空表示未单独配置密钥的链都使用该密钥
+	BcName string `yaml:"bcName,omitempty" json:"bcName,omitempty"`
+	// 密钥来源：file、encrypted、token
+	Type string `yaml:"type,omitempty" json:"type,omitempty"`
+	// 密钥目录或令牌配置，相对路径基于节点根目录
+	Path string `yaml:"path,omitempty" json:"path,omitempty"`
+	// 保存私钥口令或令牌PIN的环境变量名
+	PassphraseEnv string `yaml:"passphraseEnv,omitempty" json:"passphraseEnv,omitempty"`
+	// 令牌实现名，通过rpc.RegisterToken注册
+	Token string `yaml:"token,omitempty" json:"token,omitempty"`
+	// 令牌中密钥的标签
+	Label string `yaml:"label,omitempty" json:"label,omitempty"`
+}
+
+// EndorserFeeConf 背书服务费配置
+type EndorserFeeConf struct {
+	// 收取服务费的链名，为空表示未单独配置的链都按该配置收取
+	BcName string `yaml:"bcName,omitempty" json:"bcName,omitempty"`
+	// 服务费收款地址
+	Address string `yaml:"address,omitempty" json:"address,omitempty"`
+	// 每次背书收取的最低服务费
+	Amount string `yaml:"amount,omitempty" json:"amount,omitempty"`
+}
+
+// ConfErrors 配置检查发现的全部问题
+type ConfErrors []error
+
+func (t ConfErrors) Error() string {
+	msgs := make([]string, 0, len(t))
+	for _, err := range t {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// ConfItem 生效的配置项及其来源
+type ConfItem struct {
+	Key    string
+	Value  interface{}
+	Source string
+}
+
+// servConfFlags 支持通过启动参数覆盖的配置项
+var servConfFlags = []string{
+	"rpcPort",
+	"GWPort",
+	"metricPort",
+	"enableMetric",
+	"enableTls",
+	"tlsServerName",
+	"enableEndorser",
+	"endorserModule",
+	"endorserHosts",
+	"enableEvent",
+	"eventAddrMaxConn",
+	"endorserPolicy",
+	"endorserTlsEnable",
 }
 
 func LoadServConf(cfgFile string) (*ServConf, error) {
+	return LoadServConfWithFlags(cfgFile, nil)
+}
+
+// LoadServConfWithFlags 加载服务配置，并依次使用环境变量和启动参数覆盖
+func LoadServConfWithFlags(cfgFile string, flags *pflag.FlagSet) (*ServConf, error) {
 	cfg := GetDefServConf()
-	err := cfg.loadConf(cfgFile)
+	err := cfg.loadConf(cfgFile, flags)
 	if err != nil {
 		return nil, fmt.Errorf("load server config failed.err:%s", err)
 	}
@@ -39,6 +224,31 @@ func LoadServConf(cfgFile string) (*ServConf, error) {
 	return cfg, nil
 }
 
+// CheckServConf 严格加载并校验服务配置，一次返回发现的全部问题，没有问题时返回nil
+func CheckServConf(cfgFile, tlsPath string, flags *pflag.FlagSet) error {
+	var errs ConfErrors
+	cfg := GetDefServConf()
+	if err := cfg.loadConf(cfgFile, flags); err != nil {
+		// 解码错误会逐项列出，其余字段仍然已解码，可以继续校验
+		var decodeErr *mapstructure.Error
+		if !errors.As(err, &decodeErr) {
+			return ConfErrors{err}
+		}
+		for _, msg := range decodeErr.Errors {
+			msg = strings.Replace(msg, "'' has invalid keys:", "unknown config keys:", 1)
+			errs = append(errs, errors.New(msg))
+		}
+	}
+	if err := cfg.Validate(tlsPath); err != nil {
+		errs = append(errs, err.(ConfErrors)...)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
 func GetDefServConf() *ServConf {
 	return &ServConf{
 		RpcPort:            38101,
@@ -58,10 +268,68 @@ func GetDefServConf() *ServConf {
 		InitConnWindowSize: 64 << 10,
 		TlsServerName:      "localhost",
 		EventAddrMaxConn:   5,
+
+		EndorserModuleConf:        "",
+		EndorserPolicy:            EndorserPolicyRoundRobin,
+		EndorserRetry:             2,
+		EndorserTimeoutMs:         5000,
+		EndorserHealthCheckMs:     10000,
+		EndorserBreakerThreshold:  3,
+		EndorserBreakerCooldownMs: 30000,
+		EndorserTlsEnable:         false,
+		EndorserTlsServerName:     "",
+		EndorserKeys:              []EndorserKeyConf{},
+		EndorserComplianceRules:   "",
+		EndorserFees:              []EndorserFeeConf{},
+		EndorserAuditPath:         "endorser/audit",
+
+		sources: map[string]string{},
 	}
 }
 
-func (t *ServConf) loadConf(cfgFile string) error {
+// AddServConfFlags 注册可覆盖服务配置的启动参数，参数名与配置项名一致
+func AddServConfFlags(flags *pflag.FlagSet) {
+	def := GetDefServConf()
+	for _, key := range servConfFlags {
+		usage := fmt.Sprintf("override %s in server config", key)
+		switch v := def.valueOf(key).(type) {
+		case int:
+			flags.Int(key, v, usage)
+		case int32:
+			flags.Int32(key, v, usage)
+		case bool:
+			flags.Bool(key, v, usage)
+		case string:
+			flags.String(key, v, usage)
+		case []string:
+			flags.StringSlice(key, v, usage)
+		}
+	}
+}
+
+// Source 返回配置项取值来源
+func (t *ServConf) Source(key string) string {
+	if src, ok := t.sources[key]; ok {
+		return src
+	}
+	return ConfSourceDefault
+}
+
+// Items 按字段定义顺序返回所有生效的配置项
+func (t *ServConf) Items() []ConfItem {
+	keys := servConfKeys()
+	items := make([]ConfItem, 0, len(keys))
+	for _, key := range keys {
+		items = append(items, ConfItem{
+			Key:    key,
+			Value:  t.valueOf(key),
+			Source: t.Source(key),
+		})
+	}
+	return items
+}
+
+func (t *ServConf) loadConf(cfgFile string, flags *pflag.FlagSet) error {
 	if cfgFile == "" || !utils.FileIsExist(cfgFile) {
 		return fmt.Errorf("config file set error.path:%s", cfgFile)
 	}
@@ -73,9 +341,207 @@ func (t *ServConf) loadConf(cfgFile string) error {
 		return fmt.Errorf("read config failed.path:%s,err:%v", cfgFile, err)
 	}
 
-	if err = viperObj.Unmarshal(t); err != nil {
-		return fmt.Errorf("unmatshal config failed.path:%s,err:%v", cfgFile, err)
+	// 环境变量只对已知配置项生效，需要先注册全部配置项
+	keys := servConfKeys()
+	for _, key := range keys {
+		viperObj.SetDefault(key, t.valueOf(key))
+	}
+	viperObj.SetEnvPrefix(ServConfEnvPrefix)
+	viperObj.AutomaticEnv()
+
+	for _, key := range servConfFlags {
+		if flags == nil || flags.Lookup(key) == nil {
+			continue
+		}
+		if err = viperObj.BindPFlag(key, flags.Lookup(key)); err != nil {
+			return fmt.Errorf("bind flag failed.flag:%s,err:%v", key, err)
+		}
+	}
+
+	// 未知配置项(如拼写错误)直接报错，避免配置被静默忽略
+	if err = viperObj.UnmarshalExact(t); err != nil {
+		return fmt.Errorf("unmatshal config failed.path:%s,err:%w", cfgFile, err)
+	}
+
+	t.sources = make(map[string]string, len(keys))
+	for _, key := range keys {
+		t.sources[key] = confSource(viperObj, flags, key)
+	}
+
+	return nil
+}
+
+// Validate 检查配置取值范围和配置项之间的依赖，tlsPath为空时不检查tls文件
+func (t *ServConf) Validate(tlsPath string) error {
+	var errs ConfErrors
+	ports := map[string]int{
+		"rpcPort":    t.RpcPort,
+		"GWPort":     t.GWPort,
+		"metricPort": t.MetricPort,
+	}
+	for _, key := range []string{"rpcPort", "GWPort", "metricPort"} {
+		if ports[key] <= 0 || ports[key] > maxPort {
+			errs = append(errs, fmt.Errorf("%s must be in range [1, %d], got %d", key, maxPort, ports[key]))
+		}
+	}
+	if t.RpcPort == t.GWPort {
+		errs = append(errs, fmt.Errorf("rpcPort and GWPort must be different, both are %d", t.RpcPort))
+	}
+	if t.EnableMetric && (t.MetricPort == t.RpcPort || t.MetricPort == t.GWPort) {
+		errs = append(errs, fmt.Errorf("metricPort %d conflicts with rpcPort or GWPort", t.MetricPort))
+	}
+
+	if t.MaxMsgSize <= 0 {
+		errs = append(errs, fmt.Errorf("maxMsgSize must be positive, got %d", t.MaxMsgSize))
+	}
+	if t.ReadBufSize < 0 {
+		errs = append(errs, fmt.Errorf("readBufSize must not be negative, got %d", t.ReadBufSize))
+	}
+	if t.WriteBufSize < 0 {
+		errs = append(errs, fmt.Errorf("writeBufSize must not be negative, got %d", t.WriteBufSize))
+	}
+	if t.InitWindowSize < minWindowSize {
+		errs = append(errs, fmt.Errorf("initWindowSize must be at least %d, got %d", minWindowSize, t.InitWindowSize))
+	}
+	if t.InitConnWindowSize < minWindowSize {
+		errs = append(errs, fmt.Errorf("initConnWindowSize must be at least %d, got %d", minWindowSize, t.InitConnWindowSize))
+	}
+	if t.EventAddrMaxConn < 0 {
+		errs = append(errs, fmt.Errorf("eventAddrMaxConn must not be negative, got %d", t.EventAddrMaxConn))
+	}
+
+	if t.EnableEndorser {
+		endorserModuleMu.RLock()
+		validate, ok := endorserModules[t.EndorserModule]
+		endorserModuleMu.RUnlock()
+		if !ok {
+			errs = append(errs, fmt.Errorf("endorserModule must be one of %v, got %q", EndorserModules(), t.EndorserModule))
+		} else if validate != nil {
+			errs = append(errs, validate(t, tlsPath)...)
+		}
+	}
+	switch t.EndorserPolicy {
+	case EndorserPolicyRandom, EndorserPolicyRoundRobin, EndorserPolicyLeastLatency:
+	default:
+		errs = append(errs, fmt.Errorf("endorserPolicy must be one of %s, %s, %s, got %q",
+			EndorserPolicyRandom, EndorserPolicyRoundRobin, EndorserPolicyLeastLatency, t.EndorserPolicy))
+	}
+	proxyInts := map[string]int{
+		"endorserRetry":             t.EndorserRetry,
+		"endorserHealthCheckMs":     t.EndorserHealthCheckMs,
+		"endorserBreakerThreshold":  t.EndorserBreakerThreshold,
+		"endorserBreakerCooldownMs": t.EndorserBreakerCooldownMs,
+	}
+	for _, key := range []string{"endorserRetry", "endorserHealthCheckMs",
+		"endorserBreakerThreshold", "endorserBreakerCooldownMs"} {
+		if proxyInts[key] < 0 {
+			errs = append(errs, fmt.Errorf("%s must not be negative, got %d", key, proxyInts[key]))
+		}
+	}
+	if t.EndorserTimeoutMs <= 0 {
+		errs = append(errs, fmt.Errorf("endorserTimeoutMs must be positive, got %d", t.EndorserTimeoutMs))
+	}
+
+	keyChains := make(map[string]bool, len(t.EndorserKeys))
+	for i, key := range t.EndorserKeys {
+		if keyChains[key.BcName] {
+			errs = append(errs, fmt.Errorf("endorserKeys[%d] duplicates the key of chain %q", i, key.BcName))
+		}
+		keyChains[key.BcName] = true
+		switch key.Type {
+		case EndorserKeyTypeFile:
+		case EndorserKeyTypeEncrypted:
+			if key.PassphraseEnv == "" {
+				errs = append(errs, fmt.Errorf("endorserKeys[%d] passphraseEnv is required by encrypted key", i))
+			}
+		case EndorserKeyTypeToken:
+			if key.Token == "" || key.Label == "" {
+				errs = append(errs, fmt.Errorf("endorserKeys[%d] token and label are required by token key", i))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("endorserKeys[%d] type must be one of %s, %s, %s, got %q", i,
+				EndorserKeyTypeFile, EndorserKeyTypeEncrypted, EndorserKeyTypeToken, key.Type))
+		}
+		if key.Type != EndorserKeyTypeToken && key.Path == "" {
+			errs = append(errs, fmt.Errorf("endorserKeys[%d] path is required", i))
+		}
+	}
+
+	feeChains := make(map[string]bool, len(t.EndorserFees))
+	for i, fee := range t.EndorserFees {
+		if feeChains[fee.BcName] {
+			errs = append(errs, fmt.Errorf("endorserFees[%d] duplicates the fee of chain %q", i, fee.BcName))
+		}
+		feeChains[fee.BcName] = true
+		if fee.Address == "" {
+			errs = append(errs, fmt.Errorf("endorserFees[%d] address is required", i))
+		}
+		if amount, ok := big.NewInt(0).SetString(fee.Amount, 10); !ok || amount.Sign() <= 0 {
+			errs = append(errs, fmt.Errorf("endorserFees[%d] amount must be a positive integer, got %q", i, fee.Amount))
+		}
+	}
+	if len(t.EndorserFees) > 0 && t.EndorserAuditPath == "" {
+		errs = append(errs, fmt.Errorf("endorserFees requires endorserAuditPath to record used fee txs"))
+	}
+
+	if t.EnableTls && t.TlsServerName == "" {
+		errs = append(errs, fmt.Errorf("tlsServerName must be set when enableTls is true"))
+	}
+	if t.EnableTls {
+		errs = append(errs, TlsFileErrors(tlsPath, "enableTls")...)
 	}
 
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func confSource(viperObj *viper.Viper, flags *pflag.FlagSet, key string) string {
+	if flags != nil && flags.Lookup(key) != nil && flags.Lookup(key).Changed {
+		return ConfSourceFlag
+	}
+	if _, ok := os.LookupEnv(servConfEnvName(key)); ok {
+		return ConfSourceEnv
+	}
+	if viperObj.InConfig(strings.ToLower(key)) {
+		return ConfSourceFile
+	}
+	return ConfSourceDefault
+}
+
+func servConfEnvName(key string) string {
+	return ServConfEnvPrefix + "_" + strings.ToUpper(key)
+}
+
+// servConfKeys 按字段定义顺序返回所有配置项名
+func servConfKeys() []string {
+	typ := reflect.TypeOf(ServConf{})
+	keys := make([]string, 0, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		key := confKey(typ.Field(i))
+		if key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func confKey(field reflect.StructField) string {
+	tag := field.Tag.Get("yaml")
+	if tag == "" {
+		return ""
+	}
+	return strings.Split(tag, ",")[0]
+}
+
+func (t *ServConf) valueOf(key string) interface{} {
+	val := reflect.ValueOf(t).Elem()
+	typ := val.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		if confKey(typ.Field(i)) == key {
+			return val.Field(i).Interface()
+		}
+	}
 	return nil
 }
@@ -2,12 +2,22 @@ package config
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/spf13/pflag"
 	"github.com/xuperchain/xupercore/lib/utils"
 )
 
+func init() {
+	// 背书模块由rpc包注册，这里只登记模块名
+	RegisterEndorserModule("default", nil)
+	RegisterEndorserModule("proxy", nil)
+}
+
 func TestLoadServConf(t *testing.T) {
 	envCfg, err := LoadServConf(getConfFile())
 	if err != nil {
@@ -21,3 +31,70 @@ func getConfFile() string {
 	dir := utils.GetCurFileDir()
 	return filepath.Join(dir, "mock/server.yaml")
 }
+
+func TestLoadServConfOverride(t *testing.T) {
+	os.Setenv("XCHAIN_SERV_ENABLETLS", "true")
+	os.Setenv("XCHAIN_SERV_ENDORSERHOSTS", "127.0.0.1:8848,127.0.0.1:8849")
+	defer os.Unsetenv("XCHAIN_SERV_ENABLETLS")
+	defer os.Unsetenv("XCHAIN_SERV_ENDORSERHOSTS")
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	AddServConfFlags(flags)
+	if err := flags.Parse([]string{"--rpcPort", "37102"}); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadServConfWithFlags(getConfFile(), flags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.RpcPort != 37102 || cfg.Source("rpcPort") != ConfSourceFlag {
+		t.Errorf("rpcPort not overridden by flag.value:%d source:%s", cfg.RpcPort, cfg.Source("rpcPort"))
+	}
+	if !cfg.EnableTls || cfg.Source("enableTls") != ConfSourceEnv {
+		t.Errorf("enableTls not overridden by env.value:%v source:%s", cfg.EnableTls, cfg.Source("enableTls"))
+	}
+	if len(cfg.EndorserHosts) != 2 {
+		t.Errorf("endorserHosts not overridden by env.value:%v", cfg.EndorserHosts)
+	}
+	if cfg.GWPort != 38102 || cfg.Source("GWPort") != ConfSourceDefault {
+		t.Errorf("GWPort should be default.value:%d source:%s", cfg.GWPort, cfg.Source("GWPort"))
+	}
+}
+
+func TestCheckServConf(t *testing.T) {
+	if err := CheckServConf(getConfFile(), "", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err := ioutil.TempDir("", "servconf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfgFile := filepath.Join(dir, "server.yaml")
+	content := "rpcPort: 70000\nmaxRecvMsgSize: 1024\ninitWindowSize: 1024\n" +
+		"enableEndorser: true\nendorserModule: proxy\nenableTls: true\n"
+	if err := ioutil.WriteFile(cfgFile, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	err = CheckServConf(cfgFile, dir, nil)
+	errs, ok := err.(ConfErrors)
+	if !ok {
+		t.Fatalf("expect ConfErrors, got %v", err)
+	}
+	// 未知配置项、端口、窗口大小以及3个tls文件，代理背书的配置由rpc包检查
+	if len(errs) != 6 {
+		t.Errorf("expect 6 problems, got %d: %v", len(errs), errs)
+	}
+
+	// 背书模块需要已注册
+	cfg := GetDefServConf()
+	cfg.EnableEndorser = true
+	cfg.EndorserModule = "unknown"
+	if err := cfg.Validate(""); err == nil || !strings.Contains(err.Error(), "endorserModule") {
+		t.Errorf("expect unknown endorserModule error, got %v", err)
+	}
+}
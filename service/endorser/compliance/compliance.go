@@ -0,0 +1,410 @@
+// Package compliance 背书节点合规检查规则引擎
+//
+// 规则从yaml文件加载，文件修改后在下一次检查时自动重新加载，
+// 加载失败时继续使用上一版规则。
+package compliance
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"os"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/xuperchain/xuperchain/service/common"
+	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xupercore/lib/logs"
+)
+
+const (
+	// 交易费输出地址
+	feeAddr = "$"
+
+	// 检查规则文件是否修改的最小间隔
+	reloadInterval = time.Second
+)
+
+// ContractRule 允许调用的合约方法
+type ContractRule struct {
+	// 合约名
+	Contract string `yaml:"contract"`
+	// 允许调用的方法，为空表示该合约的全部方法
+	Methods []string `yaml:"methods,omitempty"`
+}
+
+// Rules 合规检查规则，各项为空表示不限制
+type Rules struct {
+	// 禁止参与交易的地址
+	Blocklist []string `yaml:"blocklist,omitempty"`
+	// 只允许这些地址参与交易
+	Allowlist []string `yaml:"allowlist,omitempty"`
+	// 单笔交易最大转账金额
+	MaxAmountPerTx string `yaml:"maxAmountPerTx,omitempty"`
+	// 单个发起者每天最大转账金额
+	MaxAmountPerDay string `yaml:"maxAmountPerDay,omitempty"`
+	// 允许调用的合约方法
+	Contracts []ContractRule `yaml:"contracts,omitempty"`
+	// 交易Desc需要匹配其中之一的正则
+	DescPatterns []string `yaml:"descPatterns,omitempty"`
+}
+
+// ruleSet 解析后的规则
+type ruleSet struct {
+	blocklist       map[string]bool
+	allowlist       map[string]bool
+	maxAmountPerTx  *big.Int
+	maxAmountPerDay *big.Int
+	contracts       map[string]map[string]bool
+	descPatterns    []*regexp.Regexp
+}
+
+// Violation 交易违反的规则及原因
+type Violation struct {
+	Rule   string
+	Reason string
+}
+
+func (v *Violation) Error() string {
+	return fmt.Sprintf("compliance check refused.rule:%s reason:%s", v.Rule, v.Reason)
+}
+
+// LoadRules 从文件加载规则，文件中出现未知配置项时报错
+func LoadRules(file string) (*Rules, error) {
+	viperObj := viper.New()
+	viperObj.SetConfigFile(file)
+	if err := viperObj.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("read compliance rules failed.path:%s,err:%v", file, err)
+	}
+
+	rules := &Rules{}
+	if err := viperObj.UnmarshalExact(rules); err != nil {
+		return nil, fmt.Errorf("unmarshal compliance rules failed.path:%s,err:%v", file, err)
+	}
+	return rules, nil
+}
+
+func (r *Rules) compile() (*ruleSet, error) {
+	rs := &ruleSet{
+		blocklist: toSet(r.Blocklist),
+		allowlist: toSet(r.Allowlist),
+		contracts: make(map[string]map[string]bool, len(r.Contracts)),
+	}
+
+	var err error
+	if rs.maxAmountPerTx, err = parseAmount(r.MaxAmountPerTx); err != nil {
+		return nil, fmt.Errorf("invalid maxAmountPerTx.err:%v", err)
+	}
+	if rs.maxAmountPerDay, err = parseAmount(r.MaxAmountPerDay); err != nil {
+		return nil, fmt.Errorf("invalid maxAmountPerDay.err:%v", err)
+	}
+	for _, c := range r.Contracts {
+		if c.Contract == "" {
+			return nil, fmt.Errorf("contract name is empty")
+		}
+		rs.contracts[c.Contract] = toSet(c.Methods)
+	}
+	for _, pattern := range r.DescPatterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid descPattern %q.err:%v", pattern, err)
+		}
+		rs.descPatterns = append(rs.descPatterns, re)
+	}
+	return rs, nil
+}
+
+// Checker 按规则检查交易，记录发起者当天已转账金额
+type Checker struct {
+	mutex   sync.Mutex
+	file    string
+	modTime time.Time
+	lastTry time.Time
+	rules   *ruleSet
+	// 发起者当天已转账金额，只保存在内存中，节点重启后清零
+	day   string
+	spent map[string]*big.Int
+	// 发起者已通过检查、尚未背书完成的预留金额
+	reserved map[string]*big.Int
+	// 当天已计入转账金额的交易，重试的交易不重复计入
+	charged map[string]bool
+	now     func() time.Time
+	log     logs.Logger
+}
+
+// Reservation 通过检查的交易预留的当天转账额度，背书成功后Commit计入，失败时Release释放
+type Reservation struct {
+	checker   *Checker
+	key       string
+	day       string
+	initiator string
+	amount    *big.Int
+	done      bool
+}
+
+// NewChecker 从规则文件创建检查器
+func NewChecker(file string) (*Checker, error) {
+	c := &Checker{
+		file:     file,
+		spent:    make(map[string]*big.Int),
+		reserved: make(map[string]*big.Int),
+		charged:  make(map[string]bool),
+		now:      time.Now,
+	}
+	if err := c.reload(); err != nil {
+		return nil, err
+	}
+	// 日志未初始化时(如单测)不输出重新加载日志
+	if log, err := logs.NewLogger("", "compliance"); err == nil {
+		c.log = log
+	}
+	return c, nil
+}
+
+// Check 检查交易是否满足规则，通过时为发起者预留当天转账额度，
+// 调用方在背书成功后Commit，失败时Release
+func (c *Checker) Check(tx *pb.Transaction) (*Reservation, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.tryReload()
+	if tx == nil {
+		return nil, &Violation{Rule: "tx", Reason: "tx is empty"}
+	}
+	if err := c.checkRules(tx); err != nil {
+		return nil, err
+	}
+
+	// 交易以签名前的摘要标识，客户端重试同一笔交易时摘要不变
+	digest, err := common.MakeTxDigestHash(tx)
+	if err != nil {
+		return nil, &Violation{Rule: "tx", Reason: fmt.Sprintf("make tx digest failed: %v", err)}
+	}
+	r := &Reservation{
+		checker:   c,
+		key:       hex.EncodeToString(digest),
+		initiator: tx.GetInitiator(),
+		amount:    big.NewInt(0),
+	}
+	c.rotate()
+	r.day = c.day
+	if c.charged[r.key] {
+		// 已计入过的交易重试时不再占用额度
+		return r, nil
+	}
+
+	amount := transferAmount(tx)
+	total := big.NewInt(0).Add(c.amountOf(c.spent, r.initiator), c.amountOf(c.reserved, r.initiator))
+	total.Add(total, amount)
+	if rs := c.rules; rs.maxAmountPerDay != nil && total.Cmp(rs.maxAmountPerDay) > 0 {
+		return nil, &Violation{Rule: "maxAmountPerDay", Reason: fmt.Sprintf("initiator %s daily amount %s exceeds %s",
+			r.initiator, total, rs.maxAmountPerDay)}
+	}
+	if amount.Sign() > 0 {
+		r.amount = amount
+		c.reserved[r.initiator] = big.NewInt(0).Add(c.amountOf(c.reserved, r.initiator), amount)
+	}
+	return r, nil
+}
+
+// Commit 背书成功，将预留额度计入发起者当天转账金额，同一笔交易只计入一次
+func (r *Reservation) Commit() {
+	r.finish(true)
+}
+
+// Release 背书失败，释放预留额度
+func (r *Reservation) Release() {
+	r.finish(false)
+}
+
+func (r *Reservation) finish(commit bool) {
+	if r == nil || r.done {
+		return
+	}
+	r.done = true
+	c := r.checker
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.rotate()
+	// 跨天后预留额度已随当天记录清零
+	if r.day == c.day && r.amount.Sign() > 0 {
+		left := big.NewInt(0).Sub(c.amountOf(c.reserved, r.initiator), r.amount)
+		if left.Sign() > 0 {
+			c.reserved[r.initiator] = left
+		} else {
+			delete(c.reserved, r.initiator)
+		}
+	}
+	if !commit || c.charged[r.key] {
+		return
+	}
+	c.charged[r.key] = true
+	if r.amount.Sign() > 0 {
+		c.spent[r.initiator] = big.NewInt(0).Add(c.amountOf(c.spent, r.initiator), r.amount)
+	}
+}
+
+// rotate 跨天后清空当天的转账记录
+func (c *Checker) rotate() {
+	day := c.now().Format("2006-01-02")
+	if day != c.day {
+		c.day = day
+		c.spent = make(map[string]*big.Int)
+		c.reserved = make(map[string]*big.Int)
+		c.charged = make(map[string]bool)
+	}
+}
+
+func (c *Checker) amountOf(amounts map[string]*big.Int, initiator string) *big.Int {
+	if amount, ok := amounts[initiator]; ok {
+		return amount
+	}
+	return big.NewInt(0)
+}
+
+// checkRules 检查与当天转账金额无关的规则
+func (c *Checker) checkRules(tx *pb.Transaction) error {
+
+	rs := c.rules
+	for _, addr := range addresses(tx) {
+		if rs.blocklist[addr] {
+			return &Violation{Rule: "blocklist", Reason: fmt.Sprintf("address %s is blocked", addr)}
+		}
+		if len(rs.allowlist) > 0 && !rs.allowlist[addr] {
+			return &Violation{Rule: "allowlist", Reason: fmt.Sprintf("address %s is not allowed", addr)}
+		}
+	}
+
+	if len(rs.contracts) > 0 {
+		for _, req := range tx.GetContractRequests() {
+			methods, ok := rs.contracts[req.GetContractName()]
+			if !ok {
+				return &Violation{Rule: "contracts",
+					Reason: fmt.Sprintf("contract %s is not permitted", req.GetContractName())}
+			}
+			if len(methods) > 0 && !methods[req.GetMethodName()] {
+				return &Violation{Rule: "contracts", Reason: fmt.Sprintf("method %s of contract %s is not permitted",
+					req.GetMethodName(), req.GetContractName())}
+			}
+		}
+	}
+
+	if len(rs.descPatterns) > 0 && !matchAny(rs.descPatterns, tx.GetDesc()) {
+		return &Violation{Rule: "descPatterns", Reason: fmt.Sprintf("desc %q matches no pattern", tx.GetDesc())}
+	}
+
+	amount := transferAmount(tx)
+	if rs.maxAmountPerTx != nil && amount.Cmp(rs.maxAmountPerTx) > 0 {
+		return &Violation{Rule: "maxAmountPerTx",
+			Reason: fmt.Sprintf("amount %s exceeds %s", amount, rs.maxAmountPerTx)}
+	}
+	return nil
+}
+
+// tryReload 规则文件修改后重新加载，失败时保留旧规则
+func (c *Checker) tryReload() {
+	now := c.now()
+	if now.Sub(c.lastTry) < reloadInterval {
+		return
+	}
+	c.lastTry = now
+
+	fi, err := os.Stat(c.file)
+	if err != nil || fi.ModTime().Equal(c.modTime) {
+		return
+	}
+	err = c.reload()
+	if c.log == nil {
+		return
+	}
+	if err != nil {
+		c.log.Warn("reload compliance rules failed, keep the old rules", "file", c.file, "err", err)
+		return
+	}
+	c.log.Info("compliance rules reloaded", "file", c.file)
+}
+
+func (c *Checker) reload() error {
+	fi, err := os.Stat(c.file)
+	if err != nil {
+		return err
+	}
+	rules, err := LoadRules(c.file)
+	if err != nil {
+		return err
+	}
+	rs, err := rules.compile()
+	if err != nil {
+		return fmt.Errorf("compile compliance rules failed.path:%s,err:%v", c.file, err)
+	}
+	c.rules = rs
+	c.modTime = fi.ModTime()
+	return nil
+}
+
+// addresses 交易涉及的全部地址，不含交易费地址
+func addresses(tx *pb.Transaction) []string {
+	var addrs []string
+	if tx.GetInitiator() != "" {
+		addrs = append(addrs, tx.GetInitiator())
+	}
+	for _, input := range tx.GetTxInputs() {
+		addrs = append(addrs, string(input.GetFromAddr()))
+	}
+	for _, output := range tx.GetTxOutputs() {
+		if string(output.GetToAddr()) != feeAddr {
+			addrs = append(addrs, string(output.GetToAddr()))
+		}
+	}
+	return addrs
+}
+
+// transferAmount 转给他人的金额，不含找零和交易费
+func transferAmount(tx *pb.Transaction) *big.Int {
+	senders := map[string]bool{tx.GetInitiator(): true}
+	for _, input := range tx.GetTxInputs() {
+		senders[string(input.GetFromAddr())] = true
+	}
+
+	amount := big.NewInt(0)
+	for _, output := range tx.GetTxOutputs() {
+		to := string(output.GetToAddr())
+		if to == feeAddr || senders[to] {
+			continue
+		}
+		amount.Add(amount, big.NewInt(0).SetBytes(output.GetAmount()))
+	}
+	return amount
+}
+
+func matchAny(patterns []*regexp.Regexp, desc []byte) bool {
+	for _, re := range patterns {
+		if re.Match(desc) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseAmount(s string) (*big.Int, error) {
+	if s == "" {
+		return nil, nil
+	}
+	amount, ok := big.NewInt(0).SetString(s, 10)
+	if !ok || amount.Sign() < 0 {
+		return nil, fmt.Errorf("%q is not a non-negative integer", s)
+	}
+	return amount, nil
+}
+
+func toSet(list []string) map[string]bool {
+	set := make(map[string]bool, len(list))
+	for _, item := range list {
+		set[item] = true
+	}
+	return set
+}
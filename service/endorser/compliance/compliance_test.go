@@ -0,0 +1,150 @@
+package compliance
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+const testRules = `
+blocklist:
+  - bad
+maxAmountPerTx: "100"
+maxAmountPerDay: "150"
+contracts:
+  - contract: counter
+    methods:
+      - increase
+descPatterns:
+  - "^order:"
+`
+
+func transferTx(from, to string, amount int64) *pb.Transaction {
+	return &pb.Transaction{
+		Initiator: from,
+		Desc:      []byte("order:1"),
+		TxInputs:  []*pb.TxInput{{FromAddr: []byte(from), Amount: big.NewInt(1000).Bytes()}},
+		TxOutputs: []*pb.TxOutput{
+			{ToAddr: []byte(to), Amount: big.NewInt(amount).Bytes()},
+			{ToAddr: []byte(from), Amount: big.NewInt(1000 - amount - 1).Bytes()},
+			{ToAddr: []byte(feeAddr), Amount: big.NewInt(1).Bytes()},
+		},
+	}
+}
+
+func TestChecker(t *testing.T) {
+	dir, err := ioutil.TempDir("", "compliance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "rules.yaml")
+	ioutil.WriteFile(file, []byte(testRules), 0644)
+	c, err := NewChecker(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	contractTx := transferTx("alice", "bob", 0)
+	contractTx.ContractRequests = []*pb.InvokeRequest{{ContractName: "counter", MethodName: "get"}}
+	noDescTx := transferTx("alice", "bob", 10)
+	noDescTx.Desc = nil
+
+	cases := []struct {
+		tx   *pb.Transaction
+		rule string
+	}{
+		{transferTx("alice", "bad", 10), "blocklist"},
+		{contractTx, "contracts"},
+		{noDescTx, "descPatterns"},
+		{transferTx("alice", "bob", 101), "maxAmountPerTx"},
+		{transferTx("alice", "bob", 100), ""},
+		{transferTx("alice", "bob", 60), "maxAmountPerDay"},
+		{transferTx("carol", "bob", 60), ""},
+	}
+	for i, cs := range cases {
+		r, err := c.Check(cs.tx)
+		if cs.rule == "" {
+			if err != nil {
+				t.Errorf("case %d expect pass, got %v", i, err)
+			}
+			r.Commit()
+			continue
+		}
+		if v, ok := err.(*Violation); !ok || v.Rule != cs.rule {
+			t.Errorf("case %d expect violation of %s, got %v", i, cs.rule, err)
+		}
+	}
+
+	// 第二天重新计算转账金额
+	now = now.Add(24 * time.Hour)
+	if _, err := c.Check(transferTx("alice", "bob", 60)); err != nil {
+		t.Errorf("expect pass on the next day, got %v", err)
+	}
+
+	// 修改规则文件后自动生效，错误的规则文件不影响已加载的规则
+	ioutil.WriteFile(file, []byte("blocklist:\n  - bob\n"), 0644)
+	now = now.Add(time.Minute)
+	os.Chtimes(file, now, now)
+	if _, err := c.Check(transferTx("alice", "bob", 1)); err == nil {
+		t.Error("expect reloaded rules to block bob")
+	}
+	ioutil.WriteFile(file, []byte("unknownRule: 1\n"), 0644)
+	now = now.Add(time.Minute)
+	os.Chtimes(file, now, now)
+	if _, err := c.Check(transferTx("alice", "bob", 1)); err == nil {
+		t.Error("expect broken rules file to keep the old rules")
+	}
+}
+
+func TestCheckerReservation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "compliance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "rules.yaml")
+	ioutil.WriteFile(file, []byte("maxAmountPerDay: \"100\"\n"), 0644)
+	c, err := NewChecker(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 预留未完成时额度已占用，背书失败释放后不计入
+	tx := transferTx("alice", "bob", 80)
+	r, err := c.Check(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Check(transferTx("alice", "carol", 30)); err == nil {
+		t.Error("expect reserved amount to count in the daily limit")
+	}
+	r.Release()
+	if spent := c.amountOf(c.spent, "alice"); spent.Sign() != 0 {
+		t.Errorf("released amount should not be charged, got %s", spent)
+	}
+
+	// 同一笔交易重试只计入一次
+	for i := 0; i < 3; i++ {
+		r, err := c.Check(tx)
+		if err != nil {
+			t.Fatalf("retry %d expect pass, got %v", i, err)
+		}
+		r.Commit()
+	}
+	if spent := c.amountOf(c.spent, "alice"); spent.Int64() != 80 {
+		t.Errorf("expect 80 charged once, got %s", spent)
+	}
+	if _, err := c.Check(transferTx("alice", "carol", 30)); err == nil {
+		t.Error("expect committed amount to count in the daily limit")
+	}
+}
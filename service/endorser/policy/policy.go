@@ -0,0 +1,218 @@
+// Package policy 背书模块示例：按策略文件检查请求，通过后交给默认背书模块处理
+//
+// 使用方式：
+//  1. 在节点启动入口import _ "github.com/xuperchain/xuperchain/service/endorser/policy"
+//  2. server.yaml配置endorserModule: policy，endorserModuleConf指向策略文件
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+
+	sconf "github.com/xuperchain/xuperchain/service/config"
+	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xuperchain/service/rpc"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+)
+
+const (
+	// ModuleName 背书模块名
+	ModuleName = "policy"
+
+	// 交易费输出地址
+	feeAddr = "$"
+)
+
+func init() {
+	rpc.RegisterEndorser(ModuleName, NewPolicyXEndorser)
+}
+
+// Policy 背书策略
+type Policy struct {
+	// 允许调用的合约，为空表示不限制
+	ContractAllowlist []string `yaml:"contractAllowlist,omitempty"`
+	// 单笔请求允许消耗的最大gas，0表示不限制
+	MaxGas int64 `yaml:"maxGas,omitempty"`
+}
+
+// LoadPolicy 从文件加载背书策略，文件中出现未知配置项时报错
+func LoadPolicy(file string) (*Policy, error) {
+	viperObj := viper.New()
+	viperObj.SetConfigFile(file)
+	if err := viperObj.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("read policy failed.path:%s,err:%v", file, err)
+	}
+
+	p := &Policy{}
+	if err := viperObj.UnmarshalExact(p); err != nil {
+		return nil, fmt.Errorf("unmarshal policy failed.path:%s,err:%v", file, err)
+	}
+	if p.MaxGas < 0 {
+		return nil, fmt.Errorf("maxGas must not be negative.path:%s", file)
+	}
+	return p, nil
+}
+
+// PolicyXEndorser 按策略检查后委托DefaultXEndorser处理
+type PolicyXEndorser struct {
+	policy    *Policy
+	allowlist map[string]bool
+	next      rpc.XEndorser
+}
+
+var _ rpc.XEndorser = (*PolicyXEndorser)(nil)
+
+// NewPolicyXEndorser 背书模块构造方法，策略文件由endorserModuleConf指定
+func NewPolicyXEndorser(engine ecom.Engine, cfg *sconf.ServConf, svr rpc.XEndorserServer) (rpc.XEndorser, error) {
+	if cfg.EndorserModuleConf == "" {
+		return nil, fmt.Errorf("endorserModuleConf is required by endorser module %s", ModuleName)
+	}
+
+	file := cfg.EndorserModuleConf
+	if !filepath.IsAbs(file) {
+		file = engine.Context().EnvCfg.GenConfFilePath(file)
+	}
+	p, err := LoadPolicy(file)
+	if err != nil {
+		return nil, err
+	}
+
+	next, err := rpc.NewDefaultXEndorserWithConf(svr, engine, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewPolicyXEndorserWithNext(p, next), nil
+}
+
+// NewPolicyXEndorserWithNext 按策略检查后委托next处理
+func NewPolicyXEndorserWithNext(p *Policy, next rpc.XEndorser) *PolicyXEndorser {
+	allowlist := make(map[string]bool, len(p.ContractAllowlist))
+	for _, name := range p.ContractAllowlist {
+		allowlist[name] = true
+	}
+	return &PolicyXEndorser{
+		policy:    p,
+		allowlist: allowlist,
+		next:      next,
+	}
+}
+
+// EndorserCall 检查请求的合约和gas，不满足策略时拒绝背书
+func (pe *PolicyXEndorser) EndorserCall(ctx context.Context, req *pb.EndorserRequest) (*pb.EndorserResponse, error) {
+	if err := pe.checkRequest(req); err != nil {
+		return pe.refuse(req, err)
+	}
+
+	resp, err := pe.next.EndorserCall(ctx, req)
+	if err != nil || req.GetRequestName() != "PreExecWithFee" {
+		return resp, err
+	}
+
+	// 预执行后才知道实际消耗的gas
+	preExecRes := &pb.PreExecWithSelectUTXOResponse{}
+	if err := json.Unmarshal(resp.GetResponseData(), preExecRes); err != nil {
+		return pe.refuse(req, err)
+	}
+	if err := pe.checkGas(preExecRes.GetResponse().GetGasUsed()); err != nil {
+		return pe.refuse(req, err)
+	}
+	return resp, nil
+}
+
+// GetEndorserInfo 背书地址和公钥与next一致
+func (pe *PolicyXEndorser) GetEndorserInfo(ctx context.Context,
+	req *pb.EndorserInfoRequest) (*pb.EndorserInfoResponse, error) {
+	return pe.next.GetEndorserInfo(ctx, req)
+}
+
+// QueryEndorsement 背书记录由next保存
+func (pe *PolicyXEndorser) QueryEndorsement(ctx context.Context,
+	req *pb.QueryEndorsementRequest) (*pb.QueryEndorsementResponse, error) {
+	return pe.next.QueryEndorsement(ctx, req)
+}
+
+// Close 关闭next持有的资源
+func (pe *PolicyXEndorser) Close() {
+	if closer, ok := pe.next.(interface{ Close() }); ok {
+		closer.Close()
+	}
+}
+
+func (pe *PolicyXEndorser) checkRequest(req *pb.EndorserRequest) error {
+	switch req.GetRequestName() {
+	case "PreExecWithFee":
+		preExecReq := &pb.PreExecWithSelectUTXORequest{}
+		if err := json.Unmarshal(req.GetRequestData(), preExecReq); err != nil {
+			return err
+		}
+		return pe.checkContracts(preExecReq.GetRequest().GetRequests())
+
+	case "ComplianceCheck":
+		txStatus := &pb.TxStatus{}
+		if err := json.Unmarshal(req.GetRequestData(), txStatus); err != nil {
+			return err
+		}
+		if err := pe.checkContracts(txStatus.GetTx().GetContractRequests()); err != nil {
+			return err
+		}
+		return pe.checkGas(txFee(txStatus.GetTx()))
+
+	case "CrossQueryPreExec":
+		cqReq := &pb.CrossQueryRequest{}
+		if err := json.Unmarshal(req.GetRequestData(), cqReq); err != nil {
+			return err
+		}
+		return pe.checkContracts([]*pb.InvokeRequest{cqReq.GetRequest()})
+	}
+
+	return nil
+}
+
+func (pe *PolicyXEndorser) checkContracts(reqs []*pb.InvokeRequest) error {
+	if len(pe.allowlist) == 0 {
+		return nil
+	}
+	for _, req := range reqs {
+		if req == nil || req.GetContractName() == "" {
+			continue
+		}
+		if !pe.allowlist[req.GetContractName()] {
+			return fmt.Errorf("contract %s is not in allowlist", req.GetContractName())
+		}
+	}
+	return nil
+}
+
+func (pe *PolicyXEndorser) checkGas(gas int64) error {
+	if pe.policy.MaxGas > 0 && gas > pe.policy.MaxGas {
+		return fmt.Errorf("gas %d exceeds max gas %d", gas, pe.policy.MaxGas)
+	}
+	return nil
+}
+
+func (pe *PolicyXEndorser) refuse(req *pb.EndorserRequest, err error) (*pb.EndorserResponse, error) {
+	res := &pb.EndorserResponse{
+		Header: &pb.Header{
+			Logid: req.GetHeader().GetLogid(),
+			Error: pb.XChainErrorEnum_SERVICE_REFUSED_ERROR,
+		},
+		ResponseName: req.GetRequestName(),
+	}
+	return res, fmt.Errorf("endorser policy refused.err:%v", err)
+}
+
+// txFee 交易中支付给矿工的gas
+func txFee(tx *pb.Transaction) int64 {
+	fee := big.NewInt(0)
+	for _, output := range tx.GetTxOutputs() {
+		if string(output.GetToAddr()) == feeAddr {
+			fee.Add(fee, big.NewInt(0).SetBytes(output.GetAmount()))
+		}
+	}
+	return fee.Int64()
+}
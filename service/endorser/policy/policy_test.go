@@ -0,0 +1,82 @@
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+type mockXEndorser struct {
+	gasUsed int64
+}
+
+func (m *mockXEndorser) EndorserCall(ctx context.Context, req *pb.EndorserRequest) (*pb.EndorserResponse, error) {
+	res := &pb.PreExecWithSelectUTXOResponse{
+		Response: &pb.InvokeResponse{GasUsed: m.gasUsed},
+	}
+	data, _ := json.Marshal(res)
+	return &pb.EndorserResponse{ResponseName: req.GetRequestName(), ResponseData: data}, nil
+}
+
+func (m *mockXEndorser) GetEndorserInfo(ctx context.Context, req *pb.EndorserInfoRequest) (*pb.EndorserInfoResponse, error) {
+	return &pb.EndorserInfoResponse{BcName: req.GetBcName()}, nil
+}
+
+func (m *mockXEndorser) QueryEndorsement(ctx context.Context, req *pb.QueryEndorsementRequest) (*pb.QueryEndorsementResponse, error) {
+	return &pb.QueryEndorsementResponse{}, nil
+}
+
+func TestLoadPolicy(t *testing.T) {
+	dir, err := ioutil.TempDir("", "policy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "policy.yaml")
+	ioutil.WriteFile(file, []byte("contractAllowlist:\n  - counter\nmaxGas: 100\n"), 0644)
+	p, err := LoadPolicy(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.ContractAllowlist) != 1 || p.MaxGas != 100 {
+		t.Errorf("unexpected policy: %+v", p)
+	}
+
+	ioutil.WriteFile(file, []byte("maxgass: 100\n"), 0644)
+	if _, err := LoadPolicy(file); err == nil {
+		t.Error("unknown key should be refused")
+	}
+}
+
+func TestPolicyXEndorser(t *testing.T) {
+	p := &Policy{ContractAllowlist: []string{"counter"}, MaxGas: 100}
+	genReq := func(contract string) *pb.EndorserRequest {
+		preq := &pb.PreExecWithSelectUTXORequest{
+			Request: &pb.InvokeRPCRequest{
+				Requests: []*pb.InvokeRequest{{ModuleName: "wasm", ContractName: contract}},
+			},
+		}
+		data, _ := json.Marshal(preq)
+		return &pb.EndorserRequest{RequestName: "PreExecWithFee", RequestData: data}
+	}
+
+	pe := NewPolicyXEndorserWithNext(p, &mockXEndorser{gasUsed: 10})
+	if _, err := pe.EndorserCall(context.TODO(), genReq("counter")); err != nil {
+		t.Errorf("allowed contract refused.err:%v", err)
+	}
+	resp, err := pe.EndorserCall(context.TODO(), genReq("erc20"))
+	if err == nil || resp.GetHeader().GetError() != pb.XChainErrorEnum_SERVICE_REFUSED_ERROR {
+		t.Error("contract not in allowlist should be refused")
+	}
+
+	pe = NewPolicyXEndorserWithNext(p, &mockXEndorser{gasUsed: 1000})
+	if _, err := pe.EndorserCall(context.TODO(), genReq("counter")); err == nil {
+		t.Error("request exceeding max gas should be refused")
+	}
+}
@@ -296,6 +296,37 @@ func (ResourceType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_db0991b9525664ca, []int{4}
 }
 
+type GetTxStatusResponse_TxState int32
+
+const (
+	GetTxStatusResponse_UNKNOWN   GetTxStatusResponse_TxState = 0
+	GetTxStatusResponse_PENDING   GetTxStatusResponse_TxState = 1
+	GetTxStatusResponse_CONFIRMED GetTxStatusResponse_TxState = 2
+	GetTxStatusResponse_FAILED    GetTxStatusResponse_TxState = 3
+)
+
+var GetTxStatusResponse_TxState_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "PENDING",
+	2: "CONFIRMED",
+	3: "FAILED",
+}
+
+var GetTxStatusResponse_TxState_value = map[string]int32{
+	"UNKNOWN":   0,
+	"PENDING":   1,
+	"CONFIRMED": 2,
+	"FAILED":    3,
+}
+
+func (x GetTxStatusResponse_TxState) String() string {
+	return proto.EnumName(GetTxStatusResponse_TxState_name, int32(x))
+}
+
+func (GetTxStatusResponse_TxState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{7, 0}
+}
+
 type Block_EBlockStatus int32
 
 const (
@@ -324,7 +355,7 @@ func (x Block_EBlockStatus) String() string {
 }
 
 func (Block_EBlockStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{5, 0}
+	return fileDescriptor_db0991b9525664ca, []int{17, 0}
 }
 
 type Header struct {
@@ -352,306 +383,1162 @@ func (m *Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 func (m *Header) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_Header.Merge(m, src)
 }
-func (m *Header) XXX_Size() int {
-	return xxx_messageInfo_Header.Size(m)
+func (m *Header) XXX_Size() int {
+	return xxx_messageInfo_Header.Size(m)
+}
+func (m *Header) XXX_DiscardUnknown() {
+	xxx_messageInfo_Header.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Header proto.InternalMessageInfo
+
+func (m *Header) GetLogid() string {
+	if m != nil {
+		return m.Logid
+	}
+	return ""
+}
+
+func (m *Header) GetFromNode() string {
+	if m != nil {
+		return m.FromNode
+	}
+	return ""
+}
+
+func (m *Header) GetError() XChainErrorEnum {
+	if m != nil {
+		return m.Error
+	}
+	return XChainErrorEnum_SUCCESS
+}
+
+type TxDataAccount struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount               string   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	FrozenHeight         int64    `protobuf:"varint,3,opt,name=frozen_height,json=frozenHeight,proto3" json:"frozen_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxDataAccount) Reset()         { *m = TxDataAccount{} }
+func (m *TxDataAccount) String() string { return proto.CompactTextString(m) }
+func (*TxDataAccount) ProtoMessage()    {}
+func (*TxDataAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{1}
+}
+
+func (m *TxDataAccount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxDataAccount.Unmarshal(m, b)
+}
+func (m *TxDataAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxDataAccount.Marshal(b, m, deterministic)
+}
+func (m *TxDataAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxDataAccount.Merge(m, src)
+}
+func (m *TxDataAccount) XXX_Size() int {
+	return xxx_messageInfo_TxDataAccount.Size(m)
+}
+func (m *TxDataAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxDataAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxDataAccount proto.InternalMessageInfo
+
+func (m *TxDataAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TxDataAccount) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *TxDataAccount) GetFrozenHeight() int64 {
+	if m != nil {
+		return m.FrozenHeight
+	}
+	return 0
+}
+
+type TxData struct {
+	Header *Header `protobuf:"bytes,13,opt,name=header,proto3" json:"header,omitempty"`
+	// Transaction id
+	Txid []byte `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// Block name
+	Bcname string `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	// Account which start the transaction
+	FromAddr string `protobuf:"bytes,3,opt,name=from_addr,json=fromAddr,proto3" json:"from_addr,omitempty"`
+	// The pk of the account which start the transaction
+	FromPubkey string `protobuf:"bytes,4,opt,name=from_pubkey,json=fromPubkey,proto3" json:"from_pubkey,omitempty"`
+	// The sk of the account which start the transaction
+	FromScrkey string `protobuf:"bytes,5,opt,name=from_scrkey,json=fromScrkey,proto3" json:"from_scrkey,omitempty"`
+	// if user don't want to provide scrkey, he should sign the txdata into
+	// user_sign
+	UserSign []byte `protobuf:"bytes,14,opt,name=user_sign,json=userSign,proto3" json:"user_sign,omitempty"`
+	// The account list accept tx
+	Account []*TxDataAccount `protobuf:"bytes,6,rep,name=account,proto3" json:"account,omitempty"`
+	// Random number used to avoid replay attacks
+	Nonce string `protobuf:"bytes,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Timestamp to launch the Tx
+	Timestamp int64  `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Desc      []byte `protobuf:"bytes,12,opt,name=desc,proto3" json:"desc,omitempty"`
+	// tx version
+	Version              int32    `protobuf:"varint,15,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxData) Reset()         { *m = TxData{} }
+func (m *TxData) String() string { return proto.CompactTextString(m) }
+func (*TxData) ProtoMessage()    {}
+func (*TxData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{2}
+}
+
+func (m *TxData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxData.Unmarshal(m, b)
+}
+func (m *TxData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxData.Marshal(b, m, deterministic)
+}
+func (m *TxData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxData.Merge(m, src)
+}
+func (m *TxData) XXX_Size() int {
+	return xxx_messageInfo_TxData.Size(m)
+}
+func (m *TxData) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxData proto.InternalMessageInfo
+
+func (m *TxData) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *TxData) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+func (m *TxData) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *TxData) GetFromAddr() string {
+	if m != nil {
+		return m.FromAddr
+	}
+	return ""
+}
+
+func (m *TxData) GetFromPubkey() string {
+	if m != nil {
+		return m.FromPubkey
+	}
+	return ""
+}
+
+func (m *TxData) GetFromScrkey() string {
+	if m != nil {
+		return m.FromScrkey
+	}
+	return ""
+}
+
+func (m *TxData) GetUserSign() []byte {
+	if m != nil {
+		return m.UserSign
+	}
+	return nil
+}
+
+func (m *TxData) GetAccount() []*TxDataAccount {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *TxData) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+func (m *TxData) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *TxData) GetDesc() []byte {
+	if m != nil {
+		return m.Desc
+	}
+	return nil
+}
+
+func (m *TxData) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type TxStatus struct {
+	Header               *Header           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string            `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Txid                 []byte            `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	Status               TransactionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=pb.TransactionStatus" json:"status,omitempty"`
+	Distance             int64             `protobuf:"varint,5,opt,name=distance,proto3" json:"distance,omitempty"`
+	Tx                   *Transaction      `protobuf:"bytes,7,opt,name=tx,proto3" json:"tx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *TxStatus) Reset()         { *m = TxStatus{} }
+func (m *TxStatus) String() string { return proto.CompactTextString(m) }
+func (*TxStatus) ProtoMessage()    {}
+func (*TxStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{3}
+}
+
+func (m *TxStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxStatus.Unmarshal(m, b)
+}
+func (m *TxStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxStatus.Marshal(b, m, deterministic)
+}
+func (m *TxStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxStatus.Merge(m, src)
+}
+func (m *TxStatus) XXX_Size() int {
+	return xxx_messageInfo_TxStatus.Size(m)
+}
+func (m *TxStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxStatus proto.InternalMessageInfo
+
+func (m *TxStatus) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *TxStatus) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *TxStatus) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+func (m *TxStatus) GetStatus() TransactionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TransactionStatus_UNDEFINE
+}
+
+func (m *TxStatus) GetDistance() int64 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *TxStatus) GetTx() *Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+type TxProofRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Txid                 []byte   `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxProofRequest) Reset()         { *m = TxProofRequest{} }
+func (m *TxProofRequest) String() string { return proto.CompactTextString(m) }
+func (*TxProofRequest) ProtoMessage()    {}
+func (*TxProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{4}
+}
+
+func (m *TxProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxProofRequest.Unmarshal(m, b)
+}
+func (m *TxProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxProofRequest.Marshal(b, m, deterministic)
+}
+func (m *TxProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxProofRequest.Merge(m, src)
+}
+func (m *TxProofRequest) XXX_Size() int {
+	return xxx_messageInfo_TxProofRequest.Size(m)
+}
+func (m *TxProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxProofRequest proto.InternalMessageInfo
+
+func (m *TxProofRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *TxProofRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *TxProofRequest) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+// 交易的merkle包含证明，轻节点可以只凭区块头校验交易是否在区块中
+type TxProof struct {
+	Header               *Header           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string            `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Txid                 []byte            `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	BlockHeader          *InternalBlock    `protobuf:"bytes,4,opt,name=block_header,json=blockHeader,proto3" json:"block_header,omitempty"`
+	TxIndex              int64             `protobuf:"varint,5,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	MerklePath           [][]byte          `protobuf:"bytes,6,rep,name=merkle_path,json=merklePath,proto3" json:"merkle_path,omitempty"`
+	Status               TransactionStatus `protobuf:"varint,7,opt,name=status,proto3,enum=pb.TransactionStatus" json:"status,omitempty"`
+	Distance             int64             `protobuf:"varint,8,opt,name=distance,proto3" json:"distance,omitempty"`
+	IsTrunkTip           bool              `protobuf:"varint,9,opt,name=is_trunk_tip,json=isTrunkTip,proto3" json:"is_trunk_tip,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *TxProof) Reset()         { *m = TxProof{} }
+func (m *TxProof) String() string { return proto.CompactTextString(m) }
+func (*TxProof) ProtoMessage()    {}
+func (*TxProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{5}
+}
+
+func (m *TxProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxProof.Unmarshal(m, b)
+}
+func (m *TxProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxProof.Marshal(b, m, deterministic)
+}
+func (m *TxProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxProof.Merge(m, src)
+}
+func (m *TxProof) XXX_Size() int {
+	return xxx_messageInfo_TxProof.Size(m)
+}
+func (m *TxProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxProof proto.InternalMessageInfo
+
+func (m *TxProof) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *TxProof) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *TxProof) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+func (m *TxProof) GetBlockHeader() *InternalBlock {
+	if m != nil {
+		return m.BlockHeader
+	}
+	return nil
+}
+
+func (m *TxProof) GetTxIndex() int64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *TxProof) GetMerklePath() [][]byte {
+	if m != nil {
+		return m.MerklePath
+	}
+	return nil
+}
+
+func (m *TxProof) GetStatus() TransactionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TransactionStatus_UNDEFINE
+}
+
+func (m *TxProof) GetDistance() int64 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *TxProof) GetIsTrunkTip() bool {
+	if m != nil {
+		return m.IsTrunkTip
+	}
+	return false
+}
+
+type GetTxStatusRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Txid                 []byte   `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTxStatusRequest) Reset()         { *m = GetTxStatusRequest{} }
+func (m *GetTxStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTxStatusRequest) ProtoMessage()    {}
+func (*GetTxStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{6}
+}
+
+func (m *GetTxStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTxStatusRequest.Unmarshal(m, b)
+}
+func (m *GetTxStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTxStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTxStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxStatusRequest.Merge(m, src)
+}
+func (m *GetTxStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTxStatusRequest.Size(m)
+}
+func (m *GetTxStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTxStatusRequest proto.InternalMessageInfo
+
+func (m *GetTxStatusRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *GetTxStatusRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *GetTxStatusRequest) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+// 交易的生命周期状态
+type GetTxStatusResponse struct {
+	Header               *Header                     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string                      `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Txid                 []byte                      `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	State                GetTxStatusResponse_TxState `protobuf:"varint,4,opt,name=state,proto3,enum=pb.GetTxStatusResponse_TxState" json:"state,omitempty"`
+	Blockid              []byte                      `protobuf:"bytes,5,opt,name=blockid,proto3" json:"blockid,omitempty"`
+	Height               int64                       `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Confirmations        int64                       `protobuf:"varint,7,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Reason               string                      `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *GetTxStatusResponse) Reset()         { *m = GetTxStatusResponse{} }
+func (m *GetTxStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTxStatusResponse) ProtoMessage()    {}
+func (*GetTxStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{7}
+}
+
+func (m *GetTxStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTxStatusResponse.Unmarshal(m, b)
+}
+func (m *GetTxStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTxStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTxStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxStatusResponse.Merge(m, src)
+}
+func (m *GetTxStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTxStatusResponse.Size(m)
+}
+func (m *GetTxStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTxStatusResponse proto.InternalMessageInfo
+
+func (m *GetTxStatusResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *GetTxStatusResponse) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *GetTxStatusResponse) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+func (m *GetTxStatusResponse) GetState() GetTxStatusResponse_TxState {
+	if m != nil {
+		return m.State
+	}
+	return GetTxStatusResponse_UNKNOWN
+}
+
+func (m *GetTxStatusResponse) GetBlockid() []byte {
+	if m != nil {
+		return m.Blockid
+	}
+	return nil
+}
+
+func (m *GetTxStatusResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetTxStatusResponse) GetConfirmations() int64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *GetTxStatusResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// 未确认交易过滤条件，条件之间为与关系，为空表示不过滤
+type PendingTxFilter struct {
+	Initiator            string   `protobuf:"bytes,1,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	Contract             string   `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	Limit                int64    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingTxFilter) Reset()         { *m = PendingTxFilter{} }
+func (m *PendingTxFilter) String() string { return proto.CompactTextString(m) }
+func (*PendingTxFilter) ProtoMessage()    {}
+func (*PendingTxFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{8}
+}
+
+func (m *PendingTxFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingTxFilter.Unmarshal(m, b)
+}
+func (m *PendingTxFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingTxFilter.Marshal(b, m, deterministic)
+}
+func (m *PendingTxFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTxFilter.Merge(m, src)
+}
+func (m *PendingTxFilter) XXX_Size() int {
+	return xxx_messageInfo_PendingTxFilter.Size(m)
+}
+func (m *PendingTxFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTxFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTxFilter proto.InternalMessageInfo
+
+func (m *PendingTxFilter) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
+	}
+	return ""
+}
+
+func (m *PendingTxFilter) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *PendingTxFilter) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListPendingTxsRequest struct {
+	Header               *Header          `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string           `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Filter               *PendingTxFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListPendingTxsRequest) Reset()         { *m = ListPendingTxsRequest{} }
+func (m *ListPendingTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPendingTxsRequest) ProtoMessage()    {}
+func (*ListPendingTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{9}
+}
+
+func (m *ListPendingTxsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPendingTxsRequest.Unmarshal(m, b)
+}
+func (m *ListPendingTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPendingTxsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListPendingTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPendingTxsRequest.Merge(m, src)
+}
+func (m *ListPendingTxsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPendingTxsRequest.Size(m)
+}
+func (m *ListPendingTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPendingTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPendingTxsRequest proto.InternalMessageInfo
+
+func (m *ListPendingTxsRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ListPendingTxsRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *ListPendingTxsRequest) GetFilter() *PendingTxFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+type PendingTx struct {
+	Txid                 []byte   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Initiator            string   `protobuf:"bytes,2,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	ReceivedTimestamp    int64    `protobuf:"varint,3,opt,name=received_timestamp,json=receivedTimestamp,proto3" json:"received_timestamp,omitempty"`
+	Size                 int64    `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Contracts            []string `protobuf:"bytes,5,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	Depends              [][]byte `protobuf:"bytes,6,rep,name=depends,proto3" json:"depends,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingTx) Reset()         { *m = PendingTx{} }
+func (m *PendingTx) String() string { return proto.CompactTextString(m) }
+func (*PendingTx) ProtoMessage()    {}
+func (*PendingTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{10}
+}
+
+func (m *PendingTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingTx.Unmarshal(m, b)
+}
+func (m *PendingTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingTx.Marshal(b, m, deterministic)
+}
+func (m *PendingTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTx.Merge(m, src)
+}
+func (m *PendingTx) XXX_Size() int {
+	return xxx_messageInfo_PendingTx.Size(m)
+}
+func (m *PendingTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTx proto.InternalMessageInfo
+
+func (m *PendingTx) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+func (m *PendingTx) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
+	}
+	return ""
+}
+
+func (m *PendingTx) GetReceivedTimestamp() int64 {
+	if m != nil {
+		return m.ReceivedTimestamp
+	}
+	return 0
+}
+
+func (m *PendingTx) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *PendingTx) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+func (m *PendingTx) GetDepends() [][]byte {
+	if m != nil {
+		return m.Depends
+	}
+	return nil
+}
+
+type ListPendingTxsResponse struct {
+	Header               *Header      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string       `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Total                int64        `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Txs                  []*PendingTx `protobuf:"bytes,4,rep,name=txs,proto3" json:"txs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListPendingTxsResponse) Reset()         { *m = ListPendingTxsResponse{} }
+func (m *ListPendingTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPendingTxsResponse) ProtoMessage()    {}
+func (*ListPendingTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{11}
+}
+
+func (m *ListPendingTxsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPendingTxsResponse.Unmarshal(m, b)
+}
+func (m *ListPendingTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPendingTxsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListPendingTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPendingTxsResponse.Merge(m, src)
 }
-func (m *Header) XXX_DiscardUnknown() {
-	xxx_messageInfo_Header.DiscardUnknown(m)
+func (m *ListPendingTxsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPendingTxsResponse.Size(m)
+}
+func (m *ListPendingTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPendingTxsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Header proto.InternalMessageInfo
+var xxx_messageInfo_ListPendingTxsResponse proto.InternalMessageInfo
 
-func (m *Header) GetLogid() string {
+func (m *ListPendingTxsResponse) GetHeader() *Header {
 	if m != nil {
-		return m.Logid
+		return m.Header
 	}
-	return ""
+	return nil
 }
 
-func (m *Header) GetFromNode() string {
+func (m *ListPendingTxsResponse) GetBcname() string {
 	if m != nil {
-		return m.FromNode
+		return m.Bcname
 	}
 	return ""
 }
 
-func (m *Header) GetError() XChainErrorEnum {
+func (m *ListPendingTxsResponse) GetTotal() int64 {
 	if m != nil {
-		return m.Error
+		return m.Total
 	}
-	return XChainErrorEnum_SUCCESS
+	return 0
 }
 
-type TxDataAccount struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Amount               string   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	FrozenHeight         int64    `protobuf:"varint,3,opt,name=frozen_height,json=frozenHeight,proto3" json:"frozen_height,omitempty"`
+func (m *ListPendingTxsResponse) GetTxs() []*PendingTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+type GetMempoolStatsRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TxDataAccount) Reset()         { *m = TxDataAccount{} }
-func (m *TxDataAccount) String() string { return proto.CompactTextString(m) }
-func (*TxDataAccount) ProtoMessage()    {}
-func (*TxDataAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{1}
+func (m *GetMempoolStatsRequest) Reset()         { *m = GetMempoolStatsRequest{} }
+func (m *GetMempoolStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMempoolStatsRequest) ProtoMessage()    {}
+func (*GetMempoolStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{12}
 }
 
-func (m *TxDataAccount) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TxDataAccount.Unmarshal(m, b)
+func (m *GetMempoolStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMempoolStatsRequest.Unmarshal(m, b)
 }
-func (m *TxDataAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TxDataAccount.Marshal(b, m, deterministic)
+func (m *GetMempoolStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMempoolStatsRequest.Marshal(b, m, deterministic)
 }
-func (m *TxDataAccount) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxDataAccount.Merge(m, src)
+func (m *GetMempoolStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMempoolStatsRequest.Merge(m, src)
 }
-func (m *TxDataAccount) XXX_Size() int {
-	return xxx_messageInfo_TxDataAccount.Size(m)
+func (m *GetMempoolStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMempoolStatsRequest.Size(m)
 }
-func (m *TxDataAccount) XXX_DiscardUnknown() {
-	xxx_messageInfo_TxDataAccount.DiscardUnknown(m)
+func (m *GetMempoolStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMempoolStatsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TxDataAccount proto.InternalMessageInfo
+var xxx_messageInfo_GetMempoolStatsRequest proto.InternalMessageInfo
 
-func (m *TxDataAccount) GetAddress() string {
+func (m *GetMempoolStatsRequest) GetHeader() *Header {
 	if m != nil {
-		return m.Address
+		return m.Header
 	}
-	return ""
+	return nil
 }
 
-func (m *TxDataAccount) GetAmount() string {
+func (m *GetMempoolStatsRequest) GetBcname() string {
 	if m != nil {
-		return m.Amount
+		return m.Bcname
 	}
 	return ""
 }
 
-func (m *TxDataAccount) GetFrozenHeight() int64 {
-	if m != nil {
-		return m.FrozenHeight
-	}
-	return 0
-}
-
-type TxData struct {
-	Header *Header `protobuf:"bytes,13,opt,name=header,proto3" json:"header,omitempty"`
-	// Transaction id
-	Txid []byte `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
-	// Block name
-	Bcname string `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	// Account which start the transaction
-	FromAddr string `protobuf:"bytes,3,opt,name=from_addr,json=fromAddr,proto3" json:"from_addr,omitempty"`
-	// The pk of the account which start the transaction
-	FromPubkey string `protobuf:"bytes,4,opt,name=from_pubkey,json=fromPubkey,proto3" json:"from_pubkey,omitempty"`
-	// The sk of the account which start the transaction
-	FromScrkey string `protobuf:"bytes,5,opt,name=from_scrkey,json=fromScrkey,proto3" json:"from_scrkey,omitempty"`
-	// if user don't want to provide scrkey, he should sign the txdata into
-	// user_sign
-	UserSign []byte `protobuf:"bytes,14,opt,name=user_sign,json=userSign,proto3" json:"user_sign,omitempty"`
-	// The account list accept tx
-	Account []*TxDataAccount `protobuf:"bytes,6,rep,name=account,proto3" json:"account,omitempty"`
-	// Random number used to avoid replay attacks
-	Nonce string `protobuf:"bytes,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	// Timestamp to launch the Tx
-	Timestamp int64  `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Desc      []byte `protobuf:"bytes,12,opt,name=desc,proto3" json:"desc,omitempty"`
-	// tx version
-	Version              int32    `protobuf:"varint,15,opt,name=version,proto3" json:"version,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetMempoolStatsResponse struct {
+	Header               *Header          `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string           `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Count                int64            `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Bytes                int64            `protobuf:"varint,4,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	OldestAgeMs          int64            `protobuf:"varint,5,opt,name=oldest_age_ms,json=oldestAgeMs,proto3" json:"oldest_age_ms,omitempty"`
+	InitiatorCounts      map[string]int64 `protobuf:"bytes,6,rep,name=initiator_counts,json=initiatorCounts,proto3" json:"initiator_counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *TxData) Reset()         { *m = TxData{} }
-func (m *TxData) String() string { return proto.CompactTextString(m) }
-func (*TxData) ProtoMessage()    {}
-func (*TxData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{2}
+func (m *GetMempoolStatsResponse) Reset()         { *m = GetMempoolStatsResponse{} }
+func (m *GetMempoolStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMempoolStatsResponse) ProtoMessage()    {}
+func (*GetMempoolStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{13}
 }
 
-func (m *TxData) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TxData.Unmarshal(m, b)
+func (m *GetMempoolStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMempoolStatsResponse.Unmarshal(m, b)
 }
-func (m *TxData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TxData.Marshal(b, m, deterministic)
+func (m *GetMempoolStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMempoolStatsResponse.Marshal(b, m, deterministic)
 }
-func (m *TxData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxData.Merge(m, src)
+func (m *GetMempoolStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMempoolStatsResponse.Merge(m, src)
 }
-func (m *TxData) XXX_Size() int {
-	return xxx_messageInfo_TxData.Size(m)
+func (m *GetMempoolStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMempoolStatsResponse.Size(m)
 }
-func (m *TxData) XXX_DiscardUnknown() {
-	xxx_messageInfo_TxData.DiscardUnknown(m)
+func (m *GetMempoolStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMempoolStatsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TxData proto.InternalMessageInfo
+var xxx_messageInfo_GetMempoolStatsResponse proto.InternalMessageInfo
 
-func (m *TxData) GetHeader() *Header {
+func (m *GetMempoolStatsResponse) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *TxData) GetTxid() []byte {
-	if m != nil {
-		return m.Txid
-	}
-	return nil
-}
-
-func (m *TxData) GetBcname() string {
+func (m *GetMempoolStatsResponse) GetBcname() string {
 	if m != nil {
 		return m.Bcname
 	}
 	return ""
 }
 
-func (m *TxData) GetFromAddr() string {
+func (m *GetMempoolStatsResponse) GetCount() int64 {
 	if m != nil {
-		return m.FromAddr
+		return m.Count
 	}
-	return ""
+	return 0
 }
 
-func (m *TxData) GetFromPubkey() string {
+func (m *GetMempoolStatsResponse) GetBytes() int64 {
 	if m != nil {
-		return m.FromPubkey
+		return m.Bytes
 	}
-	return ""
+	return 0
 }
 
-func (m *TxData) GetFromScrkey() string {
+func (m *GetMempoolStatsResponse) GetOldestAgeMs() int64 {
 	if m != nil {
-		return m.FromScrkey
+		return m.OldestAgeMs
 	}
-	return ""
+	return 0
 }
 
-func (m *TxData) GetUserSign() []byte {
+func (m *GetMempoolStatsResponse) GetInitiatorCounts() map[string]int64 {
 	if m != nil {
-		return m.UserSign
+		return m.InitiatorCounts
 	}
 	return nil
 }
 
-func (m *TxData) GetAccount() []*TxDataAccount {
+type EstimateFeeRequest struct {
+	Header               *Header          `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string           `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Requests             []*InvokeRequest `protobuf:"bytes,3,rep,name=requests,proto3" json:"requests,omitempty"`
+	Initiator            string           `protobuf:"bytes,4,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	AuthRequire          []string         `protobuf:"bytes,5,rep,name=auth_require,json=authRequire,proto3" json:"auth_require,omitempty"`
+	RecentBlocks         int64            `protobuf:"varint,6,opt,name=recent_blocks,json=recentBlocks,proto3" json:"recent_blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *EstimateFeeRequest) Reset()         { *m = EstimateFeeRequest{} }
+func (m *EstimateFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateFeeRequest) ProtoMessage()    {}
+func (*EstimateFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{14}
+}
+
+func (m *EstimateFeeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateFeeRequest.Unmarshal(m, b)
+}
+func (m *EstimateFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateFeeRequest.Marshal(b, m, deterministic)
+}
+func (m *EstimateFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateFeeRequest.Merge(m, src)
+}
+func (m *EstimateFeeRequest) XXX_Size() int {
+	return xxx_messageInfo_EstimateFeeRequest.Size(m)
+}
+func (m *EstimateFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateFeeRequest proto.InternalMessageInfo
+
+func (m *EstimateFeeRequest) GetHeader() *Header {
 	if m != nil {
-		return m.Account
+		return m.Header
 	}
 	return nil
 }
 
-func (m *TxData) GetNonce() string {
+func (m *EstimateFeeRequest) GetBcname() string {
 	if m != nil {
-		return m.Nonce
+		return m.Bcname
 	}
 	return ""
 }
 
-func (m *TxData) GetTimestamp() int64 {
+func (m *EstimateFeeRequest) GetRequests() []*InvokeRequest {
 	if m != nil {
-		return m.Timestamp
+		return m.Requests
 	}
-	return 0
+	return nil
 }
 
-func (m *TxData) GetDesc() []byte {
+func (m *EstimateFeeRequest) GetInitiator() string {
 	if m != nil {
-		return m.Desc
+		return m.Initiator
 	}
-	return nil
+	return ""
 }
 
-func (m *TxData) GetVersion() int32 {
+func (m *EstimateFeeRequest) GetAuthRequire() []string {
 	if m != nil {
-		return m.Version
+		return m.AuthRequire
 	}
-	return 0
+	return nil
 }
 
-type TxStatus struct {
-	Header               *Header           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Bcname               string            `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	Txid                 []byte            `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
-	Status               TransactionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=pb.TransactionStatus" json:"status,omitempty"`
-	Distance             int64             `protobuf:"varint,5,opt,name=distance,proto3" json:"distance,omitempty"`
-	Tx                   *Transaction      `protobuf:"bytes,7,opt,name=tx,proto3" json:"tx,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *EstimateFeeRequest) GetRecentBlocks() int64 {
+	if m != nil {
+		return m.RecentBlocks
+	}
+	return 0
 }
 
-func (m *TxStatus) Reset()         { *m = TxStatus{} }
-func (m *TxStatus) String() string { return proto.CompactTextString(m) }
-func (*TxStatus) ProtoMessage()    {}
-func (*TxStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{3}
+// 手续费估算结果，手续费不能低于gas_used
+type EstimateFeeResponse struct {
+	Header               *Header   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string    `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	GasUsed              int64     `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	GasPrice             *GasPrice `protobuf:"bytes,4,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	RecentSamples        int64     `protobuf:"varint,5,opt,name=recent_samples,json=recentSamples,proto3" json:"recent_samples,omitempty"`
+	RecentFeeMedian      int64     `protobuf:"varint,6,opt,name=recent_fee_median,json=recentFeeMedian,proto3" json:"recent_fee_median,omitempty"`
+	SuggestedFee         int64     `protobuf:"varint,7,opt,name=suggested_fee,json=suggestedFee,proto3" json:"suggested_fee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *EstimateFeeResponse) Reset()         { *m = EstimateFeeResponse{} }
+func (m *EstimateFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateFeeResponse) ProtoMessage()    {}
+func (*EstimateFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{15}
 }
 
-func (m *TxStatus) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TxStatus.Unmarshal(m, b)
+func (m *EstimateFeeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateFeeResponse.Unmarshal(m, b)
 }
-func (m *TxStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TxStatus.Marshal(b, m, deterministic)
+func (m *EstimateFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateFeeResponse.Marshal(b, m, deterministic)
 }
-func (m *TxStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxStatus.Merge(m, src)
+func (m *EstimateFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateFeeResponse.Merge(m, src)
 }
-func (m *TxStatus) XXX_Size() int {
-	return xxx_messageInfo_TxStatus.Size(m)
+func (m *EstimateFeeResponse) XXX_Size() int {
+	return xxx_messageInfo_EstimateFeeResponse.Size(m)
 }
-func (m *TxStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_TxStatus.DiscardUnknown(m)
+func (m *EstimateFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateFeeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TxStatus proto.InternalMessageInfo
+var xxx_messageInfo_EstimateFeeResponse proto.InternalMessageInfo
 
-func (m *TxStatus) GetHeader() *Header {
+func (m *EstimateFeeResponse) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *TxStatus) GetBcname() string {
+func (m *EstimateFeeResponse) GetBcname() string {
 	if m != nil {
 		return m.Bcname
 	}
 	return ""
 }
 
-func (m *TxStatus) GetTxid() []byte {
+func (m *EstimateFeeResponse) GetGasUsed() int64 {
 	if m != nil {
-		return m.Txid
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *EstimateFeeResponse) GetGasPrice() *GasPrice {
+	if m != nil {
+		return m.GasPrice
 	}
 	return nil
 }
 
-func (m *TxStatus) GetStatus() TransactionStatus {
+func (m *EstimateFeeResponse) GetRecentSamples() int64 {
 	if m != nil {
-		return m.Status
+		return m.RecentSamples
 	}
-	return TransactionStatus_UNDEFINE
+	return 0
 }
 
-func (m *TxStatus) GetDistance() int64 {
+func (m *EstimateFeeResponse) GetRecentFeeMedian() int64 {
 	if m != nil {
-		return m.Distance
+		return m.RecentFeeMedian
 	}
 	return 0
 }
 
-func (m *TxStatus) GetTx() *Transaction {
+func (m *EstimateFeeResponse) GetSuggestedFee() int64 {
 	if m != nil {
-		return m.Tx
+		return m.SuggestedFee
 	}
-	return nil
+	return 0
 }
 
 type BatchTxs struct {
@@ -666,7 +1553,7 @@ func (m *BatchTxs) Reset()         { *m = BatchTxs{} }
 func (m *BatchTxs) String() string { return proto.CompactTextString(m) }
 func (*BatchTxs) ProtoMessage()    {}
 func (*BatchTxs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{4}
+	return fileDescriptor_db0991b9525664ca, []int{16}
 }
 
 func (m *BatchTxs) XXX_Unmarshal(b []byte) error {
@@ -716,7 +1603,7 @@ func (m *Block) Reset()         { *m = Block{} }
 func (m *Block) String() string { return proto.CompactTextString(m) }
 func (*Block) ProtoMessage()    {}
 func (*Block) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{5}
+	return fileDescriptor_db0991b9525664ca, []int{17}
 }
 
 func (m *Block) XXX_Unmarshal(b []byte) error {
@@ -787,7 +1674,7 @@ func (m *BlockID) Reset()         { *m = BlockID{} }
 func (m *BlockID) String() string { return proto.CompactTextString(m) }
 func (*BlockID) ProtoMessage()    {}
 func (*BlockID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{6}
+	return fileDescriptor_db0991b9525664ca, []int{18}
 }
 
 func (m *BlockID) XXX_Unmarshal(b []byte) error {
@@ -849,7 +1736,7 @@ func (m *BlockHeight) Reset()         { *m = BlockHeight{} }
 func (m *BlockHeight) String() string { return proto.CompactTextString(m) }
 func (*BlockHeight) ProtoMessage()    {}
 func (*BlockHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{7}
+	return fileDescriptor_db0991b9525664ca, []int{19}
 }
 
 func (m *BlockHeight) XXX_Unmarshal(b []byte) error {
@@ -902,7 +1789,7 @@ func (m *CommonReply) Reset()         { *m = CommonReply{} }
 func (m *CommonReply) String() string { return proto.CompactTextString(m) }
 func (*CommonReply) ProtoMessage()    {}
 func (*CommonReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{8}
+	return fileDescriptor_db0991b9525664ca, []int{20}
 }
 
 func (m *CommonReply) XXX_Unmarshal(b []byte) error {
@@ -942,7 +1829,7 @@ func (m *CommonIn) Reset()         { *m = CommonIn{} }
 func (m *CommonIn) String() string { return proto.CompactTextString(m) }
 func (*CommonIn) ProtoMessage()    {}
 func (*CommonIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{9}
+	return fileDescriptor_db0991b9525664ca, []int{21}
 }
 
 func (m *CommonIn) XXX_Unmarshal(b []byte) error {
@@ -990,7 +1877,7 @@ func (m *TokenDetail) Reset()         { *m = TokenDetail{} }
 func (m *TokenDetail) String() string { return proto.CompactTextString(m) }
 func (*TokenDetail) ProtoMessage()    {}
 func (*TokenDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{10}
+	return fileDescriptor_db0991b9525664ca, []int{22}
 }
 
 func (m *TokenDetail) XXX_Unmarshal(b []byte) error {
@@ -1045,7 +1932,7 @@ func (m *AddressStatus) Reset()         { *m = AddressStatus{} }
 func (m *AddressStatus) String() string { return proto.CompactTextString(m) }
 func (*AddressStatus) ProtoMessage()    {}
 func (*AddressStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{11}
+	return fileDescriptor_db0991b9525664ca, []int{23}
 }
 
 func (m *AddressStatus) XXX_Unmarshal(b []byte) error {
@@ -1099,7 +1986,7 @@ func (m *TokenFrozenDetail) Reset()         { *m = TokenFrozenDetail{} }
 func (m *TokenFrozenDetail) String() string { return proto.CompactTextString(m) }
 func (*TokenFrozenDetail) ProtoMessage()    {}
 func (*TokenFrozenDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{12}
+	return fileDescriptor_db0991b9525664ca, []int{24}
 }
 
 func (m *TokenFrozenDetail) XXX_Unmarshal(b []byte) error {
@@ -1147,7 +2034,7 @@ func (m *TokenFrozenDetails) Reset()         { *m = TokenFrozenDetails{} }
 func (m *TokenFrozenDetails) String() string { return proto.CompactTextString(m) }
 func (*TokenFrozenDetails) ProtoMessage()    {}
 func (*TokenFrozenDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{13}
+	return fileDescriptor_db0991b9525664ca, []int{25}
 }
 
 func (m *TokenFrozenDetails) XXX_Unmarshal(b []byte) error {
@@ -1202,7 +2089,7 @@ func (m *AddressBalanceStatus) Reset()         { *m = AddressBalanceStatus{} }
 func (m *AddressBalanceStatus) String() string { return proto.CompactTextString(m) }
 func (*AddressBalanceStatus) ProtoMessage()    {}
 func (*AddressBalanceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{14}
+	return fileDescriptor_db0991b9525664ca, []int{26}
 }
 
 func (m *AddressBalanceStatus) XXX_Unmarshal(b []byte) error {
@@ -1265,7 +2152,7 @@ func (m *TxInput) Reset()         { *m = TxInput{} }
 func (m *TxInput) String() string { return proto.CompactTextString(m) }
 func (*TxInput) ProtoMessage()    {}
 func (*TxInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{15}
+	return fileDescriptor_db0991b9525664ca, []int{27}
 }
 
 func (m *TxInput) XXX_Unmarshal(b []byte) error {
@@ -1338,7 +2225,7 @@ func (m *TxOutput) Reset()         { *m = TxOutput{} }
 func (m *TxOutput) String() string { return proto.CompactTextString(m) }
 func (*TxOutput) ProtoMessage()    {}
 func (*TxOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{16}
+	return fileDescriptor_db0991b9525664ca, []int{28}
 }
 
 func (m *TxOutput) XXX_Unmarshal(b []byte) error {
@@ -1393,7 +2280,7 @@ func (m *XuperSignature) Reset()         { *m = XuperSignature{} }
 func (m *XuperSignature) String() string { return proto.CompactTextString(m) }
 func (*XuperSignature) ProtoMessage()    {}
 func (*XuperSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{17}
+	return fileDescriptor_db0991b9525664ca, []int{29}
 }
 
 func (m *XuperSignature) XXX_Unmarshal(b []byte) error {
@@ -1479,7 +2366,7 @@ func (m *Transaction) Reset()         { *m = Transaction{} }
 func (m *Transaction) String() string { return proto.CompactTextString(m) }
 func (*Transaction) ProtoMessage()    {}
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{18}
+	return fileDescriptor_db0991b9525664ca, []int{30}
 }
 
 func (m *Transaction) XXX_Unmarshal(b []byte) error {
@@ -1664,7 +2551,7 @@ func (m *LedgerMeta) Reset()         { *m = LedgerMeta{} }
 func (m *LedgerMeta) String() string { return proto.CompactTextString(m) }
 func (*LedgerMeta) ProtoMessage()    {}
 func (*LedgerMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{19}
+	return fileDescriptor_db0991b9525664ca, []int{31}
 }
 
 func (m *LedgerMeta) XXX_Unmarshal(b []byte) error {
@@ -1730,7 +2617,7 @@ func (m *UtxoMeta) Reset()         { *m = UtxoMeta{} }
 func (m *UtxoMeta) String() string { return proto.CompactTextString(m) }
 func (*UtxoMeta) ProtoMessage()    {}
 func (*UtxoMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{20}
+	return fileDescriptor_db0991b9525664ca, []int{32}
 }
 
 func (m *UtxoMeta) XXX_Unmarshal(b []byte) error {
@@ -1856,7 +2743,7 @@ func (m *GasPrice) Reset()         { *m = GasPrice{} }
 func (m *GasPrice) String() string { return proto.CompactTextString(m) }
 func (*GasPrice) ProtoMessage()    {}
 func (*GasPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{21}
+	return fileDescriptor_db0991b9525664ca, []int{33}
 }
 
 func (m *GasPrice) XXX_Unmarshal(b []byte) error {
@@ -1954,7 +2841,7 @@ func (m *InternalBlock) Reset()         { *m = InternalBlock{} }
 func (m *InternalBlock) String() string { return proto.CompactTextString(m) }
 func (*InternalBlock) ProtoMessage()    {}
 func (*InternalBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{22}
+	return fileDescriptor_db0991b9525664ca, []int{34}
 }
 
 func (m *InternalBlock) XXX_Unmarshal(b []byte) error {
@@ -2137,7 +3024,7 @@ func (m *BCStatus) Reset()         { *m = BCStatus{} }
 func (m *BCStatus) String() string { return proto.CompactTextString(m) }
 func (*BCStatus) ProtoMessage()    {}
 func (*BCStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{23}
+	return fileDescriptor_db0991b9525664ca, []int{35}
 }
 
 func (m *BCStatus) XXX_Unmarshal(b []byte) error {
@@ -2212,7 +3099,7 @@ func (m *BCTipStatus) Reset()         { *m = BCTipStatus{} }
 func (m *BCTipStatus) String() string { return proto.CompactTextString(m) }
 func (*BCTipStatus) ProtoMessage()    {}
 func (*BCTipStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{24}
+	return fileDescriptor_db0991b9525664ca, []int{36}
 }
 
 func (m *BCTipStatus) XXX_Unmarshal(b []byte) error {
@@ -2259,7 +3146,7 @@ func (m *BlockChains) Reset()         { *m = BlockChains{} }
 func (m *BlockChains) String() string { return proto.CompactTextString(m) }
 func (*BlockChains) ProtoMessage()    {}
 func (*BlockChains) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{25}
+	return fileDescriptor_db0991b9525664ca, []int{37}
 }
 
 func (m *BlockChains) XXX_Unmarshal(b []byte) error {
@@ -2306,7 +3193,7 @@ func (m *Speeds) Reset()         { *m = Speeds{} }
 func (m *Speeds) String() string { return proto.CompactTextString(m) }
 func (*Speeds) ProtoMessage()    {}
 func (*Speeds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{26}
+	return fileDescriptor_db0991b9525664ca, []int{38}
 }
 
 func (m *Speeds) XXX_Unmarshal(b []byte) error {
@@ -2352,7 +3239,7 @@ func (m *BCSpeeds) Reset()         { *m = BCSpeeds{} }
 func (m *BCSpeeds) String() string { return proto.CompactTextString(m) }
 func (*BCSpeeds) ProtoMessage()    {}
 func (*BCSpeeds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{27}
+	return fileDescriptor_db0991b9525664ca, []int{39}
 }
 
 func (m *BCSpeeds) XXX_Unmarshal(b []byte) error {
@@ -2394,7 +3281,7 @@ func (m *SystemsStatus) Reset()         { *m = SystemsStatus{} }
 func (m *SystemsStatus) String() string { return proto.CompactTextString(m) }
 func (*SystemsStatus) ProtoMessage()    {}
 func (*SystemsStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{28}
+	return fileDescriptor_db0991b9525664ca, []int{40}
 }
 
 func (m *SystemsStatus) XXX_Unmarshal(b []byte) error {
@@ -2455,7 +3342,7 @@ func (m *SystemsStatusReply) Reset()         { *m = SystemsStatusReply{} }
 func (m *SystemsStatusReply) String() string { return proto.CompactTextString(m) }
 func (*SystemsStatusReply) ProtoMessage()    {}
 func (*SystemsStatusReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{29}
+	return fileDescriptor_db0991b9525664ca, []int{41}
 }
 
 func (m *SystemsStatusReply) XXX_Unmarshal(b []byte) error {
@@ -2502,7 +3389,7 @@ func (m *ConsensusStatRequest) Reset()         { *m = ConsensusStatRequest{} }
 func (m *ConsensusStatRequest) String() string { return proto.CompactTextString(m) }
 func (*ConsensusStatRequest) ProtoMessage()    {}
 func (*ConsensusStatRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{30}
+	return fileDescriptor_db0991b9525664ca, []int{42}
 }
 
 func (m *ConsensusStatRequest) XXX_Unmarshal(b []byte) error {
@@ -2557,7 +3444,7 @@ func (m *ConsensusStatus) Reset()         { *m = ConsensusStatus{} }
 func (m *ConsensusStatus) String() string { return proto.CompactTextString(m) }
 func (*ConsensusStatus) ProtoMessage()    {}
 func (*ConsensusStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{31}
+	return fileDescriptor_db0991b9525664ca, []int{43}
 }
 
 func (m *ConsensusStatus) XXX_Unmarshal(b []byte) error {
@@ -2626,7 +3513,7 @@ func (m *RawUrl) Reset()         { *m = RawUrl{} }
 func (m *RawUrl) String() string { return proto.CompactTextString(m) }
 func (*RawUrl) ProtoMessage()    {}
 func (*RawUrl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{32}
+	return fileDescriptor_db0991b9525664ca, []int{44}
 }
 
 func (m *RawUrl) XXX_Unmarshal(b []byte) error {
@@ -2676,7 +3563,7 @@ func (m *Utxo) Reset()         { *m = Utxo{} }
 func (m *Utxo) String() string { return proto.CompactTextString(m) }
 func (*Utxo) ProtoMessage()    {}
 func (*Utxo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{33}
+	return fileDescriptor_db0991b9525664ca, []int{45}
 }
 
 func (m *Utxo) XXX_Unmarshal(b []byte) error {
@@ -2756,7 +3643,7 @@ func (m *UtxoInput) Reset()         { *m = UtxoInput{} }
 func (m *UtxoInput) String() string { return proto.CompactTextString(m) }
 func (*UtxoInput) ProtoMessage()    {}
 func (*UtxoInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{34}
+	return fileDescriptor_db0991b9525664ca, []int{46}
 }
 
 func (m *UtxoInput) XXX_Unmarshal(b []byte) error {
@@ -2844,7 +3731,7 @@ func (m *UtxoOutput) Reset()         { *m = UtxoOutput{} }
 func (m *UtxoOutput) String() string { return proto.CompactTextString(m) }
 func (*UtxoOutput) ProtoMessage()    {}
 func (*UtxoOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{35}
+	return fileDescriptor_db0991b9525664ca, []int{47}
 }
 
 func (m *UtxoOutput) XXX_Unmarshal(b []byte) error {
@@ -2906,7 +3793,7 @@ func (m *NativeCodeDesc) Reset()         { *m = NativeCodeDesc{} }
 func (m *NativeCodeDesc) String() string { return proto.CompactTextString(m) }
 func (*NativeCodeDesc) ProtoMessage()    {}
 func (*NativeCodeDesc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{36}
+	return fileDescriptor_db0991b9525664ca, []int{48}
 }
 
 func (m *NativeCodeDesc) XXX_Unmarshal(b []byte) error {
@@ -2977,7 +3864,7 @@ func (m *WasmCodeDesc) Reset()         { *m = WasmCodeDesc{} }
 func (m *WasmCodeDesc) String() string { return proto.CompactTextString(m) }
 func (*WasmCodeDesc) ProtoMessage()    {}
 func (*WasmCodeDesc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{37}
+	return fileDescriptor_db0991b9525664ca, []int{49}
 }
 
 func (m *WasmCodeDesc) XXX_Unmarshal(b []byte) error {
@@ -3046,7 +3933,7 @@ func (m *NativeCodeStatus) Reset()         { *m = NativeCodeStatus{} }
 func (m *NativeCodeStatus) String() string { return proto.CompactTextString(m) }
 func (*NativeCodeStatus) ProtoMessage()    {}
 func (*NativeCodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{38}
+	return fileDescriptor_db0991b9525664ca, []int{50}
 }
 
 func (m *NativeCodeStatus) XXX_Unmarshal(b []byte) error {
@@ -3101,7 +3988,7 @@ func (m *DposCandidatesRequest) Reset()         { *m = DposCandidatesRequest{} }
 func (m *DposCandidatesRequest) String() string { return proto.CompactTextString(m) }
 func (*DposCandidatesRequest) ProtoMessage()    {}
 func (*DposCandidatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{39}
+	return fileDescriptor_db0991b9525664ca, []int{51}
 }
 
 func (m *DposCandidatesRequest) XXX_Unmarshal(b []byte) error {
@@ -3149,7 +4036,7 @@ func (m *DposCandidatesResponse) Reset()         { *m = DposCandidatesResponse{}
 func (m *DposCandidatesResponse) String() string { return proto.CompactTextString(m) }
 func (*DposCandidatesResponse) ProtoMessage()    {}
 func (*DposCandidatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{40}
+	return fileDescriptor_db0991b9525664ca, []int{52}
 }
 
 func (m *DposCandidatesResponse) XXX_Unmarshal(b []byte) error {
@@ -3198,7 +4085,7 @@ func (m *DposNominateRecordsRequest) Reset()         { *m = DposNominateRecordsR
 func (m *DposNominateRecordsRequest) String() string { return proto.CompactTextString(m) }
 func (*DposNominateRecordsRequest) ProtoMessage()    {}
 func (*DposNominateRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{41}
+	return fileDescriptor_db0991b9525664ca, []int{53}
 }
 
 func (m *DposNominateRecordsRequest) XXX_Unmarshal(b []byte) error {
@@ -3253,7 +4140,7 @@ func (m *DposNominateInfo) Reset()         { *m = DposNominateInfo{} }
 func (m *DposNominateInfo) String() string { return proto.CompactTextString(m) }
 func (*DposNominateInfo) ProtoMessage()    {}
 func (*DposNominateInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{42}
+	return fileDescriptor_db0991b9525664ca, []int{54}
 }
 
 func (m *DposNominateInfo) XXX_Unmarshal(b []byte) error {
@@ -3301,7 +4188,7 @@ func (m *DposNominateRecordsResponse) Reset()         { *m = DposNominateRecords
 func (m *DposNominateRecordsResponse) String() string { return proto.CompactTextString(m) }
 func (*DposNominateRecordsResponse) ProtoMessage()    {}
 func (*DposNominateRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{43}
+	return fileDescriptor_db0991b9525664ca, []int{55}
 }
 
 func (m *DposNominateRecordsResponse) XXX_Unmarshal(b []byte) error {
@@ -3350,7 +4237,7 @@ func (m *DposNomineeRecordsRequest) Reset()         { *m = DposNomineeRecordsReq
 func (m *DposNomineeRecordsRequest) String() string { return proto.CompactTextString(m) }
 func (*DposNomineeRecordsRequest) ProtoMessage()    {}
 func (*DposNomineeRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{44}
+	return fileDescriptor_db0991b9525664ca, []int{56}
 }
 
 func (m *DposNomineeRecordsRequest) XXX_Unmarshal(b []byte) error {
@@ -3405,7 +4292,7 @@ func (m *DposNomineeRecordsResponse) Reset()         { *m = DposNomineeRecordsRe
 func (m *DposNomineeRecordsResponse) String() string { return proto.CompactTextString(m) }
 func (*DposNomineeRecordsResponse) ProtoMessage()    {}
 func (*DposNomineeRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{45}
+	return fileDescriptor_db0991b9525664ca, []int{57}
 }
 
 func (m *DposNomineeRecordsResponse) XXX_Unmarshal(b []byte) error {
@@ -3454,7 +4341,7 @@ func (m *DposVoteRecordsRequest) Reset()         { *m = DposVoteRecordsRequest{}
 func (m *DposVoteRecordsRequest) String() string { return proto.CompactTextString(m) }
 func (*DposVoteRecordsRequest) ProtoMessage()    {}
 func (*DposVoteRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{46}
+	return fileDescriptor_db0991b9525664ca, []int{58}
 }
 
 func (m *DposVoteRecordsRequest) XXX_Unmarshal(b []byte) error {
@@ -3509,7 +4396,7 @@ func (m *VoteRecord) Reset()         { *m = VoteRecord{} }
 func (m *VoteRecord) String() string { return proto.CompactTextString(m) }
 func (*VoteRecord) ProtoMessage()    {}
 func (*VoteRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{47}
+	return fileDescriptor_db0991b9525664ca, []int{59}
 }
 
 func (m *VoteRecord) XXX_Unmarshal(b []byte) error {
@@ -3558,7 +4445,7 @@ func (m *DposVoteRecordsResponse) Reset()         { *m = DposVoteRecordsResponse
 func (m *DposVoteRecordsResponse) String() string { return proto.CompactTextString(m) }
 func (*DposVoteRecordsResponse) ProtoMessage()    {}
 func (*DposVoteRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{48}
+	return fileDescriptor_db0991b9525664ca, []int{60}
 }
 
 func (m *DposVoteRecordsResponse) XXX_Unmarshal(b []byte) error {
@@ -3607,7 +4494,7 @@ func (m *DposVotedRecordsRequest) Reset()         { *m = DposVotedRecordsRequest
 func (m *DposVotedRecordsRequest) String() string { return proto.CompactTextString(m) }
 func (*DposVotedRecordsRequest) ProtoMessage()    {}
 func (*DposVotedRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{49}
+	return fileDescriptor_db0991b9525664ca, []int{61}
 }
 
 func (m *DposVotedRecordsRequest) XXX_Unmarshal(b []byte) error {
@@ -3662,7 +4549,7 @@ func (m *VotedRecord) Reset()         { *m = VotedRecord{} }
 func (m *VotedRecord) String() string { return proto.CompactTextString(m) }
 func (*VotedRecord) ProtoMessage()    {}
 func (*VotedRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{50}
+	return fileDescriptor_db0991b9525664ca, []int{62}
 }
 
 func (m *VotedRecord) XXX_Unmarshal(b []byte) error {
@@ -3711,7 +4598,7 @@ func (m *DposVotedRecordsResponse) Reset()         { *m = DposVotedRecordsRespon
 func (m *DposVotedRecordsResponse) String() string { return proto.CompactTextString(m) }
 func (*DposVotedRecordsResponse) ProtoMessage()    {}
 func (*DposVotedRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{51}
+	return fileDescriptor_db0991b9525664ca, []int{63}
 }
 
 func (m *DposVotedRecordsResponse) XXX_Unmarshal(b []byte) error {
@@ -3760,7 +4647,7 @@ func (m *DposCheckResultsRequest) Reset()         { *m = DposCheckResultsRequest
 func (m *DposCheckResultsRequest) String() string { return proto.CompactTextString(m) }
 func (*DposCheckResultsRequest) ProtoMessage()    {}
 func (*DposCheckResultsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{52}
+	return fileDescriptor_db0991b9525664ca, []int{64}
 }
 
 func (m *DposCheckResultsRequest) XXX_Unmarshal(b []byte) error {
@@ -3816,7 +4703,7 @@ func (m *DposCheckResultsResponse) Reset()         { *m = DposCheckResultsRespon
 func (m *DposCheckResultsResponse) String() string { return proto.CompactTextString(m) }
 func (*DposCheckResultsResponse) ProtoMessage()    {}
 func (*DposCheckResultsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{53}
+	return fileDescriptor_db0991b9525664ca, []int{65}
 }
 
 func (m *DposCheckResultsResponse) XXX_Unmarshal(b []byte) error {
@@ -3871,7 +4758,7 @@ func (m *DposStatusRequest) Reset()         { *m = DposStatusRequest{} }
 func (m *DposStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*DposStatusRequest) ProtoMessage()    {}
 func (*DposStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{54}
+	return fileDescriptor_db0991b9525664ca, []int{66}
 }
 
 func (m *DposStatusRequest) XXX_Unmarshal(b []byte) error {
@@ -3919,7 +4806,7 @@ func (m *DposStatusResponse) Reset()         { *m = DposStatusResponse{} }
 func (m *DposStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*DposStatusResponse) ProtoMessage()    {}
 func (*DposStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{55}
+	return fileDescriptor_db0991b9525664ca, []int{67}
 }
 
 func (m *DposStatusResponse) XXX_Unmarshal(b []byte) error {
@@ -3969,7 +4856,7 @@ func (m *DposStatus) Reset()         { *m = DposStatus{} }
 func (m *DposStatus) String() string { return proto.CompactTextString(m) }
 func (*DposStatus) ProtoMessage()    {}
 func (*DposStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{56}
+	return fileDescriptor_db0991b9525664ca, []int{68}
 }
 
 func (m *DposStatus) XXX_Unmarshal(b []byte) error {
@@ -4040,7 +4927,7 @@ func (m *InvokeRPCRequest) Reset()         { *m = InvokeRPCRequest{} }
 func (m *InvokeRPCRequest) String() string { return proto.CompactTextString(m) }
 func (*InvokeRPCRequest) ProtoMessage()    {}
 func (*InvokeRPCRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{57}
+	return fileDescriptor_db0991b9525664ca, []int{69}
 }
 
 func (m *InvokeRPCRequest) XXX_Unmarshal(b []byte) error {
@@ -4109,7 +4996,7 @@ func (m *InvokeRPCResponse) Reset()         { *m = InvokeRPCResponse{} }
 func (m *InvokeRPCResponse) String() string { return proto.CompactTextString(m) }
 func (*InvokeRPCResponse) ProtoMessage()    {}
 func (*InvokeRPCResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{58}
+	return fileDescriptor_db0991b9525664ca, []int{70}
 }
 
 func (m *InvokeRPCResponse) XXX_Unmarshal(b []byte) error {
@@ -4170,7 +5057,7 @@ func (m *InvokeRequest) Reset()         { *m = InvokeRequest{} }
 func (m *InvokeRequest) String() string { return proto.CompactTextString(m) }
 func (*InvokeRequest) ProtoMessage()    {}
 func (*InvokeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{59}
+	return fileDescriptor_db0991b9525664ca, []int{71}
 }
 
 func (m *InvokeRequest) XXX_Unmarshal(b []byte) error {
@@ -4252,7 +5139,7 @@ func (m *InvokeResponse) Reset()         { *m = InvokeResponse{} }
 func (m *InvokeResponse) String() string { return proto.CompactTextString(m) }
 func (*InvokeResponse) ProtoMessage()    {}
 func (*InvokeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{60}
+	return fileDescriptor_db0991b9525664ca, []int{72}
 }
 
 func (m *InvokeResponse) XXX_Unmarshal(b []byte) error {
@@ -4344,7 +5231,7 @@ func (m *TxInputExt) Reset()         { *m = TxInputExt{} }
 func (m *TxInputExt) String() string { return proto.CompactTextString(m) }
 func (*TxInputExt) ProtoMessage()    {}
 func (*TxInputExt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{61}
+	return fileDescriptor_db0991b9525664ca, []int{73}
 }
 
 func (m *TxInputExt) XXX_Unmarshal(b []byte) error {
@@ -4407,7 +5294,7 @@ func (m *TxOutputExt) Reset()         { *m = TxOutputExt{} }
 func (m *TxOutputExt) String() string { return proto.CompactTextString(m) }
 func (*TxOutputExt) ProtoMessage()    {}
 func (*TxOutputExt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{62}
+	return fileDescriptor_db0991b9525664ca, []int{74}
 }
 
 func (m *TxOutputExt) XXX_Unmarshal(b []byte) error {
@@ -4462,7 +5349,7 @@ func (m *SignatureInfo) Reset()         { *m = SignatureInfo{} }
 func (m *SignatureInfo) String() string { return proto.CompactTextString(m) }
 func (*SignatureInfo) ProtoMessage()    {}
 func (*SignatureInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{63}
+	return fileDescriptor_db0991b9525664ca, []int{75}
 }
 
 func (m *SignatureInfo) XXX_Unmarshal(b []byte) error {
@@ -4509,7 +5396,7 @@ func (m *PermissionModel) Reset()         { *m = PermissionModel{} }
 func (m *PermissionModel) String() string { return proto.CompactTextString(m) }
 func (*PermissionModel) ProtoMessage()    {}
 func (*PermissionModel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{64}
+	return fileDescriptor_db0991b9525664ca, []int{76}
 }
 
 func (m *PermissionModel) XXX_Unmarshal(b []byte) error {
@@ -4556,7 +5443,7 @@ func (m *AkSet) Reset()         { *m = AkSet{} }
 func (m *AkSet) String() string { return proto.CompactTextString(m) }
 func (*AkSet) ProtoMessage()    {}
 func (*AkSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{65}
+	return fileDescriptor_db0991b9525664ca, []int{77}
 }
 
 func (m *AkSet) XXX_Unmarshal(b []byte) error {
@@ -4596,7 +5483,7 @@ func (m *AkSets) Reset()         { *m = AkSets{} }
 func (m *AkSets) String() string { return proto.CompactTextString(m) }
 func (*AkSets) ProtoMessage()    {}
 func (*AkSets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{66}
+	return fileDescriptor_db0991b9525664ca, []int{78}
 }
 
 func (m *AkSets) XXX_Unmarshal(b []byte) error {
@@ -4645,7 +5532,7 @@ func (m *Acl) Reset()         { *m = Acl{} }
 func (m *Acl) String() string { return proto.CompactTextString(m) }
 func (*Acl) ProtoMessage()    {}
 func (*Acl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{67}
+	return fileDescriptor_db0991b9525664ca, []int{79}
 }
 
 func (m *Acl) XXX_Unmarshal(b []byte) error {
@@ -4705,7 +5592,7 @@ func (m *AclStatus) Reset()         { *m = AclStatus{} }
 func (m *AclStatus) String() string { return proto.CompactTextString(m) }
 func (*AclStatus) ProtoMessage()    {}
 func (*AclStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{68}
+	return fileDescriptor_db0991b9525664ca, []int{80}
 }
 
 func (m *AclStatus) XXX_Unmarshal(b []byte) error {
@@ -4791,7 +5678,7 @@ func (m *IdentityAuth) Reset()         { *m = IdentityAuth{} }
 func (m *IdentityAuth) String() string { return proto.CompactTextString(m) }
 func (*IdentityAuth) ProtoMessage()    {}
 func (*IdentityAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{69}
+	return fileDescriptor_db0991b9525664ca, []int{81}
 }
 
 func (m *IdentityAuth) XXX_Unmarshal(b []byte) error {
@@ -4859,7 +5746,7 @@ func (m *IdentityAuths) Reset()         { *m = IdentityAuths{} }
 func (m *IdentityAuths) String() string { return proto.CompactTextString(m) }
 func (*IdentityAuths) ProtoMessage()    {}
 func (*IdentityAuths) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{70}
+	return fileDescriptor_db0991b9525664ca, []int{82}
 }
 
 func (m *IdentityAuths) XXX_Unmarshal(b []byte) error {
@@ -4899,7 +5786,7 @@ func (m *ResourceLimit) Reset()         { *m = ResourceLimit{} }
 func (m *ResourceLimit) String() string { return proto.CompactTextString(m) }
 func (*ResourceLimit) ProtoMessage()    {}
 func (*ResourceLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{71}
+	return fileDescriptor_db0991b9525664ca, []int{83}
 }
 
 func (m *ResourceLimit) XXX_Unmarshal(b []byte) error {
@@ -4947,7 +5834,7 @@ func (m *AK2AccountRequest) Reset()         { *m = AK2AccountRequest{} }
 func (m *AK2AccountRequest) String() string { return proto.CompactTextString(m) }
 func (*AK2AccountRequest) ProtoMessage()    {}
 func (*AK2AccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{72}
+	return fileDescriptor_db0991b9525664ca, []int{84}
 }
 
 func (m *AK2AccountRequest) XXX_Unmarshal(b []byte) error {
@@ -5002,7 +5889,7 @@ func (m *AK2AccountResponse) Reset()         { *m = AK2AccountResponse{} }
 func (m *AK2AccountResponse) String() string { return proto.CompactTextString(m) }
 func (*AK2AccountResponse) ProtoMessage()    {}
 func (*AK2AccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{73}
+	return fileDescriptor_db0991b9525664ca, []int{85}
 }
 
 func (m *AK2AccountResponse) XXX_Unmarshal(b []byte) error {
@@ -5058,7 +5945,7 @@ func (m *GetAccountContractsRequest) Reset()         { *m = GetAccountContractsR
 func (m *GetAccountContractsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAccountContractsRequest) ProtoMessage()    {}
 func (*GetAccountContractsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{74}
+	return fileDescriptor_db0991b9525664ca, []int{86}
 }
 
 func (m *GetAccountContractsRequest) XXX_Unmarshal(b []byte) error {
@@ -5113,7 +6000,7 @@ func (m *GetAccountContractsResponse) Reset()         { *m = GetAccountContracts
 func (m *GetAccountContractsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAccountContractsResponse) ProtoMessage()    {}
 func (*GetAccountContractsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{75}
+	return fileDescriptor_db0991b9525664ca, []int{87}
 }
 
 func (m *GetAccountContractsResponse) XXX_Unmarshal(b []byte) error {
@@ -5165,7 +6052,7 @@ func (m *ContractStatus) Reset()         { *m = ContractStatus{} }
 func (m *ContractStatus) String() string { return proto.CompactTextString(m) }
 func (*ContractStatus) ProtoMessage()    {}
 func (*ContractStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{76}
+	return fileDescriptor_db0991b9525664ca, []int{88}
 }
 
 func (m *ContractStatus) XXX_Unmarshal(b []byte) error {
@@ -5246,7 +6133,7 @@ func (m *PreExecWithSelectUTXORequest) Reset()         { *m = PreExecWithSelectU
 func (m *PreExecWithSelectUTXORequest) String() string { return proto.CompactTextString(m) }
 func (*PreExecWithSelectUTXORequest) ProtoMessage()    {}
 func (*PreExecWithSelectUTXORequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{77}
+	return fileDescriptor_db0991b9525664ca, []int{89}
 }
 
 func (m *PreExecWithSelectUTXORequest) XXX_Unmarshal(b []byte) error {
@@ -5332,7 +6219,7 @@ func (m *PreExecWithSelectUTXOResponse) Reset()         { *m = PreExecWithSelect
 func (m *PreExecWithSelectUTXOResponse) String() string { return proto.CompactTextString(m) }
 func (*PreExecWithSelectUTXOResponse) ProtoMessage()    {}
 func (*PreExecWithSelectUTXOResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{78}
+	return fileDescriptor_db0991b9525664ca, []int{90}
 }
 
 func (m *PreExecWithSelectUTXOResponse) XXX_Unmarshal(b []byte) error {
@@ -5395,7 +6282,7 @@ func (m *ContractResponse) Reset()         { *m = ContractResponse{} }
 func (m *ContractResponse) String() string { return proto.CompactTextString(m) }
 func (*ContractResponse) ProtoMessage()    {}
 func (*ContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{79}
+	return fileDescriptor_db0991b9525664ca, []int{91}
 }
 
 func (m *ContractResponse) XXX_Unmarshal(b []byte) error {
@@ -5457,7 +6344,7 @@ func (m *ModifyBlock) Reset()         { *m = ModifyBlock{} }
 func (m *ModifyBlock) String() string { return proto.CompactTextString(m) }
 func (*ModifyBlock) ProtoMessage()    {}
 func (*ModifyBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{80}
+	return fileDescriptor_db0991b9525664ca, []int{92}
 }
 
 func (m *ModifyBlock) XXX_Unmarshal(b []byte) error {
@@ -5527,7 +6414,7 @@ func (m *HDInfo) Reset()         { *m = HDInfo{} }
 func (m *HDInfo) String() string { return proto.CompactTextString(m) }
 func (*HDInfo) ProtoMessage()    {}
 func (*HDInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{81}
+	return fileDescriptor_db0991b9525664ca, []int{93}
 }
 
 func (m *HDInfo) XXX_Unmarshal(b []byte) error {
@@ -5579,7 +6466,7 @@ func (m *UtxoRecordDetail) Reset()         { *m = UtxoRecordDetail{} }
 func (m *UtxoRecordDetail) String() string { return proto.CompactTextString(m) }
 func (*UtxoRecordDetail) ProtoMessage()    {}
 func (*UtxoRecordDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{82}
+	return fileDescriptor_db0991b9525664ca, []int{94}
 }
 
 func (m *UtxoRecordDetail) XXX_Unmarshal(b []byte) error {
@@ -5662,7 +6549,7 @@ func (m *UtxoRecord) Reset()         { *m = UtxoRecord{} }
 func (m *UtxoRecord) String() string { return proto.CompactTextString(m) }
 func (*UtxoRecord) ProtoMessage()    {}
 func (*UtxoRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{83}
+	return fileDescriptor_db0991b9525664ca, []int{95}
 }
 
 func (m *UtxoRecord) XXX_Unmarshal(b []byte) error {
@@ -5717,7 +6604,7 @@ func (m *UtxoKey) Reset()         { *m = UtxoKey{} }
 func (m *UtxoKey) String() string { return proto.CompactTextString(m) }
 func (*UtxoKey) ProtoMessage()    {}
 func (*UtxoKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{84}
+	return fileDescriptor_db0991b9525664ca, []int{96}
 }
 
 func (m *UtxoKey) XXX_Unmarshal(b []byte) error {
@@ -5771,7 +6658,7 @@ func (m *ContractStatDataRequest) Reset()         { *m = ContractStatDataRequest
 func (m *ContractStatDataRequest) String() string { return proto.CompactTextString(m) }
 func (*ContractStatDataRequest) ProtoMessage()    {}
 func (*ContractStatDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{85}
+	return fileDescriptor_db0991b9525664ca, []int{97}
 }
 
 func (m *ContractStatDataRequest) XXX_Unmarshal(b []byte) error {
@@ -5819,7 +6706,7 @@ func (m *ContractStatDataResponse) Reset()         { *m = ContractStatDataRespon
 func (m *ContractStatDataResponse) String() string { return proto.CompactTextString(m) }
 func (*ContractStatDataResponse) ProtoMessage()    {}
 func (*ContractStatDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{86}
+	return fileDescriptor_db0991b9525664ca, []int{98}
 }
 
 func (m *ContractStatDataResponse) XXX_Unmarshal(b []byte) error {
@@ -5873,7 +6760,7 @@ func (m *ContractStatData) Reset()         { *m = ContractStatData{} }
 func (m *ContractStatData) String() string { return proto.CompactTextString(m) }
 func (*ContractStatData) ProtoMessage()    {}
 func (*ContractStatData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{87}
+	return fileDescriptor_db0991b9525664ca, []int{99}
 }
 
 func (m *ContractStatData) XXX_Unmarshal(b []byte) error {
@@ -5923,7 +6810,7 @@ func (m *AddressContractsRequest) Reset()         { *m = AddressContractsRequest
 func (m *AddressContractsRequest) String() string { return proto.CompactTextString(m) }
 func (*AddressContractsRequest) ProtoMessage()    {}
 func (*AddressContractsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{88}
+	return fileDescriptor_db0991b9525664ca, []int{100}
 }
 
 func (m *AddressContractsRequest) XXX_Unmarshal(b []byte) error {
@@ -5983,7 +6870,7 @@ func (m *ContractList) Reset()         { *m = ContractList{} }
 func (m *ContractList) String() string { return proto.CompactTextString(m) }
 func (*ContractList) ProtoMessage()    {}
 func (*ContractList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{89}
+	return fileDescriptor_db0991b9525664ca, []int{101}
 }
 
 func (m *ContractList) XXX_Unmarshal(b []byte) error {
@@ -6024,7 +6911,7 @@ func (m *AddressContractsResponse) Reset()         { *m = AddressContractsRespon
 func (m *AddressContractsResponse) String() string { return proto.CompactTextString(m) }
 func (*AddressContractsResponse) ProtoMessage()    {}
 func (*AddressContractsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{90}
+	return fileDescriptor_db0991b9525664ca, []int{102}
 }
 
 func (m *AddressContractsResponse) XXX_Unmarshal(b []byte) error {
@@ -6074,7 +6961,7 @@ func (m *CrossQueryRequest) Reset()         { *m = CrossQueryRequest{} }
 func (m *CrossQueryRequest) String() string { return proto.CompactTextString(m) }
 func (*CrossQueryRequest) ProtoMessage()    {}
 func (*CrossQueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{91}
+	return fileDescriptor_db0991b9525664ca, []int{103}
 }
 
 func (m *CrossQueryRequest) XXX_Unmarshal(b []byte) error {
@@ -6141,7 +7028,7 @@ func (m *CrossQueryResponse) Reset()         { *m = CrossQueryResponse{} }
 func (m *CrossQueryResponse) String() string { return proto.CompactTextString(m) }
 func (*CrossQueryResponse) ProtoMessage()    {}
 func (*CrossQueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{92}
+	return fileDescriptor_db0991b9525664ca, []int{104}
 }
 
 func (m *CrossQueryResponse) XXX_Unmarshal(b []byte) error {
@@ -6181,7 +7068,7 @@ func (m *CrossChainMeta) Reset()         { *m = CrossChainMeta{} }
 func (m *CrossChainMeta) String() string { return proto.CompactTextString(m) }
 func (*CrossChainMeta) ProtoMessage()    {}
 func (*CrossChainMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{93}
+	return fileDescriptor_db0991b9525664ca, []int{105}
 }
 
 func (m *CrossChainMeta) XXX_Unmarshal(b []byte) error {
@@ -6230,7 +7117,7 @@ func (m *CrossEndorsor) Reset()         { *m = CrossEndorsor{} }
 func (m *CrossEndorsor) String() string { return proto.CompactTextString(m) }
 func (*CrossEndorsor) ProtoMessage()    {}
 func (*CrossEndorsor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{94}
+	return fileDescriptor_db0991b9525664ca, []int{106}
 }
 
 func (m *CrossEndorsor) XXX_Unmarshal(b []byte) error {
@@ -6284,7 +7171,7 @@ func (m *CrossQueryMeta) Reset()         { *m = CrossQueryMeta{} }
 func (m *CrossQueryMeta) String() string { return proto.CompactTextString(m) }
 func (*CrossQueryMeta) ProtoMessage()    {}
 func (*CrossQueryMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{95}
+	return fileDescriptor_db0991b9525664ca, []int{107}
 }
 
 func (m *CrossQueryMeta) XXX_Unmarshal(b []byte) error {
@@ -6332,7 +7219,7 @@ func (m *CrossQueryInfo) Reset()         { *m = CrossQueryInfo{} }
 func (m *CrossQueryInfo) String() string { return proto.CompactTextString(m) }
 func (*CrossQueryInfo) ProtoMessage()    {}
 func (*CrossQueryInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{96}
+	return fileDescriptor_db0991b9525664ca, []int{108}
 }
 
 func (m *CrossQueryInfo) XXX_Unmarshal(b []byte) error {
@@ -6387,7 +7274,7 @@ func (m *ContractEvent) Reset()         { *m = ContractEvent{} }
 func (m *ContractEvent) String() string { return proto.CompactTextString(m) }
 func (*ContractEvent) ProtoMessage()    {}
 func (*ContractEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{97}
+	return fileDescriptor_db0991b9525664ca, []int{109}
 }
 
 func (m *ContractEvent) XXX_Unmarshal(b []byte) error {
@@ -6435,11 +7322,25 @@ func init() {
 	proto.RegisterEnum("pb.ViewOption", ViewOption_name, ViewOption_value)
 	proto.RegisterEnum("pb.PermissionRule", PermissionRule_name, PermissionRule_value)
 	proto.RegisterEnum("pb.ResourceType", ResourceType_name, ResourceType_value)
+	proto.RegisterEnum("pb.GetTxStatusResponse_TxState", GetTxStatusResponse_TxState_name, GetTxStatusResponse_TxState_value)
 	proto.RegisterEnum("pb.Block_EBlockStatus", Block_EBlockStatus_name, Block_EBlockStatus_value)
 	proto.RegisterType((*Header)(nil), "pb.Header")
 	proto.RegisterType((*TxDataAccount)(nil), "pb.TxDataAccount")
 	proto.RegisterType((*TxData)(nil), "pb.TxData")
 	proto.RegisterType((*TxStatus)(nil), "pb.TxStatus")
+	proto.RegisterType((*TxProofRequest)(nil), "pb.TxProofRequest")
+	proto.RegisterType((*TxProof)(nil), "pb.TxProof")
+	proto.RegisterType((*GetTxStatusRequest)(nil), "pb.GetTxStatusRequest")
+	proto.RegisterType((*GetTxStatusResponse)(nil), "pb.GetTxStatusResponse")
+	proto.RegisterType((*PendingTxFilter)(nil), "pb.PendingTxFilter")
+	proto.RegisterType((*ListPendingTxsRequest)(nil), "pb.ListPendingTxsRequest")
+	proto.RegisterType((*PendingTx)(nil), "pb.PendingTx")
+	proto.RegisterType((*ListPendingTxsResponse)(nil), "pb.ListPendingTxsResponse")
+	proto.RegisterType((*GetMempoolStatsRequest)(nil), "pb.GetMempoolStatsRequest")
+	proto.RegisterType((*GetMempoolStatsResponse)(nil), "pb.GetMempoolStatsResponse")
+	proto.RegisterMapType((map[string]int64)(nil), "pb.GetMempoolStatsResponse.InitiatorCountsEntry")
+	proto.RegisterType((*EstimateFeeRequest)(nil), "pb.EstimateFeeRequest")
+	proto.RegisterType((*EstimateFeeResponse)(nil), "pb.EstimateFeeResponse")
 	proto.RegisterType((*BatchTxs)(nil), "pb.BatchTxs")
 	proto.RegisterType((*Block)(nil), "pb.Block")
 	proto.RegisterType((*BlockID)(nil), "pb.BlockID")
@@ -6547,386 +7448,426 @@ func init() {
 func init() { proto.RegisterFile("xchain.proto", fileDescriptor_db0991b9525664ca) }
 
 var fileDescriptor_db0991b9525664ca = []byte{
-	// 6052 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0xcd, 0x6f, 0x23, 0xc9,
-	0x75, 0xf8, 0x36, 0x29, 0xf1, 0xe3, 0xf1, 0x43, 0x54, 0xcd, 0x48, 0xc3, 0xa1, 0xb4, 0xf3, 0xd1,
-	0x3b, 0xbb, 0x2b, 0xcf, 0xfe, 0xac, 0xf9, 0xad, 0x6c, 0xff, 0x76, 0x31, 0xb6, 0xd7, 0x3f, 0x8a,
-	0xa2, 0x66, 0x68, 0x69, 0x48, 0x6d, 0x93, 0x9c, 0xd1, 0xc2, 0x01, 0xda, 0x2d, 0xb2, 0x24, 0xb5,
-	0x45, 0x76, 0xd3, 0xdd, 0x4d, 0x0d, 0xb5, 0x36, 0x92, 0x8d, 0x91, 0x93, 0x6f, 0x49, 0x80, 0xdc,
-	0x62, 0x04, 0x39, 0x06, 0xc8, 0x25, 0x08, 0x90, 0x43, 0x80, 0x00, 0x31, 0x02, 0x1f, 0x73, 0x09,
-	0x72, 0x48, 0xae, 0x0e, 0xf2, 0x1f, 0xe4, 0x1e, 0xd4, 0xab, 0xaa, 0xee, 0x6a, 0x7e, 0xcc, 0x8e,
-	0xbc, 0xe3, 0xbd, 0x8c, 0x58, 0xaf, 0x5e, 0xbd, 0xaa, 0xf7, 0xaa, 0xea, 0x7d, 0xd5, 0xeb, 0x81,
-	0xfc, 0xa4, 0x77, 0x6e, 0xd9, 0xce, 0xf6, 0xc8, 0x73, 0x03, 0x97, 0x24, 0x46, 0x27, 0x95, 0xcd,
-	0x33, 0xd7, 0x3d, 0x1b, 0xd0, 0x47, 0xd6, 0xc8, 0x7e, 0x64, 0x39, 0x8e, 0x1b, 0x58, 0x81, 0xed,
-	0x3a, 0x3e, 0xc7, 0xa8, 0x94, 0x10, 0x9d, 0xf6, 0x4f, 0x4e, 0x03, 0x0e, 0xd1, 0x4f, 0x21, 0xf5,
-	0x94, 0x5a, 0x7d, 0xea, 0x91, 0x9b, 0xb0, 0x3c, 0x70, 0xcf, 0xec, 0x7e, 0x59, 0xbb, 0xa7, 0x6d,
-	0x65, 0x0d, 0xde, 0x20, 0x1b, 0x90, 0x3d, 0xf5, 0xdc, 0xa1, 0xe9, 0xb8, 0x7d, 0x5a, 0x4e, 0x60,
-	0x4f, 0x86, 0x01, 0x9a, 0x6e, 0x9f, 0x92, 0x6f, 0xc0, 0x32, 0xf5, 0x3c, 0xd7, 0x2b, 0x27, 0xef,
-	0x69, 0x5b, 0xc5, 0x9d, 0x1b, 0xdb, 0xa3, 0x93, 0xed, 0xe3, 0x1a, 0x9b, 0xa2, 0xce, 0xc0, 0x75,
-	0x67, 0x3c, 0x34, 0x38, 0x86, 0x7e, 0x0a, 0x85, 0xce, 0x64, 0xcf, 0x0a, 0xac, 0x6a, 0xaf, 0xe7,
-	0x8e, 0x9d, 0x80, 0x94, 0x21, 0x6d, 0xf5, 0xfb, 0x1e, 0xf5, 0x7d, 0x31, 0xa1, 0x6c, 0x92, 0x75,
-	0x48, 0x59, 0x43, 0x86, 0x23, 0xe6, 0x13, 0x2d, 0xf2, 0x0e, 0x14, 0x4e, 0x3d, 0xf7, 0x73, 0xea,
-	0x98, 0xe7, 0xd4, 0x3e, 0x3b, 0x0f, 0x70, 0xd6, 0xa4, 0x91, 0xe7, 0xc0, 0xa7, 0x08, 0xd3, 0x7f,
-	0x9b, 0x80, 0x14, 0x9f, 0x88, 0xe8, 0x90, 0x3a, 0x47, 0xd6, 0xca, 0x85, 0x7b, 0xda, 0x56, 0x6e,
-	0x07, 0xd8, 0xf2, 0x38, 0xb3, 0x86, 0xe8, 0x21, 0x04, 0x96, 0x82, 0x89, 0xe0, 0x39, 0x6f, 0xe0,
-	0x6f, 0x36, 0xff, 0x49, 0xcf, 0xb1, 0x86, 0x92, 0x5f, 0xd1, 0x0a, 0x45, 0xc1, 0xd6, 0x89, 0x73,
-	0x0b, 0x51, 0x54, 0xfb, 0x7d, 0x8f, 0xdc, 0x85, 0x1c, 0x76, 0x8e, 0xc6, 0x27, 0x17, 0xf4, 0xaa,
-	0xbc, 0x84, 0xdd, 0xc0, 0x40, 0x47, 0x08, 0x09, 0x11, 0xfc, 0x9e, 0xc7, 0x10, 0x96, 0x23, 0x84,
-	0x36, 0x42, 0x18, 0xf9, 0xb1, 0x4f, 0x3d, 0xd3, 0xb7, 0xcf, 0x9c, 0x72, 0x11, 0xd7, 0x93, 0x61,
-	0x80, 0xb6, 0x7d, 0xe6, 0x90, 0x0f, 0x20, 0x6d, 0x71, 0xc1, 0x95, 0x53, 0xf7, 0x92, 0x5b, 0xb9,
-	0x9d, 0x55, 0xc6, 0x4c, 0x4c, 0xa2, 0x86, 0xc4, 0x60, 0x3b, 0xe9, 0xb8, 0x4e, 0x8f, 0x96, 0x33,
-	0x7c, 0x27, 0xb1, 0x41, 0x36, 0x21, 0x1b, 0xd8, 0x43, 0xea, 0x07, 0xd6, 0x70, 0x54, 0xce, 0xa2,
-	0xe8, 0x22, 0x00, 0x13, 0x44, 0x9f, 0xfa, 0xbd, 0x72, 0x9e, 0x0b, 0x82, 0xfd, 0x66, 0x5b, 0x74,
-	0x49, 0x3d, 0xdf, 0x76, 0x9d, 0xf2, 0xca, 0x3d, 0x6d, 0x6b, 0xd9, 0x90, 0x4d, 0xfd, 0x37, 0x1a,
-	0x64, 0x3a, 0x93, 0x76, 0x60, 0x05, 0x63, 0x5f, 0x91, 0xb3, 0xb6, 0x50, 0xce, 0x8b, 0x64, 0x2a,
-	0xe5, 0x9f, 0x54, 0xe4, 0xff, 0x4d, 0x48, 0xf9, 0x48, 0x19, 0xa5, 0x58, 0xdc, 0x59, 0x43, 0x56,
-	0x3d, 0xcb, 0xf1, 0xad, 0x1e, 0x3b, 0xcc, 0x7c, 0x5a, 0x43, 0x20, 0x91, 0x0a, 0x64, 0xfa, 0xb6,
-	0x1f, 0x58, 0x8c, 0xe1, 0x65, 0x64, 0x2b, 0x6c, 0x93, 0xbb, 0x90, 0x08, 0x26, 0xe5, 0x34, 0x2e,
-	0x6b, 0x65, 0x8a, 0x8c, 0x91, 0x08, 0x26, 0x7a, 0x13, 0x32, 0xbb, 0x56, 0xd0, 0x3b, 0xef, 0x4c,
-	0x5e, 0x8f, 0x8f, 0x3b, 0x90, 0xec, 0x4c, 0xfc, 0x72, 0x02, 0xf7, 0x20, 0xcf, 0xf7, 0x40, 0xac,
-	0x87, 0x75, 0xe8, 0xff, 0xa3, 0xc1, 0xf2, 0xee, 0xc0, 0xed, 0x5d, 0x7c, 0x25, 0xa9, 0x94, 0x21,
-	0x7d, 0xc2, 0x88, 0x84, 0x82, 0x91, 0x4d, 0xb2, 0x3d, 0x25, 0x9b, 0x75, 0x46, 0x15, 0x27, 0xdc,
-	0xae, 0xe3, 0x9f, 0x29, 0xe1, 0xbc, 0x0f, 0xcb, 0x38, 0x14, 0x25, 0x23, 0x4e, 0x4d, 0xc3, 0x09,
-	0xa8, 0xe7, 0x58, 0x03, 0xc4, 0x37, 0x78, 0xbf, 0xfe, 0x7d, 0xc8, 0xab, 0x04, 0x48, 0x16, 0x96,
-	0xeb, 0x86, 0xd1, 0x32, 0x4a, 0x6f, 0xb1, 0x9f, 0x1d, 0xa3, 0xdb, 0x3c, 0x28, 0x69, 0x04, 0x20,
-	0xb5, 0x6b, 0x54, 0x9b, 0xb5, 0xa7, 0xa5, 0x04, 0xc9, 0x41, 0xba, 0xd9, 0xaa, 0x1f, 0x37, 0xda,
-	0x9d, 0x52, 0x52, 0xff, 0x85, 0x06, 0x69, 0x1c, 0xde, 0xd8, 0x53, 0x38, 0x5f, 0x7a, 0x0d, 0xce,
-	0xb5, 0x45, 0x9c, 0x27, 0xe2, 0x9c, 0xdf, 0x87, 0xbc, 0x43, 0x69, 0xdf, 0xec, 0xb9, 0x4e, 0x40,
-	0x1d, 0x7e, 0xf9, 0x33, 0x46, 0x8e, 0xc1, 0x6a, 0x1c, 0xa4, 0x5b, 0x90, 0xc3, 0x35, 0x70, 0x55,
-	0xa0, 0xac, 0x23, 0x79, 0xed, 0x75, 0xac, 0xb3, 0xb1, 0xa8, 0x64, 0x12, 0x78, 0xa4, 0x44, 0x4b,
-	0xff, 0x10, 0x72, 0x35, 0x77, 0x38, 0x74, 0x1d, 0x83, 0x8e, 0x06, 0x57, 0xaf, 0xb3, 0xc9, 0xba,
-	0x09, 0x19, 0x3e, 0xa4, 0xe1, 0xbc, 0xd6, 0xa1, 0x78, 0x04, 0xb9, 0x4b, 0x9b, 0xbe, 0x34, 0xdd,
-	0x11, 0x3b, 0xa5, 0x38, 0x7f, 0x71, 0xa7, 0xc8, 0x10, 0x9f, 0xdb, 0xf4, 0x65, 0x0b, 0xa1, 0x06,
-	0x5c, 0x86, 0xbf, 0xf5, 0x9f, 0x40, 0xae, 0xe3, 0x5e, 0x50, 0x67, 0x8f, 0x06, 0x96, 0x3d, 0x78,
-	0xa5, 0x68, 0xad, 0x01, 0x5e, 0x13, 0x7e, 0xda, 0x64, 0xf3, 0x3a, 0x6a, 0x7c, 0x04, 0x85, 0x2a,
-	0x57, 0xd3, 0xd7, 0xb8, 0xfc, 0x8a, 0xaa, 0x4f, 0xc4, 0x55, 0xfd, 0x7d, 0x48, 0x9e, 0xf4, 0xfc,
-	0x72, 0x12, 0xaf, 0x13, 0xbf, 0xa0, 0x11, 0x27, 0x06, 0xeb, 0xd3, 0x1b, 0xb0, 0x8a, 0xb0, 0x7d,
-	0xd4, 0xf2, 0x82, 0x47, 0x85, 0x17, 0x2d, 0xce, 0x4b, 0x05, 0x32, 0xb6, 0xcf, 0x71, 0x71, 0xb2,
-	0x8c, 0x11, 0xb6, 0xf5, 0x2f, 0x34, 0x20, 0x33, 0xb4, 0xfc, 0x85, 0x02, 0x7b, 0x1f, 0x92, 0xc1,
-	0x69, 0x5f, 0xdc, 0xf5, 0xb5, 0x70, 0x71, 0xea, 0x60, 0x83, 0x61, 0x5c, 0x47, 0x7e, 0x5f, 0x68,
-	0x70, 0x53, 0x08, 0x70, 0x97, 0xaf, 0xf8, 0x8d, 0xc8, 0xf1, 0x21, 0x2c, 0x05, 0xa7, 0x7d, 0x29,
-	0xc8, 0xf5, 0xb9, 0x6b, 0xf5, 0x0d, 0xc4, 0xd1, 0xff, 0x52, 0x83, 0x74, 0x67, 0xd2, 0x70, 0x46,
-	0xe3, 0x80, 0xdc, 0x86, 0x8c, 0x47, 0x4f, 0x4d, 0xc5, 0x04, 0xa6, 0x3d, 0x7a, 0xda, 0x61, 0x5a,
-	0xf8, 0x6d, 0x00, 0xd6, 0xe5, 0x9e, 0x9e, 0xfa, 0x94, 0xdf, 0x82, 0x65, 0x23, 0xeb, 0xd1, 0xd3,
-	0x16, 0x02, 0xe2, 0xc6, 0x70, 0x99, 0x5b, 0xab, 0xd0, 0x18, 0x46, 0x16, 0x3c, 0x85, 0x3d, 0x0b,
-	0x2d, 0x78, 0x7a, 0x8e, 0x05, 0xff, 0x31, 0x33, 0x2d, 0xad, 0x71, 0xc0, 0xd6, 0x17, 0x11, 0xd2,
-	0x62, 0x84, 0x6e, 0x41, 0x3a, 0x70, 0xf9, 0xdc, 0x5c, 0x4d, 0xa4, 0x02, 0x17, 0x67, 0x9e, 0x99,
-	0x61, 0x69, 0xce, 0x0c, 0x2d, 0x28, 0x1e, 0x8f, 0x47, 0xdc, 0xb2, 0x5a, 0xc1, 0xd8, 0x63, 0x76,
-	0x22, 0x37, 0x1a, 0x9f, 0x0c, 0xec, 0x9e, 0x79, 0x41, 0xaf, 0x98, 0x43, 0x92, 0xdc, 0xca, 0x1b,
-	0xc0, 0x41, 0x07, 0xf4, 0xca, 0x67, 0xc6, 0xd3, 0x97, 0xd8, 0x62, 0xca, 0x08, 0xa0, 0xff, 0x6b,
-	0x0a, 0x72, 0x8a, 0x65, 0x99, 0xeb, 0x55, 0x2c, 0xd6, 0x6c, 0x5b, 0x90, 0x0d, 0x26, 0xa6, 0xcd,
-	0x36, 0x44, 0xee, 0x60, 0x8e, 0x5b, 0x16, 0xdc, 0x24, 0x23, 0x13, 0xf0, 0x1f, 0x3e, 0xf9, 0x00,
-	0x20, 0x98, 0x98, 0x2e, 0xca, 0x86, 0x59, 0x00, 0xc5, 0x08, 0x71, 0x81, 0x19, 0xd9, 0x40, 0xfc,
-	0xf2, 0x43, 0x8b, 0x9e, 0x52, 0x2c, 0x7a, 0x05, 0x32, 0x3d, 0xd7, 0x76, 0x4e, 0x2c, 0x9f, 0xa2,
-	0xec, 0x33, 0x46, 0xd8, 0xfe, 0x9d, 0xbc, 0x06, 0xc5, 0x43, 0x80, 0x98, 0x87, 0x80, 0x67, 0x75,
-	0x1c, 0xb8, 0x67, 0xd4, 0x29, 0xe7, 0x70, 0x22, 0xd9, 0x24, 0x3b, 0x50, 0x08, 0xd9, 0x35, 0xe9,
-	0x24, 0x28, 0xdf, 0x42, 0x3e, 0x8a, 0x0a, 0xcb, 0xf5, 0x49, 0x60, 0xe4, 0x24, 0xd7, 0xf5, 0x49,
-	0x40, 0xbe, 0x03, 0xc5, 0x88, 0x71, 0x1c, 0x54, 0x56, 0x54, 0x86, 0x60, 0x99, 0x8d, 0xca, 0x87,
-	0xfc, 0xb3, 0x61, 0x9f, 0xc0, 0x2a, 0x33, 0x17, 0x9e, 0xd5, 0x0b, 0x4c, 0x8f, 0xfe, 0x74, 0x4c,
-	0xfd, 0xc0, 0x2f, 0xdf, 0x8e, 0xfc, 0xa7, 0x86, 0x73, 0xe9, 0x5e, 0x50, 0x83, 0xf7, 0x18, 0x25,
-	0x89, 0x2b, 0x00, 0xb8, 0xeb, 0xb6, 0x63, 0x07, 0xb6, 0x15, 0xb8, 0x5e, 0xb9, 0x82, 0x62, 0x89,
-	0x00, 0xcc, 0x22, 0x59, 0xe3, 0xe0, 0x1c, 0x29, 0xdb, 0x1e, 0x2d, 0x6f, 0xdc, 0x4b, 0x6e, 0x65,
-	0x8d, 0x1c, 0x83, 0x19, 0x1c, 0x44, 0x1e, 0xc3, 0x4a, 0x88, 0x8f, 0x8e, 0x9d, 0x5f, 0xde, 0x8c,
-	0xa6, 0x0f, 0xcf, 0x5f, 0xc3, 0x39, 0x75, 0x8d, 0x62, 0x88, 0xc9, 0xe0, 0x3e, 0xf9, 0x01, 0x10,
-	0x95, 0xbc, 0x18, 0xfe, 0xf6, 0xa2, 0xe1, 0x25, 0x65, 0x5e, 0x4e, 0xe0, 0x9b, 0x40, 0x3c, 0xda,
-	0xa3, 0xf6, 0x25, 0xed, 0x9b, 0xd1, 0x1e, 0xde, 0xc1, 0x3d, 0x5c, 0x95, 0x3d, 0x9d, 0x70, 0x2f,
-	0x3f, 0x04, 0x98, 0xb0, 0x5b, 0xc1, 0x1d, 0xd0, 0xbb, 0xa8, 0x85, 0x08, 0xaa, 0xb2, 0xd8, 0x5d,
-	0x31, 0xb2, 0x13, 0xd9, 0x26, 0x3b, 0x90, 0x1f, 0xba, 0x7d, 0xfb, 0xf4, 0xca, 0xe4, 0x4e, 0xc6,
-	0xbd, 0xc8, 0xd1, 0x7a, 0x86, 0x70, 0xee, 0x62, 0xe4, 0x86, 0x51, 0x83, 0xbc, 0x03, 0xe9, 0xa7,
-	0x7b, 0xa6, 0xed, 0x9c, 0xba, 0xe5, 0xfb, 0x8a, 0xa6, 0xdb, 0x43, 0x26, 0x52, 0xfc, 0xaf, 0xee,
-	0x03, 0x1c, 0xd2, 0xfe, 0x19, 0xf5, 0x9e, 0xd1, 0xc0, 0x62, 0x82, 0xf6, 0x5c, 0x37, 0x30, 0xe5,
-	0xfd, 0xe1, 0xd7, 0x2a, 0xc7, 0x60, 0xbb, 0xe2, 0x0e, 0xdd, 0x85, 0x5c, 0x60, 0x8f, 0xcc, 0xf8,
-	0x0d, 0x83, 0xc0, 0x1e, 0xed, 0x46, 0xee, 0x43, 0xe0, 0x8d, 0x9d, 0x8b, 0x78, 0xec, 0x90, 0x43,
-	0x98, 0x50, 0x0b, 0xbf, 0x5c, 0x86, 0x4c, 0x37, 0x98, 0xb8, 0x38, 0xe7, 0xbb, 0x50, 0x1c, 0x58,
-	0x01, 0xf5, 0xa7, 0x67, 0x2d, 0x70, 0xa8, 0x24, 0xab, 0x43, 0x81, 0xfd, 0x62, 0x6a, 0xc3, 0x1c,
-	0xd8, 0x7e, 0x80, 0xd6, 0x22, 0x6b, 0xe4, 0x18, 0xf0, 0x80, 0x5e, 0x1d, 0xda, 0x7e, 0xc0, 0x34,
-	0xe9, 0x38, 0x98, 0xb8, 0x66, 0xe0, 0x06, 0xd6, 0x40, 0x04, 0x0e, 0x59, 0x06, 0xe9, 0x30, 0x00,
-	0xbb, 0x93, 0xd6, 0xe5, 0xd9, 0x1e, 0x1d, 0x58, 0x57, 0x42, 0x5b, 0x85, 0x6d, 0xf2, 0x7f, 0x60,
-	0x75, 0xec, 0xf4, 0x5c, 0xe7, 0xd4, 0xf6, 0x86, 0x9d, 0x49, 0x95, 0xab, 0x42, 0xee, 0xe4, 0xce,
-	0x76, 0x90, 0x07, 0x50, 0x1c, 0x5a, 0x13, 0xbe, 0x60, 0xd3, 0xb7, 0x3f, 0xa7, 0x78, 0xf7, 0x93,
-	0x46, 0x7e, 0x68, 0x4d, 0xb8, 0x6f, 0x67, 0x7f, 0x4e, 0xc9, 0xff, 0x67, 0xc7, 0xc2, 0xa7, 0xde,
-	0xa5, 0x70, 0xa6, 0xd8, 0x89, 0xf7, 0xcb, 0xe9, 0x45, 0xb7, 0x62, 0x55, 0x22, 0xd7, 0x24, 0x2e,
-	0xa3, 0x70, 0xea, 0x7a, 0x27, 0x76, 0xbf, 0x4f, 0x9d, 0x90, 0x04, 0xaa, 0x8d, 0xf9, 0x14, 0x42,
-	0x64, 0x49, 0x82, 0x7c, 0x1f, 0x36, 0x1c, 0xfa, 0xd2, 0x14, 0x01, 0x8b, 0xe9, 0x51, 0xdf, 0x1d,
-	0x7b, 0x3d, 0x6a, 0x0a, 0x65, 0xcf, 0xf5, 0x4c, 0xd9, 0xa1, 0x2f, 0x65, 0x6c, 0x23, 0x10, 0x04,
-	0xa3, 0x1f, 0xc3, 0x2d, 0xdb, 0xf3, 0x28, 0xea, 0x9a, 0x93, 0x01, 0x55, 0x9c, 0x3e, 0x54, 0x43,
-	0x49, 0x63, 0x51, 0xf7, 0xf4, 0xc8, 0xf6, 0xc0, 0xee, 0xd3, 0x17, 0xb6, 0xd3, 0x77, 0x5f, 0xa2,
-	0x9a, 0x9a, 0x1a, 0xa9, 0x74, 0x93, 0x2d, 0xc8, 0x9c, 0x59, 0xfe, 0x91, 0x67, 0xf7, 0x28, 0x06,
-	0x49, 0x42, 0xf3, 0x3e, 0x11, 0x30, 0x23, 0xec, 0x25, 0x35, 0xb8, 0x79, 0xe6, 0xb9, 0xe3, 0x91,
-	0x89, 0xc1, 0x76, 0x24, 0xa0, 0xc2, 0x22, 0x01, 0x11, 0x44, 0x47, 0x87, 0x41, 0x4a, 0x48, 0xff,
-	0x1c, 0x32, 0x92, 0x34, 0xb3, 0xd2, 0xbd, 0xd1, 0xd8, 0xf4, 0xac, 0x80, 0xbb, 0x28, 0x49, 0x23,
-	0xdd, 0x1b, 0x8d, 0x0d, 0x2b, 0xc0, 0xae, 0x21, 0x1d, 0xf2, 0x2e, 0xee, 0xa9, 0xa6, 0x87, 0x74,
-	0x88, 0x5d, 0x1b, 0x90, 0xed, 0xdb, 0xfe, 0x05, 0xef, 0x4b, 0x86, 0x81, 0xd1, 0x85, 0xec, 0x9c,
-	0x9c, 0x52, 0xca, 0x3b, 0xc5, 0xa9, 0x63, 0x00, 0xd6, 0xa9, 0xff, 0xf3, 0x32, 0x14, 0x62, 0x41,
-	0x82, 0xaa, 0xe7, 0xb5, 0xb8, 0x9e, 0x0f, 0xad, 0x06, 0xf7, 0x10, 0x84, 0xd5, 0x58, 0x1c, 0xc0,
-	0xdc, 0x86, 0xcc, 0xc8, 0xa3, 0xe6, 0xb9, 0xe5, 0x9f, 0xe3, 0xbc, 0x79, 0x23, 0x3d, 0xf2, 0xe8,
-	0x53, 0xcb, 0x3f, 0x67, 0x17, 0x61, 0xe4, 0xb9, 0x23, 0xd7, 0xa7, 0xa1, 0x47, 0x21, 0xdb, 0xcc,
-	0x98, 0xa1, 0x5a, 0x12, 0xc6, 0x8c, 0xfd, 0x66, 0xce, 0x81, 0x88, 0xb6, 0xd3, 0xdc, 0x07, 0x18,
-	0x85, 0x91, 0xf6, 0x90, 0x7a, 0x17, 0x03, 0x6a, 0x32, 0x0d, 0x81, 0xe7, 0x32, 0x6f, 0x00, 0x07,
-	0x19, 0xae, 0x1b, 0x28, 0xce, 0x7d, 0x56, 0x75, 0xee, 0xe3, 0xb6, 0x0e, 0xa6, 0x6d, 0xdd, 0xb7,
-	0x98, 0x06, 0x09, 0x6d, 0xbc, 0x5f, 0xce, 0x29, 0x16, 0x48, 0x89, 0x2a, 0x63, 0x48, 0x8c, 0xdd,
-	0x60, 0x62, 0xf2, 0xc0, 0x3d, 0xcf, 0x25, 0x17, 0x4c, 0x6a, 0x78, 0x88, 0xa3, 0x65, 0x06, 0x1e,
-	0xa5, 0xe5, 0x02, 0xf7, 0x39, 0x38, 0xa8, 0xe3, 0x51, 0x14, 0x62, 0x6f, 0xec, 0x75, 0xa8, 0x37,
-	0x2c, 0x97, 0xc4, 0xae, 0xf3, 0x26, 0xb9, 0x07, 0xb9, 0xde, 0xd8, 0xc3, 0xad, 0x69, 0x8e, 0x87,
-	0xe5, 0x55, 0xae, 0xcb, 0x14, 0x10, 0xf9, 0x01, 0xc0, 0xa9, 0x65, 0x0f, 0x98, 0xe6, 0x9f, 0xf8,
-	0x65, 0x82, 0x4b, 0xbd, 0x37, 0x13, 0xfc, 0x6d, 0xef, 0x23, 0x4e, 0x67, 0xe2, 0xd7, 0x9d, 0xc0,
-	0xbb, 0x32, 0xb2, 0xa7, 0xb2, 0x4d, 0xee, 0x00, 0x04, 0x96, 0x77, 0x46, 0x83, 0x5d, 0x3b, 0xf0,
-	0xcb, 0x37, 0x70, 0xe9, 0x0a, 0x84, 0x6c, 0x41, 0xfa, 0x87, 0x63, 0x3f, 0xb0, 0x4f, 0xaf, 0xca,
-	0x37, 0xf1, 0x5c, 0xa3, 0xfd, 0xfe, 0x74, 0xec, 0x7a, 0xe3, 0x61, 0x8d, 0x7a, 0x81, 0x21, 0xbb,
-	0x99, 0x08, 0x6c, 0xc7, 0x44, 0x45, 0x8b, 0x69, 0x8d, 0x8c, 0x91, 0xb6, 0x9d, 0x0e, 0x6b, 0xb2,
-	0x53, 0xe8, 0xd0, 0x49, 0xc0, 0x4f, 0xc3, 0x0a, 0xdf, 0x72, 0x06, 0x60, 0xc7, 0xa1, 0xf2, 0x3d,
-	0x28, 0xc6, 0x97, 0x47, 0x4a, 0x90, 0x64, 0xbb, 0xcd, 0xbd, 0x74, 0xf6, 0x93, 0x9d, 0xbe, 0x4b,
-	0x6b, 0x30, 0x96, 0x11, 0x0d, 0x6f, 0x3c, 0x4e, 0x7c, 0xac, 0xe9, 0xbf, 0xd5, 0x20, 0xb3, 0x5b,
-	0x7b, 0x03, 0x19, 0x0a, 0x1d, 0x96, 0x86, 0x34, 0xb0, 0x44, 0x0c, 0x89, 0x5c, 0x46, 0xa6, 0xc9,
-	0xc0, 0xbe, 0x28, 0xca, 0x5e, 0x7a, 0x75, 0x94, 0xcd, 0x94, 0xc8, 0x58, 0x58, 0x18, 0x11, 0x91,
-	0xa3, 0x12, 0x91, 0x56, 0xc7, 0x08, 0x7b, 0xc9, 0x03, 0x28, 0x9c, 0x78, 0x96, 0xd3, 0x3b, 0x17,
-	0x96, 0x06, 0xd3, 0x3e, 0x59, 0x23, 0x0e, 0xd4, 0xdb, 0x90, 0xdb, 0xad, 0x75, 0xec, 0xd1, 0x35,
-	0xf8, 0xbc, 0x07, 0x79, 0xdb, 0xe7, 0xdb, 0x61, 0x06, 0xf6, 0x48, 0x04, 0x49, 0x60, 0xfb, 0xb8,
-	0x25, 0x1d, 0x7b, 0x84, 0x44, 0x19, 0x7d, 0x54, 0x48, 0xaf, 0x4b, 0x34, 0x87, 0x0c, 0xa2, 0xc6,
-	0xf3, 0xa5, 0x11, 0x54, 0x40, 0xfa, 0x17, 0x09, 0x48, 0xb5, 0x47, 0x94, 0xf6, 0x7d, 0xf2, 0x11,
-	0x64, 0xdb, 0xe3, 0x21, 0x6f, 0xa0, 0xab, 0x9d, 0xdb, 0xb9, 0x8d, 0xfe, 0x0c, 0x42, 0xb6, 0xc3,
-	0x3e, 0x71, 0x26, 0xc3, 0x36, 0xf9, 0x36, 0x64, 0x76, 0x7b, 0x62, 0x1c, 0x8f, 0xca, 0xca, 0xca,
-	0x38, 0xd9, 0xc5, 0x87, 0x85, 0x98, 0xec, 0x1c, 0xc5, 0x49, 0x7e, 0xd9, 0x39, 0xd2, 0x94, 0x73,
-	0x54, 0x69, 0x40, 0x21, 0x46, 0x78, 0xce, 0x60, 0x5d, 0x1d, 0x2c, 0x76, 0x74, 0xb7, 0xc6, 0xc7,
-	0xa8, 0x47, 0xf2, 0x67, 0x78, 0x22, 0x39, 0x2b, 0xdf, 0x82, 0xb4, 0x20, 0xab, 0x4a, 0x40, 0x76,
-	0x4b, 0x5e, 0x38, 0x2b, 0x12, 0xb3, 0xf2, 0x18, 0xf2, 0x6a, 0xc7, 0x75, 0xf8, 0xd0, 0xff, 0x4a,
-	0x83, 0x42, 0xfb, 0xca, 0x0f, 0xe8, 0xf0, 0x3a, 0x91, 0xfb, 0x07, 0x00, 0x27, 0x3d, 0xdf, 0x14,
-	0x29, 0x27, 0x25, 0xeb, 0x25, 0xaf, 0x96, 0x91, 0x3d, 0xe9, 0x29, 0x04, 0x7d, 0xbe, 0x39, 0x4a,
-	0xbe, 0x45, 0x88, 0x41, 0xf4, 0xa0, 0x8e, 0xa7, 0xd4, 0xeb, 0x7a, 0x03, 0x1e, 0xbf, 0x64, 0x8d,
-	0xb0, 0xad, 0x7b, 0x40, 0x62, 0x2b, 0x7c, 0xed, 0x14, 0x0b, 0xf9, 0x18, 0x8a, 0x3e, 0x1f, 0x19,
-	0x2d, 0x35, 0xbc, 0x88, 0x71, 0x9a, 0x05, 0x5f, 0x6d, 0xea, 0x06, 0xdc, 0xac, 0xb9, 0x8e, 0x4f,
-	0x1d, 0x7f, 0x8c, 0x20, 0x61, 0x92, 0xbf, 0x8a, 0xc6, 0xd0, 0x7f, 0xad, 0xc1, 0x4a, 0x8c, 0xe8,
-	0xeb, 0x87, 0xf7, 0xd2, 0xc8, 0x8a, 0xf0, 0x5e, 0x1a, 0xd9, 0x77, 0xa1, 0xd8, 0x93, 0x04, 0x4d,
-	0x9c, 0x91, 0x7b, 0x91, 0x85, 0x10, 0xda, 0x64, 0xaa, 0xea, 0x3e, 0xe4, 0xfd, 0xc0, 0xf2, 0x02,
-	0x35, 0xf6, 0xcd, 0x1a, 0x39, 0x84, 0x09, 0xff, 0xe7, 0x7d, 0x58, 0xb9, 0xb4, 0x06, 0x76, 0x9f,
-	0x85, 0x19, 0x3e, 0xf7, 0xc2, 0x79, 0x26, 0xba, 0x18, 0x81, 0xd1, 0x03, 0xdf, 0x83, 0x94, 0x61,
-	0xbd, 0xec, 0x7a, 0x83, 0xd7, 0x15, 0x85, 0x87, 0xd8, 0x52, 0x14, 0xbc, 0xa5, 0xff, 0x52, 0x83,
-	0x25, 0xa6, 0xdc, 0x16, 0x06, 0xf2, 0xeb, 0x20, 0x22, 0xf7, 0xa9, 0x38, 0xbe, 0x02, 0x99, 0xc0,
-	0xe5, 0x99, 0x73, 0xe1, 0x41, 0x84, 0x6d, 0x26, 0x27, 0x91, 0xa4, 0x90, 0x1e, 0x84, 0xcc, 0x59,
-	0x6c, 0x42, 0x94, 0xa1, 0x40, 0xbe, 0xd4, 0x94, 0x85, 0xfe, 0xef, 0x1a, 0x64, 0xd9, 0x62, 0x78,
-	0xea, 0xe3, 0x2b, 0xe6, 0x67, 0x65, 0x22, 0x26, 0x19, 0x4f, 0xc4, 0x6c, 0x42, 0x96, 0x67, 0x0d,
-	0xa2, 0x47, 0x80, 0x08, 0x80, 0x0e, 0x06, 0xf3, 0xf9, 0x9b, 0xec, 0xde, 0x73, 0xb9, 0x47, 0x00,
-	0xc6, 0xb3, 0xcc, 0xf7, 0x0b, 0x8f, 0x26, 0xca, 0xff, 0x57, 0x20, 0xe3, 0x50, 0xda, 0x3f, 0x64,
-	0x46, 0x26, 0xc3, 0x03, 0x77, 0xd9, 0xd6, 0x7f, 0x0e, 0xc0, 0xd8, 0x12, 0x29, 0x93, 0xd7, 0xe1,
-	0xeb, 0x01, 0x37, 0x43, 0x87, 0x32, 0x60, 0xc9, 0xed, 0x64, 0xa4, 0x19, 0x32, 0xc2, 0x1e, 0x66,
-	0x82, 0x70, 0x71, 0x6d, 0x3a, 0xa0, 0xbd, 0x80, 0xf6, 0xe5, 0xa1, 0x8b, 0x01, 0xf5, 0xbf, 0xd6,
-	0xa0, 0xd8, 0xb4, 0x02, 0xfb, 0x92, 0xd6, 0xdc, 0x3e, 0xdd, 0xa3, 0x7e, 0x8f, 0x39, 0x6b, 0x4a,
-	0x3a, 0x6d, 0x49, 0x8a, 0x6c, 0xc1, 0xe1, 0x5e, 0x87, 0x54, 0xdf, 0x3e, 0xa3, 0x7e, 0x20, 0x36,
-	0x5a, 0xb4, 0x98, 0x4d, 0x19, 0x79, 0xf4, 0xf2, 0xb9, 0x18, 0x25, 0x0e, 0xb3, 0x02, 0x22, 0x5b,
-	0xb0, 0x82, 0xb1, 0x68, 0x75, 0x64, 0x4b, 0x2c, 0xbe, 0xe9, 0xd3, 0x60, 0xb6, 0xc8, 0xfc, 0x0b,
-	0xcb, 0x1f, 0x86, 0x4b, 0x64, 0x67, 0x68, 0xec, 0x30, 0xe7, 0x4e, 0x26, 0x10, 0x45, 0x93, 0xa7,
-	0x48, 0x86, 0x23, 0x7b, 0x40, 0x3d, 0xf9, 0xde, 0x25, 0xdb, 0x0b, 0x97, 0x7a, 0x17, 0x72, 0x97,
-	0x43, 0x33, 0x1c, 0x26, 0x1e, 0x7f, 0x2e, 0x87, 0x35, 0x39, 0xf0, 0x1d, 0x28, 0x84, 0x89, 0x88,
-	0xe0, 0x6a, 0x44, 0xc5, 0xe6, 0xe7, 0x25, 0xb0, 0x73, 0x35, 0xa2, 0xfa, 0x00, 0x4a, 0x91, 0x20,
-	0x85, 0xde, 0x78, 0x4f, 0x24, 0x71, 0xb4, 0x28, 0x1c, 0x8f, 0x0b, 0x5b, 0x24, 0x76, 0xd6, 0xc3,
-	0x77, 0x01, 0xee, 0x87, 0xcb, 0xfc, 0x7f, 0x19, 0xd2, 0xe7, 0xd4, 0x1a, 0x04, 0xe7, 0x57, 0x22,
-	0x61, 0x2e, 0x9b, 0x7a, 0x1b, 0xd6, 0xf6, 0x46, 0xae, 0x5f, 0xb3, 0x9c, 0x3e, 0xbb, 0xf7, 0xd4,
-	0x7f, 0x13, 0xaa, 0xaf, 0x0f, 0xeb, 0xd3, 0x44, 0xfd, 0x11, 0xd3, 0x51, 0xaf, 0x45, 0xf5, 0x3d,
-	0x28, 0xf6, 0xc2, 0x91, 0x4c, 0x0b, 0x09, 0x47, 0x62, 0x0a, 0xaa, 0x7b, 0x50, 0x61, 0xb3, 0x34,
-	0xdd, 0xa1, 0xed, 0x58, 0x01, 0x35, 0x68, 0xcf, 0xf5, 0xfa, 0x6f, 0x62, 0xfd, 0x8b, 0x2f, 0xb6,
-	0xbe, 0x07, 0x25, 0x75, 0x4e, 0xb6, 0x0e, 0x76, 0x9d, 0xc3, 0x95, 0x89, 0x63, 0x14, 0x01, 0xc2,
-	0x24, 0x20, 0x9f, 0x01, 0x7f, 0xeb, 0x7f, 0xac, 0xc1, 0xc6, 0xdc, 0xa5, 0x5f, 0x43, 0x4a, 0x9f,
-	0xc0, 0x8a, 0x13, 0x1f, 0x2e, 0xee, 0xf0, 0x4d, 0x86, 0x3c, 0xbd, 0x48, 0x63, 0x1a, 0x59, 0xff,
-	0x29, 0xdc, 0x0e, 0x91, 0xe8, 0xd7, 0x23, 0xbc, 0x8e, 0xb2, 0x61, 0xf4, 0x77, 0x62, 0x7a, 0x9e,
-	0x30, 0x1d, 0x7e, 0xd8, 0x9e, 0xbb, 0x5f, 0xd3, 0x11, 0xf8, 0x04, 0xe0, 0x32, 0x9c, 0xeb, 0x77,
-	0xd8, 0xfc, 0x97, 0x70, 0x6b, 0x66, 0xbd, 0xd7, 0x10, 0xc1, 0xc7, 0xb0, 0xc2, 0xa6, 0x67, 0x86,
-	0x2e, 0xbe, 0xef, 0x18, 0x93, 0x44, 0x2b, 0x33, 0xa6, 0xd1, 0x74, 0x37, 0x9a, 0xb8, 0xff, 0xb5,
-	0x48, 0xea, 0x23, 0xc8, 0x5d, 0x46, 0x93, 0xa1, 0x57, 0xea, 0x06, 0x62, 0x0e, 0x16, 0xa5, 0xb1,
-	0xc6, 0x5c, 0x11, 0xfd, 0x0c, 0xca, 0xb3, 0x2b, 0xbd, 0x86, 0x8c, 0xbe, 0x0b, 0x25, 0x9c, 0x78,
-	0x56, 0x48, 0x2b, 0x52, 0x48, 0x02, 0x6e, 0xcc, 0x20, 0xea, 0x36, 0x17, 0x53, 0xed, 0x9c, 0xf6,
-	0x2e, 0x0c, 0xea, 0x8f, 0x07, 0xc1, 0x1b, 0x11, 0x13, 0xe3, 0x93, 0xc5, 0xf0, 0x3c, 0x05, 0x83,
-	0xbf, 0xf5, 0x80, 0xf3, 0x19, 0x9f, 0xea, 0x9a, 0xd7, 0x81, 0xd1, 0x4c, 0x44, 0x34, 0x31, 0x29,
-	0x10, 0xd1, 0xc3, 0x87, 0x84, 0xac, 0xa1, 0x82, 0xf4, 0x16, 0xac, 0xb2, 0x59, 0xa5, 0x77, 0xfd,
-	0xd5, 0xd5, 0xfd, 0x8f, 0x81, 0xa8, 0x04, 0xaf, 0xa5, 0xea, 0x53, 0x31, 0x4f, 0xbd, 0x28, 0x75,
-	0x57, 0xfc, 0xfd, 0x5a, 0xff, 0x95, 0x06, 0x10, 0x81, 0x43, 0xbe, 0x35, 0x85, 0xef, 0x0d, 0xc8,
-	0xf2, 0x8c, 0xa7, 0x33, 0x96, 0x02, 0xc9, 0x9c, 0xc8, 0x3c, 0x88, 0x9a, 0x53, 0x12, 0x25, 0x1b,
-	0x61, 0x4e, 0xe9, 0x3e, 0xe4, 0xe5, 0x6f, 0x1c, 0xcb, 0xd3, 0x60, 0x39, 0x09, 0x63, 0xc3, 0xa7,
-	0x64, 0xba, 0x3c, 0x2b, 0xd3, 0x7f, 0xd2, 0xa0, 0x24, 0xb2, 0x79, 0x47, 0xb5, 0x37, 0x71, 0x5c,
-	0xbe, 0x09, 0x99, 0xf0, 0xa9, 0x22, 0xb9, 0x28, 0x29, 0x1b, 0xa2, 0xc4, 0x9f, 0x28, 0x96, 0xbe,
-	0xec, 0x89, 0x62, 0x79, 0xe6, 0x89, 0x42, 0xff, 0x23, 0x58, 0x55, 0xd6, 0x7f, 0x8d, 0x2d, 0x5c,
-	0xc4, 0xc0, 0x36, 0x63, 0x80, 0xd3, 0x11, 0x81, 0x20, 0x51, 0x19, 0xe0, 0x3d, 0x46, 0x88, 0xa3,
-	0xff, 0x7d, 0x02, 0x0a, 0x31, 0xee, 0x30, 0x33, 0xe6, 0xf6, 0xc7, 0x03, 0x6a, 0x2a, 0x6e, 0x24,
-	0x70, 0x10, 0x06, 0x3a, 0xaa, 0x3b, 0xa5, 0xac, 0x20, 0x74, 0xa7, 0x10, 0x09, 0xf3, 0x6b, 0xc1,
-	0xb9, 0xdb, 0x57, 0x23, 0x26, 0xe0, 0x20, 0x44, 0x78, 0x04, 0x4b, 0x96, 0x77, 0x26, 0xdf, 0xd1,
-	0x36, 0x66, 0xa4, 0xbc, 0x5d, 0xf5, 0xce, 0x44, 0x36, 0x01, 0x11, 0xc9, 0x63, 0x58, 0x09, 0x33,
-	0xd5, 0x03, 0x7b, 0x68, 0x07, 0x3e, 0x0a, 0x54, 0xec, 0x90, 0xcc, 0x51, 0x1f, 0xb2, 0x1e, 0xa3,
-	0xe8, 0xa9, 0x4d, 0x7f, 0xea, 0x49, 0x34, 0x2c, 0x6a, 0xaa, 0x7c, 0x04, 0xd9, 0x70, 0x9a, 0x2f,
-	0x0b, 0xe8, 0xf3, 0x6a, 0x40, 0xff, 0x9f, 0x09, 0x28, 0xc6, 0x65, 0xca, 0x2e, 0x95, 0x78, 0x45,
-	0xd4, 0xe6, 0x3e, 0xa9, 0x89, 0x5e, 0xf2, 0x0d, 0x48, 0xcb, 0x37, 0xc4, 0xc4, 0xfc, 0x67, 0x34,
-	0xd9, 0xcf, 0xee, 0x8f, 0xb2, 0x99, 0x49, 0x16, 0x93, 0xc8, 0x36, 0xb9, 0x8d, 0x19, 0x71, 0x73,
-	0xec, 0xd3, 0xbe, 0xb8, 0x3b, 0xe9, 0x33, 0xcb, 0xef, 0xfa, 0xb4, 0x1f, 0x3b, 0xc4, 0xcb, 0x5f,
-	0x7e, 0x88, 0x77, 0x58, 0xdc, 0xc6, 0xa9, 0xfa, 0xa2, 0xbe, 0x09, 0x9d, 0x99, 0x5a, 0xf8, 0x20,
-	0x27, 0x4e, 0x4d, 0x84, 0x46, 0x3e, 0xe0, 0xaf, 0x2a, 0xfc, 0x8d, 0x50, 0x3c, 0x5f, 0xc4, 0x9e,
-	0x4d, 0x95, 0x6e, 0xb2, 0x0d, 0xb9, 0x71, 0x18, 0x22, 0xf9, 0xe5, 0xcc, 0x9c, 0x97, 0x53, 0x15,
-	0x41, 0x1f, 0x01, 0x44, 0x72, 0xc3, 0x93, 0x3e, 0xee, 0x5d, 0xd0, 0x20, 0x2c, 0x10, 0xc0, 0x96,
-	0xdc, 0x2e, 0xbe, 0x35, 0xb8, 0x5d, 0xea, 0x7b, 0x7a, 0xf2, 0x55, 0xef, 0xe9, 0x4b, 0xd3, 0xc1,
-	0xe9, 0x33, 0xc8, 0x29, 0x1b, 0x70, 0x8d, 0x29, 0xc3, 0x13, 0x92, 0x54, 0x4e, 0x88, 0x5e, 0x85,
-	0x42, 0xec, 0x79, 0x90, 0xe9, 0x89, 0x23, 0xf9, 0x9c, 0x2d, 0xdd, 0x95, 0x10, 0xc0, 0xf4, 0x2a,
-	0x86, 0x9d, 0x9c, 0x2e, 0xfe, 0xd6, 0x7f, 0x04, 0x2b, 0x47, 0xd4, 0x1b, 0xda, 0x3e, 0x8b, 0xa0,
-	0x9e, 0xb9, 0x7d, 0x3a, 0x60, 0xd1, 0x88, 0x37, 0x1e, 0xf0, 0x1b, 0x59, 0xe4, 0xd7, 0x3a, 0x42,
-	0x31, 0xc6, 0x03, 0x6a, 0x60, 0x3f, 0x53, 0x9b, 0x56, 0xaf, 0x47, 0x47, 0xc1, 0x73, 0x25, 0x19,
-	0xa5, 0x82, 0xf4, 0xdb, 0xb0, 0x5c, 0xbd, 0x68, 0x73, 0x86, 0xac, 0x0b, 0x7e, 0x60, 0xb3, 0x06,
-	0xfb, 0xa9, 0xff, 0x85, 0x06, 0x29, 0xec, 0xf3, 0xc9, 0x16, 0x2c, 0xf9, 0x34, 0x3c, 0xce, 0x78,
-	0x24, 0x78, 0xcf, 0x36, 0xfb, 0x47, 0x5c, 0x4d, 0x86, 0x41, 0xee, 0x00, 0xd0, 0xc9, 0x88, 0x39,
-	0x1f, 0x51, 0x84, 0xa9, 0x40, 0x2a, 0xbb, 0x90, 0x0d, 0x87, 0xcc, 0xb9, 0x66, 0x77, 0xe3, 0x29,
-	0xbc, 0x6c, 0x38, 0x93, 0x7a, 0xe3, 0x7e, 0xad, 0x41, 0xb2, 0xda, 0x1b, 0x90, 0x77, 0x20, 0x31,
-	0x1a, 0x0a, 0xc5, 0x78, 0x23, 0x2e, 0x03, 0x14, 0x93, 0x91, 0x18, 0x0d, 0xc9, 0xb7, 0x21, 0x6b,
-	0x5d, 0xf8, 0x2f, 0x64, 0x0d, 0x51, 0x58, 0x96, 0x51, 0xed, 0x0d, 0xb6, 0xab, 0xb2, 0x43, 0x64,
-	0x38, 0x43, 0x44, 0xa6, 0x77, 0x2d, 0x64, 0x50, 0x4d, 0xa1, 0x71, 0x96, 0x0d, 0xd1, 0x53, 0xf9,
-	0x1e, 0x14, 0xe3, 0x04, 0xae, 0x95, 0x07, 0xfc, 0x6f, 0x0d, 0xb2, 0xd5, 0xde, 0xe0, 0x0d, 0x24,
-	0xc6, 0xf9, 0x26, 0x33, 0x25, 0xd6, 0x8c, 0xf4, 0xab, 0x0a, 0x22, 0x3a, 0xc4, 0x34, 0xb2, 0x30,
-	0x4f, 0x71, 0x2d, 0x7d, 0x07, 0x14, 0x95, 0x2c, 0xab, 0x22, 0x15, 0x25, 0xcd, 0xdc, 0x6c, 0xfe,
-	0xcc, 0x49, 0xfb, 0xa8, 0x3a, 0x33, 0x46, 0x04, 0x20, 0xb7, 0x21, 0x69, 0xf5, 0x06, 0xa2, 0xc0,
-	0x2f, 0x2d, 0xe4, 0x6b, 0x30, 0x98, 0xfe, 0x27, 0x1a, 0xe4, 0x1b, 0x7d, 0xea, 0x04, 0x76, 0x70,
-	0x55, 0x1d, 0x07, 0xe7, 0xe1, 0x13, 0x92, 0x36, 0xf7, 0x09, 0x29, 0x11, 0x7b, 0x42, 0x22, 0xb0,
-	0xa4, 0x54, 0x79, 0xe2, 0x6f, 0xc4, 0xa5, 0xd4, 0x6b, 0xec, 0x09, 0x3e, 0x44, 0x2b, 0xfe, 0x6a,
-	0x24, 0x93, 0x3a, 0x12, 0xa0, 0x7f, 0x07, 0x0a, 0xea, 0x2a, 0x7c, 0xf2, 0x00, 0x96, 0x98, 0xf9,
-	0x15, 0x67, 0xba, 0x84, 0x6a, 0x51, 0x41, 0x30, 0xb0, 0x57, 0x3f, 0x80, 0x42, 0xcc, 0x9e, 0xb0,
-	0x61, 0x98, 0x38, 0xe0, 0x57, 0xaf, 0xa4, 0x1a, 0x9c, 0xce, 0xd5, 0x88, 0x1a, 0xd8, 0x8b, 0x35,
-	0xbc, 0x0c, 0x5d, 0xf8, 0x41, 0xbc, 0xa1, 0xdb, 0xb0, 0x5a, 0x3d, 0xd8, 0x09, 0x9f, 0x52, 0x7f,
-	0x9f, 0x9e, 0xff, 0x4f, 0x80, 0xa8, 0x53, 0xbd, 0x01, 0x77, 0xa2, 0x1c, 0x55, 0xbe, 0x72, 0x97,
-	0x56, 0x36, 0x75, 0x0f, 0x2a, 0x4f, 0x68, 0x20, 0xe6, 0x0a, 0x5f, 0xa7, 0xdf, 0x14, 0x7f, 0xe1,
-	0x9c, 0x9a, 0x3a, 0xe7, 0x17, 0x1a, 0x6c, 0xcc, 0x9d, 0xf4, 0x1a, 0x9c, 0x7e, 0x1f, 0xc2, 0x4a,
-	0x93, 0xa9, 0xd4, 0x3a, 0x51, 0x8d, 0x9e, 0xf0, 0x84, 0x57, 0x42, 0x5c, 0x91, 0xb2, 0xfe, 0x3b,
-	0x0d, 0x8a, 0x71, 0x9c, 0x59, 0x7f, 0x48, 0x9b, 0x73, 0xd3, 0xe6, 0xc4, 0x5b, 0x61, 0x8d, 0x50,
-	0x52, 0xa9, 0x11, 0xda, 0x80, 0xac, 0xed, 0x9b, 0x27, 0x96, 0xe3, 0x08, 0xbb, 0x8e, 0x25, 0x74,
-	0xbb, 0xd8, 0x9e, 0x3d, 0xec, 0xd3, 0xe5, 0x40, 0x32, 0xab, 0x96, 0x8a, 0x65, 0xd5, 0xf4, 0x3f,
-	0x4d, 0xc0, 0xe6, 0x91, 0x47, 0xeb, 0x13, 0xda, 0x7b, 0x61, 0x07, 0xe7, 0x3c, 0x7b, 0xd8, 0xed,
-	0x1c, 0xb7, 0x7e, 0xaf, 0xc7, 0x91, 0xe9, 0x28, 0xcc, 0x56, 0x8a, 0xca, 0x09, 0xe1, 0xe1, 0x2b,
-	0x20, 0xe6, 0xa9, 0x30, 0x4d, 0x80, 0xd9, 0xa6, 0x94, 0xf2, 0x68, 0x10, 0xab, 0xad, 0x09, 0x51,
-	0x62, 0x79, 0xd8, 0x74, 0x3c, 0x0f, 0x4b, 0xb6, 0x21, 0x2d, 0x3c, 0x1a, 0xf1, 0xb6, 0x77, 0x53,
-	0xf1, 0x79, 0xc2, 0xe0, 0xc0, 0x90, 0x48, 0xfa, 0x3f, 0x6a, 0xf0, 0xf6, 0x02, 0x99, 0x7c, 0xfd,
-	0x6e, 0x38, 0xd9, 0xe6, 0xfe, 0x14, 0x77, 0x41, 0xc4, 0x43, 0x66, 0x51, 0x66, 0x85, 0x85, 0x8f,
-	0xa4, 0x60, 0xe8, 0xc7, 0x50, 0x9a, 0x76, 0xcf, 0x94, 0x2c, 0xa4, 0x36, 0x9d, 0x85, 0x1c, 0x52,
-	0xdf, 0xb7, 0xce, 0xc2, 0xd2, 0x53, 0xd1, 0x64, 0x07, 0xf0, 0xc4, 0xed, 0xcb, 0x1c, 0x3f, 0xfe,
-	0xd6, 0xff, 0x46, 0x83, 0x9c, 0x52, 0x3e, 0x44, 0xde, 0x85, 0x22, 0x3d, 0x3d, 0xa5, 0xbd, 0xc0,
-	0xbe, 0xa4, 0x51, 0xa9, 0x62, 0xd6, 0x28, 0x84, 0xd0, 0x8e, 0x28, 0xdb, 0x1f, 0x5a, 0xde, 0x05,
-	0xed, 0x8b, 0x27, 0x4d, 0xd1, 0x22, 0xdf, 0x80, 0x52, 0x34, 0x3c, 0x56, 0xfd, 0xb3, 0x12, 0xc2,
-	0xc5, 0xeb, 0xc8, 0xdb, 0x00, 0x51, 0x19, 0x60, 0x3c, 0x7d, 0x2f, 0xbc, 0x24, 0xb4, 0x20, 0x5c,
-	0xc9, 0xe3, 0x6f, 0xfd, 0x53, 0x10, 0x35, 0x4b, 0x44, 0x87, 0xc2, 0x79, 0xdf, 0x54, 0xc6, 0x8b,
-	0x32, 0xa5, 0xf3, 0x7e, 0xe4, 0x67, 0xbd, 0x03, 0x05, 0xd7, 0xb3, 0xcf, 0x6c, 0xc7, 0x1a, 0xf0,
-	0x47, 0x6f, 0x6e, 0x76, 0xf2, 0x12, 0xf8, 0xd4, 0xf2, 0xcf, 0xf5, 0x7f, 0x49, 0x40, 0x09, 0x53,
-	0xf1, 0x98, 0x97, 0x10, 0x15, 0xaf, 0xbf, 0x5f, 0x4b, 0xfd, 0xff, 0xa0, 0xe8, 0x8e, 0xa8, 0x13,
-	0xcd, 0x3a, 0x7d, 0x00, 0x44, 0xd2, 0x64, 0x0a, 0x8b, 0x3c, 0x86, 0x12, 0xdb, 0x22, 0xda, 0x57,
-	0x46, 0x2e, 0xcf, 0x1d, 0x39, 0x83, 0xc7, 0xc6, 0xf2, 0xaa, 0x4c, 0x65, 0x6c, 0x6a, 0xfe, 0xd8,
-	0x69, 0x3c, 0xe6, 0x59, 0xf4, 0x6d, 0x7f, 0x34, 0xb0, 0xae, 0xb0, 0x96, 0x42, 0xd6, 0x91, 0xaa,
-	0x30, 0xfd, 0x82, 0x3f, 0x8b, 0x44, 0xe9, 0x3a, 0x76, 0x78, 0x6b, 0xe1, 0x1b, 0x94, 0xa8, 0xc1,
-	0xe2, 0xb5, 0x18, 0x77, 0xf8, 0xe1, 0xaf, 0xaa, 0x9f, 0x9d, 0x28, 0x10, 0x72, 0x17, 0x96, 0xec,
-	0x80, 0x0e, 0xd5, 0xea, 0x4c, 0x46, 0xfb, 0x80, 0x5e, 0x19, 0xd8, 0xa1, 0xb7, 0x21, 0x2d, 0x00,
-	0xea, 0xf3, 0x94, 0x7c, 0x5a, 0x10, 0x21, 0xc0, 0x3a, 0xa4, 0x94, 0x72, 0xda, 0xac, 0x21, 0x5a,
-	0x4a, 0x6c, 0x98, 0x54, 0x63, 0x43, 0xbd, 0x0b, 0xb7, 0x54, 0x45, 0xbf, 0x67, 0x05, 0xd6, 0x9b,
-	0xc8, 0xda, 0x7c, 0xa1, 0x41, 0x79, 0x96, 0xee, 0x1b, 0x50, 0x39, 0x5b, 0xb0, 0xd4, 0xb7, 0xc2,
-	0x52, 0x89, 0x9b, 0xd3, 0xc6, 0x0c, 0xe7, 0x41, 0x0c, 0xfd, 0x0f, 0x22, 0xe5, 0x21, 0x7b, 0xd8,
-	0x9e, 0x5a, 0xd2, 0xac, 0xca, 0x4d, 0x4a, 0x1a, 0x31, 0x18, 0x79, 0x10, 0x19, 0xba, 0x5a, 0xb8,
-	0x55, 0x49, 0x23, 0x0e, 0xd4, 0xff, 0x4c, 0x83, 0x5b, 0xa2, 0xc8, 0xfa, 0x8d, 0xbb, 0x05, 0xf3,
-	0xed, 0xcc, 0xf4, 0xc7, 0x09, 0x4b, 0xb3, 0x1f, 0x27, 0x1c, 0x40, 0x5e, 0x2e, 0x06, 0x5f, 0xd7,
-	0xbe, 0x0b, 0xa1, 0x65, 0x37, 0x43, 0xa5, 0xb9, 0xc8, 0x09, 0x28, 0xf6, 0x62, 0x6d, 0xfd, 0x3f,
-	0x34, 0x28, 0xcf, 0x72, 0x78, 0x8d, 0x2d, 0x6c, 0xa0, 0x5b, 0x2d, 0x6a, 0xff, 0xb8, 0xf3, 0xf1,
-	0x01, 0xba, 0xcf, 0x0b, 0x88, 0x86, 0x0b, 0x92, 0x55, 0x19, 0xe1, 0xe8, 0x4a, 0x33, 0x72, 0x47,
-	0x16, 0xc6, 0x57, 0xef, 0xc5, 0xe3, 0xab, 0x92, 0xca, 0x22, 0x93, 0x86, 0x1a, 0xa1, 0xfc, 0x83,
-	0x06, 0xab, 0x35, 0xcf, 0xf5, 0xfd, 0x4f, 0xc7, 0xd4, 0xbb, 0x92, 0xfb, 0xb6, 0xa8, 0x48, 0x3f,
-	0xe6, 0x90, 0x24, 0xa6, 0x1d, 0x92, 0x58, 0x76, 0x2c, 0xf9, 0x65, 0xd9, 0xb1, 0xa5, 0xd9, 0x02,
-	0xde, 0x0f, 0xa6, 0x6d, 0xfa, 0x9c, 0x3c, 0x46, 0x68, 0xd0, 0xf7, 0x81, 0xa8, 0x0b, 0x17, 0xdb,
-	0xf1, 0x7f, 0x15, 0x43, 0xac, 0xcd, 0xde, 0x8c, 0x39, 0x19, 0x31, 0x26, 0x51, 0x46, 0x07, 0x0b,
-	0x70, 0xb0, 0x1a, 0x88, 0x28, 0xde, 0x7f, 0x56, 0xf8, 0xfa, 0x5b, 0x50, 0x1a, 0xda, 0x8e, 0x49,
-	0x9d, 0xbe, 0xeb, 0xf9, 0xae, 0xa7, 0xa4, 0x3f, 0x8b, 0x43, 0xdb, 0xa9, 0x0b, 0x70, 0x73, 0x3c,
-	0xd4, 0x9f, 0x43, 0x01, 0xe9, 0x49, 0xd8, 0x2b, 0xbe, 0xbd, 0xbb, 0x05, 0xe9, 0xd1, 0xf8, 0xc4,
-	0x94, 0x11, 0x51, 0x16, 0x23, 0x22, 0x61, 0xfb, 0xce, 0x5d, 0x5f, 0x6a, 0x28, 0xfc, 0xad, 0x07,
-	0x62, 0x9d, 0xc8, 0x2f, 0xae, 0xf3, 0x43, 0x00, 0x5e, 0xf4, 0x88, 0x25, 0x53, 0xca, 0xa3, 0x65,
-	0x9c, 0x1f, 0x23, 0xdb, 0x0b, 0x59, 0x7b, 0x04, 0x59, 0xc9, 0x82, 0x3c, 0x89, 0xab, 0xe1, 0x08,
-	0xb9, 0x62, 0x23, 0xc2, 0xd1, 0x7f, 0xa5, 0xa9, 0xd3, 0xa2, 0xe9, 0x7d, 0x14, 0xed, 0x12, 0x9f,
-	0x73, 0x2d, 0xa4, 0xa0, 0x1e, 0xa2, 0x70, 0xa7, 0xc8, 0x8e, 0xb2, 0x27, 0xfc, 0x48, 0xae, 0x4f,
-	0x8f, 0x98, 0x71, 0x90, 0xde, 0x87, 0x65, 0x5e, 0x82, 0x9d, 0x5c, 0x54, 0x82, 0xcd, 0xfb, 0xf5,
-	0x36, 0x14, 0xe4, 0xe6, 0xd6, 0x2f, 0xa9, 0x13, 0xf0, 0x27, 0x65, 0x51, 0x04, 0xaa, 0xc9, 0x27,
-	0x65, 0x51, 0x09, 0x2b, 0xdf, 0xca, 0x13, 0xca, 0x5b, 0xf9, 0x1c, 0xa7, 0xe8, 0xe1, 0xdf, 0xa6,
-	0x60, 0x65, 0xea, 0x9b, 0x12, 0x92, 0x83, 0x74, 0xbb, 0x5b, 0xab, 0xd5, 0xdb, 0xed, 0xd2, 0x5b,
-	0xa4, 0x04, 0xf9, 0x6e, 0xf3, 0xa0, 0xd9, 0x7a, 0x61, 0xf2, 0xef, 0xb6, 0x34, 0x42, 0xa0, 0x58,
-	0x6b, 0x35, 0x9b, 0xf5, 0x5a, 0xc7, 0x34, 0xea, 0xfb, 0xdd, 0x76, 0xbd, 0x94, 0x20, 0xb7, 0x61,
-	0xad, 0xd9, 0xea, 0x98, 0xf5, 0x66, 0xab, 0xfb, 0xe4, 0xa9, 0xc9, 0x9c, 0x4d, 0x81, 0x9e, 0x24,
-	0x3a, 0xdc, 0x61, 0xed, 0xe7, 0xcf, 0xcc, 0xea, 0xa1, 0x51, 0xaf, 0xee, 0x7d, 0x66, 0x76, 0x9b,
-	0xb5, 0x56, 0x73, 0xbf, 0x61, 0x3c, 0x13, 0x38, 0x4b, 0xa4, 0x02, 0xeb, 0x02, 0x87, 0x51, 0xd9,
-	0x6f, 0x75, 0x9b, 0x7b, 0xa2, 0x6f, 0x99, 0xdc, 0x83, 0xcd, 0x46, 0xf3, 0xa8, 0xdb, 0x31, 0x5b,
-	0xdd, 0x0e, 0xfb, 0x83, 0xf3, 0x7c, 0xda, 0xad, 0x1e, 0x0a, 0x8c, 0x14, 0x59, 0x07, 0xd2, 0x39,
-	0x9e, 0x19, 0x99, 0x26, 0xab, 0x50, 0xe8, 0x1c, 0x9b, 0xed, 0xc6, 0x93, 0xa6, 0x00, 0x65, 0xc8,
-	0x2d, 0xb8, 0xb1, 0x7b, 0xd8, 0xaa, 0x1d, 0xd4, 0x9e, 0x56, 0x1b, 0x4d, 0x36, 0x84, 0x7f, 0x68,
-	0x96, 0x65, 0x4c, 0x3d, 0xaf, 0x1e, 0x36, 0xf6, 0xaa, 0x9d, 0xba, 0x40, 0x06, 0xb2, 0x01, 0xb7,
-	0x6a, 0xd5, 0x26, 0xa3, 0xdb, 0xfe, 0xac, 0x59, 0x33, 0x71, 0xa0, 0xe8, 0xcc, 0x31, 0x4a, 0x92,
-	0x0b, 0xb5, 0x23, 0x4f, 0xd6, 0x60, 0x55, 0xf0, 0x72, 0x74, 0x58, 0xfd, 0x4c, 0x80, 0x0b, 0xa4,
-	0x08, 0xf0, 0xa2, 0x7a, 0x28, 0xd1, 0x8a, 0xe4, 0x06, 0xac, 0x30, 0xca, 0x5c, 0x22, 0x1c, 0xb8,
-	0xc2, 0xc6, 0x0a, 0x62, 0x6c, 0x59, 0x02, 0x5c, 0x62, 0xe2, 0x31, 0x5a, 0xad, 0x8e, 0x39, 0xdb,
-	0xb7, 0x2a, 0x98, 0xdf, 0xeb, 0x1e, 0x1d, 0x36, 0x6a, 0xd1, 0xe2, 0x6f, 0xb0, 0x1d, 0x69, 0xd7,
-	0x8d, 0xe7, 0x8d, 0x5a, 0x5d, 0xec, 0x92, 0x94, 0xcb, 0x4d, 0x36, 0x4b, 0xe7, 0x78, 0xaf, 0xda,
-	0xa9, 0xaa, 0xb2, 0x59, 0x63, 0x3b, 0xcd, 0xc4, 0x75, 0x28, 0x69, 0xdc, 0x66, 0x02, 0xe8, 0x1c,
-	0x9b, 0xfb, 0xf5, 0xba, 0xa9, 0x6c, 0x2e, 0xef, 0xac, 0x30, 0x06, 0x70, 0x9f, 0x15, 0x1a, 0x9b,
-	0xe4, 0x26, 0x94, 0xf6, 0x8e, 0x5a, 0x6d, 0xf3, 0xd3, 0x6e, 0xdd, 0x90, 0x6c, 0xdd, 0x65, 0xb2,
-	0x32, 0x5e, 0xb4, 0xeb, 0x1d, 0xb3, 0xd1, 0x44, 0x21, 0x8b, 0x8e, 0xfb, 0xbc, 0xa3, 0x5a, 0x3b,
-	0x9c, 0xea, 0xd0, 0x49, 0x19, 0x6e, 0x3e, 0xa9, 0xb6, 0x67, 0xa7, 0x7d, 0x87, 0x6c, 0x42, 0xb9,
-	0x73, 0x6c, 0x3e, 0xaf, 0x1b, 0xed, 0x46, 0xab, 0x39, 0x35, 0xee, 0x01, 0xb9, 0x0f, 0x6f, 0xd7,
-	0x5a, 0xcf, 0x8e, 0x0e, 0x1b, 0xd5, 0x66, 0xad, 0x6e, 0xd6, 0x9e, 0xd6, 0x6b, 0x07, 0x48, 0xa4,
-	0x7a, 0x74, 0x64, 0xb4, 0x9e, 0xd7, 0xf7, 0x4a, 0xef, 0x32, 0x94, 0x6a, 0xad, 0xd6, 0xea, 0x36,
-	0x3b, 0x66, 0xad, 0xd5, 0xec, 0x18, 0xd5, 0x5a, 0xc7, 0x6c, 0x77, 0xaa, 0x9d, 0x6e, 0x5b, 0x50,
-	0x79, 0x8f, 0xc9, 0x8e, 0xcf, 0xd1, 0xd8, 0x67, 0x42, 0x65, 0x13, 0xf1, 0xae, 0xad, 0x87, 0x14,
-	0x56, 0x67, 0x3e, 0x19, 0x25, 0x79, 0xc8, 0x74, 0x9b, 0x7b, 0xf5, 0xfd, 0x46, 0xb3, 0x5e, 0x7a,
-	0x4b, 0xfd, 0x80, 0x51, 0x63, 0x0d, 0x71, 0x4c, 0x4a, 0x09, 0x52, 0x80, 0xec, 0x7e, 0xd7, 0xe0,
-	0x14, 0x4b, 0x49, 0xd6, 0x0c, 0xaf, 0x42, 0x69, 0x89, 0x00, 0xa4, 0xf6, 0xab, 0x8d, 0xc3, 0xfa,
-	0x5e, 0x69, 0xf9, 0xe1, 0x01, 0x40, 0xf4, 0x55, 0x1e, 0xc9, 0xc0, 0x52, 0xb3, 0x85, 0xb4, 0x01,
-	0x52, 0x87, 0xf5, 0xbd, 0x27, 0x75, 0x76, 0x0f, 0xd9, 0xac, 0x9d, 0xe3, 0x56, 0xa3, 0xb9, 0xdf,
-	0x2a, 0x25, 0xd8, 0xf9, 0xe2, 0x9f, 0x50, 0x62, 0x3b, 0x49, 0xb2, 0xb0, 0x7c, 0x54, 0xaf, 0x1b,
-	0xed, 0xd2, 0xd2, 0xc3, 0x3f, 0x84, 0x62, 0x3c, 0x9d, 0x8a, 0x04, 0xbb, 0x87, 0x87, 0xa5, 0xb7,
-	0xd8, 0xb9, 0xc7, 0x0d, 0xec, 0x3c, 0x35, 0xea, 0xed, 0xa7, 0xad, 0xc3, 0xbd, 0x92, 0xc6, 0x48,
-	0x21, 0xac, 0x7a, 0xd0, 0xae, 0x77, 0xf8, 0xb2, 0xb1, 0x6d, 0x54, 0x3b, 0xf5, 0x52, 0x92, 0xcd,
-	0x8b, 0xcd, 0x76, 0x97, 0xad, 0xba, 0x00, 0xd9, 0x5a, 0xd5, 0x64, 0x47, 0xad, 0xce, 0x6e, 0x2b,
-	0x2a, 0x87, 0x67, 0xcf, 0xba, 0xcd, 0x46, 0xe7, 0x33, 0xf3, 0x79, 0xab, 0x53, 0x2f, 0xa5, 0x1e,
-	0x7e, 0x04, 0x79, 0x35, 0xa7, 0x44, 0xd2, 0x90, 0xac, 0x1d, 0x75, 0x39, 0x37, 0xcf, 0xea, 0xcf,
-	0x5a, 0xc6, 0x67, 0x25, 0x8d, 0x2d, 0x69, 0xaf, 0xd1, 0x3e, 0x28, 0x25, 0xd8, 0xaf, 0xe3, 0xfd,
-	0x7a, 0xbd, 0x94, 0xdc, 0xf9, 0xcd, 0x0d, 0x48, 0x1d, 0xa3, 0x4a, 0x27, 0x5d, 0x28, 0x45, 0x81,
-	0xec, 0xee, 0x15, 0x7e, 0x71, 0x50, 0x90, 0xfe, 0x32, 0x66, 0xd4, 0x2b, 0x53, 0x51, 0xa5, 0xae,
-	0xff, 0xe2, 0xdf, 0xfe, 0xeb, 0xcf, 0x13, 0x9b, 0xfa, 0xad, 0x47, 0x97, 0x1f, 0x3e, 0xf2, 0x71,
-	0xb0, 0x89, 0x1f, 0x4c, 0x9c, 0x5c, 0xe1, 0x57, 0x0c, 0x8f, 0xb5, 0x87, 0xe4, 0x07, 0x90, 0x3a,
-	0x72, 0xfd, 0xa0, 0x33, 0x21, 0xb1, 0x8f, 0x6e, 0x2b, 0x2b, 0xdc, 0x94, 0x86, 0x5f, 0x64, 0xea,
-	0xeb, 0x48, 0xac, 0xa4, 0xe7, 0x18, 0xb1, 0x91, 0xeb, 0x07, 0x66, 0x30, 0x61, 0x04, 0x76, 0x21,
-	0x83, 0x8a, 0xbd, 0x5a, 0x3b, 0xe4, 0xeb, 0x09, 0x93, 0xa0, 0x95, 0x78, 0x53, 0x2f, 0x23, 0x05,
-	0xa2, 0x17, 0x18, 0x85, 0x9f, 0xb2, 0x31, 0xa6, 0xd5, 0x1b, 0x30, 0x1a, 0x26, 0xac, 0x20, 0x0d,
-	0x25, 0xac, 0xb8, 0x19, 0x0f, 0x55, 0x78, 0xb0, 0x56, 0x99, 0x0b, 0xd5, 0xef, 0x21, 0xe1, 0x8a,
-	0xbe, 0x16, 0x11, 0x46, 0x36, 0x3d, 0x44, 0x62, 0x13, 0xfc, 0x0c, 0xd6, 0x70, 0x82, 0x19, 0xdf,
-	0x78, 0x63, 0xae, 0x2f, 0xcd, 0x8d, 0x59, 0x65, 0x73, 0x7e, 0xa7, 0x70, 0x26, 0xde, 0xc7, 0x59,
-	0xef, 0xeb, 0x9b, 0xd1, 0xac, 0x31, 0xbf, 0xd3, 0x64, 0x0e, 0x39, 0x9b, 0xfc, 0xe7, 0x70, 0x63,
-	0x4e, 0x66, 0x8b, 0xdc, 0xc1, 0xaf, 0x1c, 0x16, 0xe6, 0xd9, 0x2a, 0x77, 0x17, 0xf6, 0x8b, 0x05,
-	0x3c, 0xc0, 0x05, 0xdc, 0xd1, 0x6f, 0xb3, 0x05, 0x9c, 0xd1, 0x20, 0xfc, 0xea, 0x23, 0x74, 0x21,
-	0xd9, 0xec, 0x9f, 0x40, 0x1a, 0x59, 0x9f, 0xd9, 0xe1, 0x58, 0x4b, 0xbf, 0x85, 0xc4, 0x56, 0xf5,
-	0x7c, 0xc4, 0x0d, 0xdf, 0xdf, 0x26, 0xc0, 0x13, 0x1a, 0x88, 0x6f, 0x2a, 0xc9, 0xaa, 0xe2, 0xcb,
-	0x0a, 0x3a, 0xb3, 0x20, 0xbd, 0x82, 0xc4, 0x6e, 0xea, 0x2b, 0x72, 0x65, 0xe2, 0x23, 0x52, 0x46,
-	0xcf, 0x86, 0x52, 0x44, 0x4f, 0x7e, 0x75, 0xaa, 0x90, 0x88, 0x7d, 0xbd, 0x59, 0x59, 0xd8, 0xa3,
-	0xdf, 0xc7, 0x39, 0x36, 0xf4, 0xf5, 0xa9, 0x39, 0xcc, 0x3e, 0xd2, 0x64, 0x53, 0xfd, 0x08, 0xa7,
-	0xe2, 0x9f, 0x6a, 0x5e, 0x8f, 0x81, 0x19, 0xe2, 0xe2, 0xdb, 0x47, 0x85, 0x8f, 0xef, 0x41, 0x86,
-	0xf1, 0x81, 0x89, 0x94, 0x5c, 0xf8, 0xb1, 0x78, 0x63, 0xaf, 0x92, 0x0d, 0x1b, 0xf1, 0x13, 0x8f,
-	0x6b, 0x64, 0x60, 0x36, 0xda, 0xe0, 0x52, 0x60, 0xcd, 0xdd, 0x2b, 0x91, 0x24, 0x59, 0x09, 0x07,
-	0x72, 0x80, 0x4a, 0x29, 0x76, 0x95, 0x43, 0x4a, 0xec, 0x22, 0xf3, 0xc4, 0x0b, 0xdf, 0xa9, 0x1b,
-	0x92, 0x26, 0xfa, 0x33, 0x52, 0x37, 0xab, 0x65, 0xc5, 0x95, 0x58, 0x4b, 0xdf, 0x40, 0xb2, 0x6b,
-	0x7a, 0x29, 0x24, 0xdb, 0xe3, 0x21, 0x13, 0xa3, 0xd7, 0x80, 0x62, 0x8c, 0x9e, 0x20, 0x25, 0xbf,
-	0xb9, 0xae, 0x44, 0xeb, 0xe5, 0xdd, 0x92, 0x5d, 0xa2, 0x50, 0xe3, 0x45, 0xea, 0xa4, 0x0b, 0x2b,
-	0x4f, 0x68, 0xc0, 0x0b, 0x86, 0xd5, 0x65, 0x85, 0xb4, 0xd6, 0x67, 0x0b, 0x8a, 0x51, 0xeb, 0x6c,
-	0x22, 0xc9, 0x75, 0x7d, 0x55, 0x92, 0xf4, 0xaf, 0xfc, 0x68, 0x85, 0x67, 0x40, 0x9e, 0xd0, 0x60,
-	0xba, 0x24, 0xb8, 0x2c, 0xae, 0xed, 0x4c, 0xf1, 0x71, 0xe5, 0xc6, 0x4c, 0xcf, 0xd8, 0x9f, 0x15,
-	0x6d, 0x58, 0xfb, 0x1b, 0x4d, 0xf4, 0x3e, 0x64, 0x9f, 0xd0, 0xa0, 0x49, 0x83, 0xae, 0x71, 0x38,
-	0xb5, 0x72, 0x0c, 0x02, 0x79, 0x45, 0xaf, 0xfe, 0x16, 0x39, 0x00, 0x88, 0xb4, 0xf4, 0x97, 0xe9,
-	0xe7, 0x3b, 0x38, 0x73, 0x59, 0xbf, 0x31, 0xa5, 0x9f, 0x7d, 0xf3, 0x72, 0x87, 0xcd, 0xfa, 0x85,
-	0x06, 0x6b, 0x73, 0xf3, 0x98, 0x04, 0xbf, 0x38, 0x79, 0x55, 0xda, 0xb7, 0x72, 0xff, 0x15, 0x18,
-	0x42, 0x7f, 0xc4, 0x18, 0x1f, 0x79, 0x94, 0x4e, 0x68, 0xcf, 0x54, 0x96, 0xc1, 0x96, 0xf0, 0x04,
-	0x8a, 0xf1, 0xba, 0x43, 0x72, 0x5b, 0x16, 0x94, 0xcc, 0x14, 0x38, 0x56, 0x2a, 0xf3, 0xba, 0x84,
-	0x93, 0xff, 0x1c, 0x6e, 0xcc, 0xa9, 0xcf, 0xe3, 0x4a, 0x70, 0x71, 0xcd, 0x21, 0x57, 0x82, 0xaf,
-	0x2a, 0xec, 0x6b, 0xf3, 0x4a, 0x99, 0x78, 0x05, 0x1c, 0x79, 0x3b, 0x36, 0x6c, 0xba, 0x18, 0xaf,
-	0x72, 0x67, 0x51, 0xb7, 0x20, 0xfa, 0x43, 0x58, 0x99, 0x2a, 0x28, 0x23, 0x21, 0x6f, 0xb3, 0x55,
-	0x71, 0x95, 0x8d, 0xb9, 0x7d, 0x82, 0xd6, 0x33, 0x5e, 0xdf, 0xa8, 0x56, 0x5e, 0x91, 0xd8, 0x80,
-	0xa9, 0xca, 0x31, 0x6e, 0x75, 0x16, 0x16, 0x6b, 0x09, 0x72, 0x6a, 0x81, 0x53, 0x44, 0x6e, 0x4e,
-	0x85, 0x55, 0x44, 0x6e, 0x6e, 0x4d, 0xd4, 0x77, 0x63, 0x55, 0x40, 0x6b, 0x53, 0xc5, 0x42, 0x82,
-	0xc4, 0xfa, 0x34, 0x58, 0x0c, 0xb6, 0x50, 0x41, 0x08, 0xfb, 0xb4, 0x7b, 0x55, 0x3d, 0xe0, 0x04,
-	0x66, 0x9e, 0xc4, 0x38, 0x81, 0xd9, 0xe7, 0xab, 0xb8, 0xe1, 0x56, 0x2d, 0xd8, 0xc9, 0x95, 0x69,
-	0xa1, 0x9e, 0xbc, 0xe4, 0xb6, 0x73, 0x2a, 0x79, 0xc2, 0x39, 0x5e, 0x90, 0x89, 0xe2, 0x1c, 0x2f,
-	0xca, 0xb7, 0xcc, 0xb1, 0x9a, 0x1c, 0x33, 0x6e, 0x35, 0x9b, 0x90, 0x16, 0x97, 0x87, 0xcc, 0x7d,
-	0x6c, 0xa8, 0xac, 0x4d, 0x41, 0x05, 0xf5, 0xb8, 0x97, 0xc4, 0xef, 0xd4, 0x63, 0xed, 0xe1, 0x49,
-	0x0a, 0xff, 0x53, 0xa0, 0x6f, 0xfd, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7a, 0x49, 0x52, 0x71,
-	0x58, 0x48, 0x00, 0x00,
+	// 6696 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7c, 0xdd, 0x6f, 0x23, 0xc9,
+	0x71, 0xf8, 0x0d, 0x29, 0xf1, 0xa3, 0xf8, 0x21, 0xaa, 0x57, 0x2b, 0x71, 0x29, 0xdd, 0xae, 0x76,
+	0xee, 0x7c, 0xb7, 0xb7, 0xf7, 0xb3, 0xd6, 0xb7, 0xb6, 0x7f, 0x77, 0x38, 0xdb, 0xe7, 0x50, 0x14,
+	0xb5, 0x4b, 0x4b, 0x22, 0x75, 0x43, 0x72, 0x6f, 0x0f, 0x36, 0x30, 0x1e, 0x91, 0x2d, 0x69, 0x2c,
+	0x72, 0x86, 0x9e, 0x19, 0xea, 0x28, 0xdb, 0x48, 0x2e, 0x46, 0x9e, 0x9c, 0x87, 0x20, 0x09, 0x90,
+	0xb7, 0x04, 0x41, 0x80, 0xbc, 0x04, 0xc8, 0x4b, 0x10, 0x20, 0x06, 0x02, 0x04, 0x88, 0x11, 0xf8,
+	0x29, 0xc8, 0x4b, 0x90, 0x87, 0xe4, 0xd5, 0x49, 0xfe, 0x83, 0xbc, 0x07, 0xd5, 0x1f, 0x33, 0x3d,
+	0xfc, 0xd8, 0x5b, 0xd9, 0xba, 0x03, 0xf2, 0x22, 0x4d, 0x57, 0x75, 0x57, 0x75, 0x55, 0x77, 0x57,
+	0x57, 0x57, 0x57, 0x13, 0xf2, 0x93, 0xde, 0xb9, 0x65, 0x3b, 0x3b, 0x23, 0xcf, 0x0d, 0x5c, 0x92,
+	0x18, 0x9d, 0x54, 0xb6, 0xce, 0x5c, 0xf7, 0x6c, 0x40, 0x1f, 0x59, 0x23, 0xfb, 0x91, 0xe5, 0x38,
+	0x6e, 0x60, 0x05, 0xb6, 0xeb, 0xf8, 0xbc, 0x46, 0xa5, 0xc4, 0xaa, 0xd3, 0xfe, 0xc9, 0x69, 0xc0,
+	0x21, 0xfa, 0x29, 0xa4, 0x9e, 0x52, 0xab, 0x4f, 0x3d, 0xb2, 0x06, 0xcb, 0x03, 0xf7, 0xcc, 0xee,
+	0x97, 0xb5, 0x6d, 0xed, 0x41, 0xd6, 0xe0, 0x05, 0xb2, 0x09, 0xd9, 0x53, 0xcf, 0x1d, 0x9a, 0x8e,
+	0xdb, 0xa7, 0xe5, 0x04, 0xc3, 0x64, 0x10, 0xd0, 0x74, 0xfb, 0x94, 0xbc, 0x05, 0xcb, 0xd4, 0xf3,
+	0x5c, 0xaf, 0x9c, 0xdc, 0xd6, 0x1e, 0x14, 0x1f, 0xdf, 0xda, 0x19, 0x9d, 0xec, 0x3c, 0xaf, 0x21,
+	0x8b, 0x3a, 0x82, 0xeb, 0xce, 0x78, 0x68, 0xf0, 0x1a, 0xfa, 0x29, 0x14, 0x3a, 0x93, 0x3d, 0x2b,
+	0xb0, 0xaa, 0xbd, 0x9e, 0x3b, 0x76, 0x02, 0x52, 0x86, 0xb4, 0xd5, 0xef, 0x7b, 0xd4, 0xf7, 0x05,
+	0x43, 0x59, 0x24, 0xeb, 0x90, 0xb2, 0x86, 0x58, 0x47, 0xf0, 0x13, 0x25, 0xf2, 0x1a, 0x14, 0x4e,
+	0x3d, 0xf7, 0x47, 0xd4, 0x31, 0xcf, 0xa9, 0x7d, 0x76, 0x1e, 0x30, 0xae, 0x49, 0x23, 0xcf, 0x81,
+	0x4f, 0x19, 0x4c, 0xff, 0x55, 0x02, 0x52, 0x9c, 0x11, 0xd1, 0x21, 0x75, 0xce, 0x44, 0x2b, 0x17,
+	0xb6, 0xb5, 0x07, 0xb9, 0xc7, 0x80, 0xdd, 0xe3, 0xc2, 0x1a, 0x02, 0x43, 0x08, 0x2c, 0x05, 0x13,
+	0x21, 0x73, 0xde, 0x60, 0xdf, 0xc8, 0xff, 0xa4, 0xe7, 0x58, 0x43, 0x29, 0xaf, 0x28, 0x85, 0xaa,
+	0xc0, 0x7e, 0x96, 0x93, 0x91, 0x2a, 0xaa, 0xfd, 0xbe, 0x47, 0xee, 0x41, 0x8e, 0x21, 0x47, 0xe3,
+	0x93, 0x0b, 0x7a, 0x55, 0x5e, 0x62, 0x68, 0x40, 0xd0, 0x31, 0x83, 0x84, 0x15, 0xfc, 0x9e, 0x87,
+	0x15, 0x96, 0xa3, 0x0a, 0x6d, 0x06, 0x41, 0xf2, 0x63, 0x9f, 0x7a, 0xa6, 0x6f, 0x9f, 0x39, 0xe5,
+	0x22, 0xeb, 0x4f, 0x06, 0x01, 0x6d, 0xfb, 0xcc, 0x21, 0x6f, 0x43, 0xda, 0xe2, 0x8a, 0x2b, 0xa7,
+	0xb6, 0x93, 0x0f, 0x72, 0x8f, 0x57, 0x51, 0x98, 0x98, 0x46, 0x0d, 0x59, 0x03, 0x47, 0xd2, 0x71,
+	0x9d, 0x1e, 0x2d, 0x67, 0xf8, 0x48, 0xb2, 0x02, 0xd9, 0x82, 0x6c, 0x60, 0x0f, 0xa9, 0x1f, 0x58,
+	0xc3, 0x51, 0x39, 0xcb, 0x54, 0x17, 0x01, 0x50, 0x11, 0x7d, 0xea, 0xf7, 0xca, 0x79, 0xae, 0x08,
+	0xfc, 0xc6, 0x21, 0xba, 0xa4, 0x9e, 0x6f, 0xbb, 0x4e, 0x79, 0x65, 0x5b, 0x7b, 0xb0, 0x6c, 0xc8,
+	0xa2, 0xfe, 0x4b, 0x0d, 0x32, 0x9d, 0x49, 0x3b, 0xb0, 0x82, 0xb1, 0xaf, 0xe8, 0x59, 0x5b, 0xa8,
+	0xe7, 0x45, 0x3a, 0x95, 0xfa, 0x4f, 0x2a, 0xfa, 0xff, 0x32, 0xa4, 0x7c, 0x46, 0x99, 0x69, 0xb1,
+	0xf8, 0xf8, 0x36, 0x13, 0xd5, 0xb3, 0x1c, 0xdf, 0xea, 0xe1, 0x64, 0xe6, 0x6c, 0x0d, 0x51, 0x89,
+	0x54, 0x20, 0xd3, 0xb7, 0xfd, 0xc0, 0x42, 0x81, 0x97, 0x99, 0x58, 0x61, 0x99, 0xdc, 0x83, 0x44,
+	0x30, 0x29, 0xa7, 0x59, 0xb7, 0x56, 0xa6, 0xc8, 0x18, 0x89, 0x60, 0xa2, 0x7f, 0x1f, 0x8a, 0x9d,
+	0xc9, 0xb1, 0xe7, 0xba, 0xa7, 0x06, 0xfd, 0xe1, 0x98, 0xfa, 0xc1, 0x4d, 0x4b, 0xa3, 0xff, 0x3c,
+	0x01, 0x69, 0xc1, 0xe2, 0xc6, 0x35, 0xf5, 0x35, 0xc8, 0x9f, 0x0c, 0xdc, 0xde, 0x85, 0x29, 0xa8,
+	0x2e, 0x6d, 0x6b, 0x72, 0x6a, 0x34, 0x9c, 0x80, 0x7a, 0x8e, 0x35, 0xd8, 0x45, 0xbc, 0x91, 0x63,
+	0xd5, 0xc4, 0x42, 0xbf, 0x03, 0x99, 0x60, 0x62, 0xda, 0x4e, 0x9f, 0x4e, 0x84, 0xc2, 0xd2, 0xc1,
+	0xa4, 0x81, 0x45, 0x9c, 0xa4, 0x43, 0xea, 0x5d, 0x0c, 0xa8, 0x39, 0xb2, 0x82, 0x73, 0x36, 0xd5,
+	0xf2, 0x06, 0x70, 0xd0, 0xb1, 0x15, 0x9c, 0x2b, 0x63, 0x93, 0xbe, 0xee, 0xd8, 0x64, 0xa6, 0xc6,
+	0x66, 0x1b, 0xf2, 0xb6, 0x6f, 0x06, 0xde, 0xd8, 0xb9, 0x30, 0x03, 0x9b, 0x4f, 0xc9, 0x8c, 0x01,
+	0xb6, 0xdf, 0x41, 0x50, 0xc7, 0x1e, 0xe9, 0x7d, 0x20, 0x4f, 0x68, 0x20, 0xe7, 0xd9, 0xe7, 0x35,
+	0x40, 0xff, 0x9c, 0x80, 0x5b, 0x31, 0x36, 0xfe, 0xc8, 0x75, 0x7c, 0x7a, 0xe3, 0x83, 0xf5, 0x75,
+	0x58, 0x46, 0xad, 0x50, 0x31, 0xab, 0xef, 0x21, 0xb9, 0x39, 0x7c, 0x77, 0x38, 0x80, 0x1a, 0xbc,
+	0x36, 0x2e, 0x42, 0x36, 0x78, 0x76, 0x9f, 0x0d, 0x56, 0xde, 0x90, 0x45, 0x64, 0x2e, 0x0c, 0x61,
+	0x8a, 0xa9, 0x56, 0x94, 0xc8, 0xeb, 0x50, 0xe8, 0xb9, 0xce, 0xa9, 0xed, 0x0d, 0xb9, 0xed, 0x67,
+	0x43, 0x95, 0x34, 0xe2, 0x40, 0x6c, 0xed, 0x51, 0xcb, 0x77, 0x1d, 0x61, 0x25, 0x44, 0x49, 0xff,
+	0x00, 0xd2, 0xa2, 0x07, 0x24, 0x07, 0xe9, 0x6e, 0xf3, 0xa0, 0xd9, 0xfa, 0xa8, 0x59, 0x7a, 0x05,
+	0x0b, 0xc7, 0xf5, 0xe6, 0x5e, 0xa3, 0xf9, 0xa4, 0xa4, 0x91, 0x02, 0x64, 0x6b, 0xad, 0xe6, 0x7e,
+	0xc3, 0x38, 0xaa, 0xef, 0x95, 0x12, 0x04, 0x20, 0xb5, 0x5f, 0x6d, 0x1c, 0xd6, 0xf7, 0x4a, 0x49,
+	0xdd, 0x82, 0x95, 0x63, 0xea, 0xf4, 0x6d, 0xe7, 0xac, 0x33, 0xd9, 0xb7, 0x07, 0x01, 0xf5, 0xd0,
+	0xf2, 0xd8, 0x8e, 0x1d, 0xd8, 0x56, 0xe0, 0x7a, 0xc2, 0xd8, 0x47, 0x00, 0x9c, 0x23, 0x3d, 0xd7,
+	0x09, 0x3c, 0xab, 0x27, 0x0d, 0x7e, 0x58, 0x66, 0x7b, 0x92, 0x3d, 0xb4, 0xa5, 0xa9, 0xe7, 0x05,
+	0xfd, 0x53, 0x0d, 0x6e, 0x1f, 0xda, 0x7e, 0x10, 0xf2, 0xb9, 0x91, 0xb9, 0xf1, 0x36, 0xa4, 0x4e,
+	0x59, 0x7f, 0x19, 0xb3, 0x1c, 0xdf, 0xcd, 0xa6, 0x44, 0x31, 0x44, 0x15, 0xfd, 0xe7, 0x1a, 0x64,
+	0x43, 0xdc, 0xdc, 0x5d, 0x24, 0x26, 0x74, 0x62, 0x5a, 0xe8, 0x2f, 0x03, 0xf1, 0x68, 0x8f, 0xda,
+	0x97, 0xb4, 0x6f, 0x46, 0x56, 0x99, 0x4b, 0xb9, 0x2a, 0x31, 0x1d, 0xd5, 0x3a, 0xfb, 0xf6, 0x8f,
+	0xf8, 0xd4, 0x49, 0x1a, 0xec, 0x1b, 0x19, 0x48, 0x3d, 0xf9, 0xe5, 0xe5, 0xed, 0x24, 0x32, 0x08,
+	0x01, 0x38, 0x6d, 0xfa, 0x74, 0x44, 0x9d, 0xbe, 0x2f, 0x56, 0xb1, 0x2c, 0xea, 0xbf, 0xaf, 0xc1,
+	0xfa, 0xb4, 0xf6, 0x6e, 0x60, 0xca, 0xaf, 0xc1, 0x72, 0xe0, 0x06, 0xd6, 0x40, 0x0e, 0x15, 0x2b,
+	0x90, 0x7b, 0x90, 0x0c, 0x26, 0x68, 0xc8, 0x71, 0xcf, 0x2a, 0xc4, 0x34, 0x6a, 0x20, 0x46, 0xef,
+	0xc0, 0xfa, 0x13, 0x1a, 0x1c, 0xd1, 0xe1, 0xc8, 0x75, 0x07, 0x38, 0xed, 0x6e, 0x62, 0x2c, 0xf5,
+	0x5f, 0x26, 0x60, 0x63, 0x86, 0xec, 0xcd, 0x08, 0xc9, 0x37, 0x61, 0x21, 0x64, 0xb8, 0xdf, 0x9e,
+	0x5c, 0x05, 0xd4, 0x17, 0xc3, 0xc3, 0x0b, 0x44, 0x87, 0x82, 0x3b, 0xe8, 0x53, 0x3f, 0x30, 0xad,
+	0x33, 0x6a, 0x0e, 0x7d, 0x61, 0x6b, 0x73, 0x1c, 0x58, 0x3d, 0xa3, 0x47, 0x3e, 0xf9, 0x2e, 0x94,
+	0xc2, 0x39, 0x61, 0x32, 0x62, 0xbe, 0xd8, 0xdf, 0xbf, 0x22, 0xcc, 0xc3, 0x3c, 0x11, 0x76, 0x1a,
+	0xb2, 0x4d, 0x8d, 0x35, 0xa9, 0x3b, 0x81, 0x77, 0x65, 0xac, 0xd8, 0x71, 0x68, 0x65, 0x17, 0xd6,
+	0xe6, 0x55, 0x24, 0x25, 0x48, 0xa2, 0x07, 0xc2, 0x17, 0x22, 0x7e, 0xa2, 0x00, 0x97, 0xd6, 0x60,
+	0xcc, 0xa5, 0x4d, 0x1a, 0xbc, 0xf0, 0x7e, 0xe2, 0x3d, 0x4d, 0xff, 0x2f, 0x0d, 0x48, 0xdd, 0x0f,
+	0xec, 0xa1, 0x15, 0xd0, 0x7d, 0x4a, 0x6f, 0x62, 0x9d, 0x7d, 0x19, 0x32, 0x1e, 0x27, 0xe3, 0x97,
+	0x93, 0x91, 0x2f, 0xd3, 0x70, 0x2e, 0xdd, 0x0b, 0xc9, 0xc0, 0x08, 0xab, 0xc4, 0xd7, 0xd1, 0xd2,
+	0xf4, 0x3a, 0xba, 0x0f, 0x79, 0x6b, 0x1c, 0x9c, 0x9b, 0x58, 0xdd, 0xf6, 0xa8, 0x58, 0x07, 0x39,
+	0x84, 0x19, 0x1c, 0x84, 0x6e, 0x23, 0x2e, 0x28, 0x27, 0x30, 0x99, 0xe1, 0xf4, 0x85, 0xb5, 0xcc,
+	0x73, 0x20, 0xdb, 0x1f, 0x7d, 0xfd, 0x0f, 0x12, 0x70, 0x2b, 0x26, 0xe7, 0x0d, 0x4c, 0x96, 0x3b,
+	0x90, 0x39, 0xb3, 0x7c, 0x73, 0xec, 0xd3, 0xbe, 0x98, 0x2f, 0xe9, 0x33, 0xcb, 0xef, 0xfa, 0xb4,
+	0x4f, 0xde, 0x82, 0x2c, 0xa2, 0x46, 0x9e, 0xdd, 0xa3, 0x62, 0xd7, 0xce, 0xb3, 0x01, 0xb7, 0xfc,
+	0x63, 0x84, 0x19, 0x99, 0x33, 0xf1, 0x45, 0xbe, 0x04, 0x45, 0xd1, 0x7d, 0xdf, 0x1a, 0x8e, 0x06,
+	0x54, 0xce, 0x23, 0x21, 0x54, 0x9b, 0x03, 0xc9, 0x43, 0x58, 0x15, 0xd5, 0x4e, 0x29, 0x35, 0x87,
+	0xb4, 0x6f, 0x5b, 0x8e, 0x90, 0x74, 0x85, 0x23, 0xf6, 0x29, 0x3d, 0x62, 0x60, 0xd4, 0x88, 0x3f,
+	0x3e, 0x3b, 0xa3, 0x7e, 0x40, 0xfb, 0x58, 0x5d, 0x6c, 0x10, 0xf9, 0x10, 0xb8, 0x4f, 0xa9, 0xde,
+	0x84, 0xcc, 0xae, 0x15, 0xf4, 0xce, 0x3b, 0x93, 0x97, 0xf3, 0xf0, 0xee, 0x42, 0xb2, 0x33, 0xf1,
+	0xcb, 0x89, 0xed, 0xa4, 0x14, 0x26, 0xdc, 0xd9, 0x10, 0xa1, 0xff, 0x8f, 0x06, 0xcb, 0x4c, 0xd9,
+	0xbf, 0x91, 0x4e, 0x95, 0xdd, 0x30, 0x19, 0xdf, 0x0d, 0x77, 0xa6, 0xbc, 0xc6, 0x75, 0xa4, 0xca,
+	0x18, 0xee, 0xd4, 0xd9, 0xbf, 0x29, 0xd7, 0xe4, 0x4d, 0x58, 0x66, 0x4d, 0x99, 0x3a, 0xe7, 0x3a,
+	0x4d, 0x1c, 0xaf, 0x7f, 0x0b, 0xf2, 0x2a, 0x01, 0x92, 0x85, 0xe5, 0xba, 0x61, 0xb4, 0x8c, 0xd2,
+	0x2b, 0xf8, 0xd9, 0x31, 0xba, 0xcd, 0x83, 0x92, 0x86, 0x5b, 0xe0, 0xae, 0x51, 0x6d, 0xd6, 0x9e,
+	0x96, 0x12, 0xb8, 0x55, 0x36, 0x5b, 0xf5, 0xe7, 0x8d, 0x76, 0xa7, 0x94, 0xd4, 0x7f, 0xaa, 0x41,
+	0x9a, 0x35, 0x6f, 0xec, 0x29, 0x92, 0x2f, 0xbd, 0x84, 0xe4, 0xda, 0x22, 0xc9, 0x13, 0x71, 0xc9,
+	0xef, 0x43, 0xde, 0xa1, 0xb4, 0x6f, 0xa2, 0xf1, 0xa7, 0xc2, 0x36, 0x65, 0x8c, 0x1c, 0xc2, 0x6a,
+	0x1c, 0xa4, 0x5b, 0x90, 0xdb, 0xe5, 0x1e, 0x20, 0xf3, 0x10, 0xa2, 0x7e, 0x24, 0xaf, 0xdd, 0x8f,
+	0xc8, 0xeb, 0x48, 0xa8, 0x5e, 0x87, 0xfe, 0x0e, 0xe4, 0x6a, 0xee, 0x70, 0xe8, 0x3a, 0x06, 0x1d,
+	0x0d, 0xae, 0x5e, 0x66, 0x90, 0x75, 0x13, 0x32, 0xbc, 0x49, 0xc3, 0x79, 0xa9, 0x49, 0xf1, 0x08,
+	0x72, 0x97, 0x36, 0xfd, 0xc4, 0x74, 0x47, 0xe8, 0xc2, 0x30, 0xfe, 0xc5, 0xc7, 0x45, 0xac, 0xf8,
+	0xcc, 0xa6, 0x9f, 0xb4, 0x18, 0xd4, 0x80, 0xcb, 0xf0, 0x5b, 0xff, 0x01, 0xe4, 0x3a, 0xee, 0x05,
+	0x75, 0xf6, 0x68, 0x60, 0xd9, 0x83, 0x17, 0xaa, 0xd6, 0x1a, 0x30, 0x27, 0x95, 0xcf, 0x36, 0x59,
+	0xbc, 0xce, 0x01, 0x77, 0x04, 0x85, 0x2a, 0x3f, 0xc0, 0x5e, 0xe3, 0x58, 0xa4, 0x1c, 0x82, 0x13,
+	0xf1, 0x43, 0xf0, 0x7d, 0x48, 0x9e, 0xf4, 0xa4, 0x81, 0xe4, 0x47, 0x97, 0x48, 0x12, 0x03, 0x71,
+	0x7a, 0x03, 0x56, 0x19, 0x6c, 0x9f, 0x9d, 0x7f, 0x85, 0x8c, 0x8a, 0x2c, 0x5a, 0x5c, 0x96, 0x0a,
+	0x64, 0x6c, 0x9f, 0xd7, 0x65, 0xcc, 0x32, 0x46, 0x58, 0x46, 0x8f, 0x8a, 0xcc, 0xd0, 0xf2, 0x17,
+	0x2a, 0xec, 0x4d, 0x48, 0x06, 0xa7, 0x7d, 0xb1, 0xd6, 0x6f, 0x87, 0x9d, 0x53, 0x1b, 0x1b, 0x58,
+	0xe3, 0x3a, 0xfa, 0xfb, 0x54, 0x83, 0x35, 0xa1, 0xc0, 0x5d, 0xde, 0xe3, 0x1b, 0xd1, 0xe3, 0x43,
+	0x58, 0x0a, 0x4e, 0xfb, 0x52, 0x91, 0xeb, 0x73, 0xfb, 0xea, 0x1b, 0xac, 0x8e, 0xfe, 0xa7, 0x1a,
+	0xfa, 0xbe, 0x0d, 0x67, 0x34, 0x0e, 0xd0, 0x78, 0x7b, 0xf4, 0xd4, 0x54, 0xdc, 0xba, 0xb4, 0x47,
+	0x4f, 0x3b, 0xe8, 0xd9, 0xbd, 0x0a, 0x80, 0x28, 0xf7, 0xf4, 0xd4, 0xa7, 0x7c, 0x15, 0x2c, 0x1b,
+	0x59, 0x8f, 0x9e, 0xb6, 0x18, 0x20, 0x1e, 0x26, 0xe0, 0x2e, 0x7b, 0x14, 0x26, 0x88, 0x62, 0x1b,
+	0x29, 0x86, 0x59, 0x18, 0xdb, 0x48, 0xcf, 0x89, 0x6d, 0x7c, 0x1f, 0x0f, 0xdd, 0xad, 0x71, 0x80,
+	0xfd, 0x8b, 0x08, 0x69, 0x31, 0x42, 0x1b, 0x90, 0x0e, 0x5c, 0xce, 0x9b, 0x9b, 0x89, 0x54, 0xe0,
+	0x32, 0xce, 0x33, 0x1c, 0x96, 0xe6, 0x70, 0x68, 0x41, 0xf1, 0xf9, 0x78, 0xc4, 0x63, 0x0e, 0x56,
+	0x30, 0xf6, 0xf0, 0x04, 0x9d, 0x1b, 0x8d, 0x4f, 0x06, 0x76, 0xcf, 0xbc, 0xa0, 0x57, 0x18, 0xaa,
+	0x61, 0x27, 0x42, 0x0e, 0x3a, 0xa0, 0x57, 0x6c, 0x7f, 0xf6, 0x65, 0x6d, 0xc1, 0x32, 0x02, 0xe8,
+	0xff, 0x92, 0x82, 0x9c, 0x72, 0x3c, 0x9c, 0xeb, 0x29, 0x2f, 0xb6, 0x6c, 0x0f, 0x20, 0xcb, 0x4e,
+	0xaa, 0xa3, 0x71, 0xe8, 0x2b, 0xe4, 0xf8, 0xce, 0xc2, 0x06, 0xc9, 0xc8, 0x04, 0xfc, 0xc3, 0x27,
+	0x6f, 0x03, 0x04, 0x13, 0xd3, 0x65, 0xba, 0x91, 0xee, 0xa6, 0xd8, 0x84, 0xb8, 0xc2, 0x8c, 0x6c,
+	0x20, 0xbe, 0xfc, 0x30, 0xd6, 0x91, 0x52, 0x62, 0x1d, 0xec, 0x14, 0x62, 0x3b, 0x27, 0x96, 0xcf,
+	0xb7, 0xc3, 0x8c, 0x11, 0x96, 0x7f, 0xad, 0x78, 0x8a, 0x12, 0x3b, 0x81, 0x58, 0xec, 0x04, 0x31,
+	0xd6, 0x38, 0x70, 0xcf, 0xa8, 0x53, 0xce, 0x31, 0x46, 0xb2, 0x48, 0x1e, 0x43, 0x21, 0x14, 0xd7,
+	0xa4, 0x93, 0xa0, 0xbc, 0xc1, 0xe4, 0x28, 0x2a, 0x22, 0xd7, 0x27, 0x81, 0x91, 0x93, 0x52, 0xd7,
+	0x27, 0x01, 0xf9, 0x3a, 0x14, 0x23, 0xc1, 0x59, 0xa3, 0xb2, 0x62, 0x32, 0x84, 0xc8, 0xd8, 0x2a,
+	0x1f, 0xca, 0x8f, 0xcd, 0x3e, 0x80, 0x55, 0x79, 0x56, 0x30, 0x43, 0x6f, 0xec, 0xce, 0x22, 0x6f,
+	0xac, 0x24, 0xeb, 0x1a, 0x73, 0xbd, 0xb2, 0xca, 0x67, 0x79, 0x65, 0x9b, 0xb3, 0x5e, 0xd9, 0xfb,
+	0x10, 0xf9, 0xab, 0x2c, 0xe4, 0xe5, 0x97, 0xb7, 0x22, 0xf6, 0xe1, 0xfc, 0x6b, 0x38, 0xa7, 0xae,
+	0x51, 0x0c, 0x6b, 0x22, 0xdc, 0x27, 0xdf, 0x06, 0xa2, 0x92, 0x17, 0xcd, 0x5f, 0x5d, 0xd4, 0xbc,
+	0xa4, 0xf0, 0xe5, 0x04, 0xe6, 0x9f, 0xbe, 0xee, 0x2e, 0x3a, 0x7d, 0xbd, 0x03, 0x30, 0xc1, 0x55,
+	0xc1, 0x18, 0x95, 0xef, 0x31, 0x2b, 0x44, 0x98, 0x29, 0x8b, 0xad, 0x15, 0x23, 0x3b, 0x91, 0x65,
+	0xf2, 0x18, 0xf2, 0x43, 0xb7, 0x6f, 0x9f, 0x5e, 0x71, 0xa7, 0xb3, 0xbc, 0x1d, 0x85, 0xa0, 0x8e,
+	0x18, 0x5c, 0xc4, 0x65, 0x86, 0x51, 0x81, 0xbc, 0x06, 0xe9, 0xa7, 0x7b, 0xa6, 0xed, 0x9c, 0xba,
+	0xe5, 0xfb, 0x8a, 0xa5, 0xdb, 0x63, 0x42, 0xa4, 0xf8, 0x7f, 0xdd, 0x07, 0x38, 0xa4, 0xfd, 0x33,
+	0xea, 0x1d, 0xd1, 0xc0, 0x42, 0x45, 0x7b, 0xae, 0x2b, 0x3c, 0xdb, 0x70, 0x59, 0xe5, 0x10, 0xb6,
+	0xcb, 0x41, 0xb8, 0x80, 0x03, 0x7b, 0x64, 0xc6, 0x57, 0x18, 0x04, 0xf6, 0x68, 0x37, 0x72, 0x1f,
+	0x78, 0x10, 0x26, 0x16, 0x55, 0xcd, 0x31, 0x98, 0x30, 0x0b, 0x3f, 0x5b, 0x86, 0x4c, 0x37, 0x98,
+	0xb8, 0x8c, 0xe7, 0x97, 0xa0, 0x38, 0xb0, 0x02, 0x3c, 0xd7, 0xc4, 0xb9, 0x16, 0x38, 0x54, 0x92,
+	0xd5, 0xa1, 0x80, 0x5f, 0x68, 0x36, 0xcc, 0x81, 0xed, 0x07, 0x6c, 0xb7, 0xc8, 0x1a, 0x39, 0x04,
+	0x1e, 0xd0, 0x2b, 0x3c, 0x81, 0xa2, 0x25, 0x1d, 0x07, 0x13, 0xd7, 0x8c, 0x0e, 0x8e, 0x59, 0x23,
+	0x8b, 0x90, 0x0e, 0x02, 0x70, 0x4d, 0x5a, 0x97, 0x67, 0x7b, 0x74, 0x60, 0x5d, 0x09, 0x6b, 0x15,
+	0x96, 0xc9, 0xff, 0x83, 0xd5, 0xb1, 0x23, 0x22, 0x1a, 0x9d, 0x49, 0x95, 0x9b, 0x42, 0xee, 0x19,
+	0xcf, 0x22, 0xc8, 0xeb, 0x50, 0x1c, 0x5a, 0x13, 0xde, 0x61, 0x93, 0x9d, 0xa4, 0xc5, 0x21, 0x60,
+	0x68, 0x4d, 0xb8, 0x6f, 0x87, 0x27, 0xea, 0xdf, 0xc2, 0x69, 0xe1, 0x53, 0xef, 0x52, 0x38, 0x53,
+	0xfc, 0x68, 0x9d, 0x5e, 0xb4, 0x2a, 0x56, 0x65, 0xe5, 0x9a, 0xac, 0x8b, 0x14, 0x4e, 0x5d, 0xef,
+	0xc4, 0xee, 0xf7, 0xa9, 0x13, 0x92, 0x60, 0x66, 0x63, 0x3e, 0x85, 0xb0, 0xb2, 0x24, 0x41, 0xbe,
+	0x05, 0x9b, 0x0e, 0xfd, 0xc4, 0x14, 0xa1, 0x5c, 0xd3, 0xa3, 0xbe, 0x3b, 0xf6, 0x7a, 0xd4, 0x14,
+	0xc6, 0x9e, 0xdb, 0x99, 0xb2, 0x43, 0x3f, 0x91, 0x51, 0x5f, 0x51, 0x41, 0x08, 0xfa, 0x1e, 0x6c,
+	0xd8, 0x9e, 0x47, 0x99, 0xad, 0x39, 0x19, 0x50, 0xc5, 0xe9, 0x63, 0x66, 0x28, 0x69, 0x2c, 0x42,
+	0x4f, 0xb7, 0x6c, 0x0f, 0xec, 0x3e, 0xfd, 0xc8, 0x76, 0xfa, 0xee, 0x27, 0xe5, 0xdc, 0x6c, 0x4b,
+	0x05, 0x4d, 0x1e, 0x40, 0x78, 0x5a, 0x61, 0xe1, 0xe3, 0xc5, 0x67, 0x99, 0x1a, 0xac, 0x9d, 0x79,
+	0xee, 0x78, 0x64, 0xb2, 0x6b, 0x88, 0x48, 0x41, 0x85, 0x45, 0x0a, 0x22, 0xac, 0x3a, 0x73, 0x18,
+	0xa4, 0x86, 0xf4, 0x1f, 0x41, 0x46, 0x92, 0xc6, 0x5d, 0xba, 0x37, 0x1a, 0x9b, 0x9e, 0x15, 0x70,
+	0x17, 0x25, 0x69, 0xa4, 0x7b, 0xa3, 0xb1, 0x61, 0x05, 0x0c, 0x35, 0xa4, 0x43, 0x8e, 0xe2, 0x9e,
+	0x6a, 0x7a, 0x48, 0x87, 0x0c, 0xb5, 0x09, 0xd9, 0xbe, 0xed, 0x5f, 0x70, 0x5c, 0x32, 0x0c, 0x4b,
+	0x5e, 0x48, 0xe4, 0x04, 0x8f, 0x50, 0x9e, 0x0c, 0xd5, 0x25, 0x8d, 0x0c, 0x02, 0x10, 0xa9, 0xff,
+	0xe3, 0x32, 0x14, 0x62, 0x87, 0x04, 0xd5, 0xce, 0x6b, 0x71, 0x3b, 0x1f, 0xee, 0x1a, 0xdc, 0x43,
+	0xe0, 0x85, 0x17, 0x1c, 0x60, 0xee, 0x40, 0x66, 0xe4, 0x51, 0xf3, 0xdc, 0xf2, 0xcf, 0x19, 0xdf,
+	0xbc, 0x91, 0x1e, 0x79, 0xf4, 0xa9, 0xe5, 0x9f, 0xe3, 0x42, 0x18, 0x79, 0xee, 0xc8, 0xf5, 0x69,
+	0xe8, 0x51, 0xc8, 0x32, 0x0f, 0x0d, 0x9d, 0x39, 0x72, 0x33, 0xc3, 0x6f, 0x74, 0x0e, 0xc4, 0x3d,
+	0x44, 0x9a, 0x41, 0x45, 0x49, 0x09, 0xef, 0xa2, 0x85, 0x60, 0xf3, 0x32, 0x0c, 0xef, 0x1a, 0xae,
+	0x1b, 0x28, 0xce, 0x7d, 0x36, 0x16, 0x52, 0x8c, 0xed, 0x75, 0x30, 0xbd, 0xd7, 0x7d, 0x15, 0x2d,
+	0x48, 0xb8, 0xc7, 0xfb, 0xe5, 0x9c, 0xb2, 0x03, 0x45, 0x70, 0x23, 0x56, 0x49, 0x44, 0xa1, 0x79,
+	0x34, 0x25, 0xcf, 0x35, 0x17, 0x4c, 0x58, 0x98, 0x42, 0xe9, 0x66, 0xe0, 0x51, 0x5a, 0x2e, 0xa8,
+	0x51, 0xe8, 0x8e, 0x47, 0x99, 0x12, 0x7b, 0x63, 0xaf, 0x43, 0xbd, 0x61, 0xb9, 0x24, 0x46, 0x9d,
+	0x17, 0xc9, 0x36, 0xe4, 0x7a, 0x63, 0x8f, 0x0d, 0x4d, 0x73, 0x3c, 0x2c, 0xaf, 0x72, 0x5b, 0xa6,
+	0x80, 0xc8, 0xb7, 0x01, 0x4e, 0x2d, 0x7b, 0x80, 0x96, 0x7f, 0xe2, 0x97, 0x09, 0xeb, 0xea, 0xf6,
+	0xcc, 0xe1, 0x6f, 0x67, 0x9f, 0xd5, 0xe9, 0x4c, 0x44, 0x70, 0x25, 0x7b, 0x2a, 0xcb, 0xe4, 0x2e,
+	0x40, 0x60, 0x79, 0x67, 0x34, 0xd8, 0xb5, 0x03, 0xbf, 0x7c, 0x8b, 0x75, 0x5d, 0x81, 0x90, 0x07,
+	0x90, 0xfe, 0xce, 0xd8, 0x0f, 0xec, 0xd3, 0xab, 0xf2, 0xda, 0xb6, 0x26, 0xf7, 0xef, 0x0f, 0xc7,
+	0xae, 0x37, 0x1e, 0xd6, 0xa8, 0x17, 0x18, 0x12, 0x8d, 0x2a, 0xb0, 0x1d, 0x1e, 0x01, 0x67, 0x17,
+	0x3e, 0x19, 0x23, 0x6d, 0x3b, 0x2c, 0xfa, 0x8d, 0xb3, 0xd0, 0xa1, 0x93, 0x80, 0xcf, 0x86, 0x15,
+	0x3e, 0xe4, 0x08, 0xc0, 0xe9, 0x50, 0xf9, 0x26, 0x14, 0xe3, 0xdd, 0xfb, 0xac, 0x90, 0x4e, 0x56,
+	0x0d, 0xe9, 0xfc, 0x4a, 0x83, 0xcc, 0x6e, 0xed, 0x06, 0xee, 0x6e, 0x74, 0x58, 0x1a, 0xd2, 0xc0,
+	0x2a, 0x27, 0x23, 0x29, 0xa3, 0xad, 0xc9, 0x60, 0xb8, 0xe8, 0x94, 0xbd, 0xf4, 0xe2, 0x53, 0x36,
+	0x1a, 0x91, 0xb1, 0xd8, 0x61, 0xca, 0xcb, 0x91, 0x11, 0x91, 0xbb, 0x8e, 0x11, 0x62, 0x31, 0xbc,
+	0x7d, 0xe2, 0x59, 0x4e, 0xef, 0x5c, 0xec, 0x34, 0x2c, 0x60, 0x96, 0x35, 0xe2, 0x40, 0xbd, 0x0d,
+	0xb9, 0xdd, 0x5a, 0xc7, 0x1e, 0x5d, 0x43, 0xce, 0xe9, 0x0b, 0x89, 0xc4, 0xcc, 0x85, 0x44, 0x5b,
+	0x1c, 0xa3, 0x99, 0x41, 0x7a, 0x59, 0xa2, 0xfc, 0xee, 0x85, 0x59, 0x3c, 0x5f, 0x6e, 0x82, 0x0a,
+	0x48, 0xff, 0x34, 0x01, 0xa9, 0xf6, 0x88, 0xd2, 0xbe, 0x4f, 0xde, 0x85, 0x6c, 0x7b, 0x3c, 0xe4,
+	0x05, 0xe6, 0x6a, 0xe7, 0x1e, 0xdf, 0x61, 0xfe, 0x0c, 0x83, 0xec, 0x84, 0x38, 0x31, 0x27, 0xc3,
+	0x32, 0xf9, 0x1a, 0x64, 0x76, 0x7b, 0xa2, 0x1d, 0x3f, 0x95, 0x95, 0x95, 0x76, 0xbb, 0x3d, 0xb5,
+	0x59, 0x58, 0x13, 0xe7, 0x51, 0x9c, 0xe4, 0x67, 0xcd, 0x23, 0x4d, 0x99, 0x47, 0x95, 0x06, 0x14,
+	0x76, 0x7b, 0x2f, 0x6e, 0xac, 0xab, 0x8d, 0xc5, 0x88, 0xee, 0xd6, 0x78, 0x1b, 0x75, 0x4a, 0xfe,
+	0x18, 0x32, 0x12, 0x4c, 0xbe, 0x0a, 0x69, 0x41, 0x56, 0xd5, 0xc0, 0x6e, 0x2d, 0x2e, 0x0b, 0x17,
+	0x45, 0xd6, 0xac, 0xbc, 0x0f, 0x79, 0x15, 0x71, 0x1d, 0x39, 0xf4, 0x3f, 0xd7, 0xa0, 0xd0, 0xbe,
+	0xf2, 0x03, 0x3a, 0xbc, 0xce, 0xc9, 0xfd, 0x6d, 0x80, 0x93, 0x9e, 0x6f, 0x8a, 0x90, 0x93, 0x12,
+	0xf5, 0x92, 0x4b, 0xcb, 0xc8, 0x9e, 0xf4, 0x14, 0x82, 0x3e, 0x1f, 0x1c, 0x25, 0xde, 0x22, 0xd4,
+	0x20, 0x30, 0xcc, 0xc6, 0x53, 0xea, 0x75, 0xbd, 0x01, 0x3f, 0xbf, 0x64, 0x8d, 0xb0, 0xac, 0x7b,
+	0x40, 0x62, 0x3d, 0x7c, 0xe9, 0x10, 0x0b, 0x79, 0x0f, 0x8a, 0x3e, 0x6f, 0x19, 0x75, 0x35, 0x5c,
+	0x88, 0x71, 0x9a, 0x05, 0x5f, 0x2d, 0xea, 0x06, 0xac, 0xd5, 0x30, 0x04, 0xea, 0xf8, 0x63, 0x06,
+	0xba, 0x89, 0xb0, 0xfc, 0x2f, 0x34, 0x58, 0x89, 0x11, 0x7d, 0xf9, 0xe3, 0xbd, 0xdc, 0x64, 0xc5,
+	0xf1, 0x5e, 0x14, 0xd1, 0x19, 0xed, 0x49, 0x82, 0x26, 0xe3, 0xc8, 0xbd, 0xc8, 0x42, 0x08, 0x6d,
+	0xa2, 0xa9, 0xba, 0x0f, 0x79, 0x3f, 0xb0, 0xbc, 0x40, 0x3d, 0xfb, 0x66, 0x8d, 0x1c, 0x83, 0x09,
+	0xff, 0xe7, 0x4d, 0x58, 0xb9, 0xb4, 0x06, 0x76, 0x1f, 0x8f, 0x19, 0x3e, 0xf7, 0xc2, 0xf9, 0x1d,
+	0x7d, 0x31, 0x02, 0x33, 0x0f, 0x7c, 0x0f, 0x52, 0x86, 0xf5, 0x49, 0xd7, 0x1b, 0xbc, 0xac, 0x2a,
+	0x3c, 0x56, 0x5b, 0xaa, 0x82, 0x97, 0xf4, 0x9f, 0x69, 0xb0, 0x84, 0xc6, 0x6d, 0xe1, 0x41, 0x7e,
+	0x1d, 0xc4, 0xc9, 0x7d, 0xea, 0x1c, 0x5f, 0x81, 0x4c, 0xe0, 0xf2, 0x9c, 0x02, 0xe1, 0x41, 0x84,
+	0x65, 0xd4, 0x93, 0x08, 0x52, 0x48, 0x0f, 0x42, 0x14, 0x71, 0x03, 0x0f, 0x23, 0x14, 0xe5, 0xe5,
+	0xa9, 0x90, 0x85, 0xfe, 0x6f, 0x1a, 0x64, 0xb1, 0x33, 0x3c, 0xf4, 0xf1, 0x1b, 0xc6, 0x67, 0x65,
+	0x20, 0x26, 0x19, 0x0f, 0xc4, 0x6c, 0x41, 0x96, 0x47, 0x0d, 0xa2, 0xf4, 0x88, 0x08, 0x80, 0x58,
+	0x76, 0x08, 0x68, 0xe2, 0xba, 0xe7, 0x7a, 0x8f, 0x00, 0x28, 0xb3, 0xcc, 0x84, 0x10, 0x1e, 0x4d,
+	0x58, 0x46, 0x9c, 0x43, 0x69, 0xff, 0x10, 0x37, 0x99, 0x0c, 0x3f, 0xb8, 0xcb, 0xb2, 0xfe, 0x13,
+	0x00, 0x14, 0x4b, 0x84, 0x4c, 0x5e, 0x46, 0xae, 0xd7, 0xf9, 0x36, 0x74, 0x28, 0x0f, 0x2c, 0xb9,
+	0xc7, 0x19, 0xb9, 0x0d, 0x19, 0x21, 0x06, 0xb7, 0x20, 0xd6, 0xb9, 0x36, 0x1d, 0xd0, 0x5e, 0x20,
+	0xc2, 0xfb, 0x59, 0x23, 0x0e, 0xd4, 0xff, 0x42, 0x83, 0x62, 0xd3, 0x0a, 0xec, 0x4b, 0x5a, 0x73,
+	0xfb, 0x74, 0x0f, 0xa3, 0x0c, 0x04, 0x96, 0x94, 0x70, 0xda, 0x92, 0x54, 0xd9, 0x82, 0xc9, 0xbd,
+	0x0e, 0xa9, 0xbe, 0x8d, 0x11, 0x79, 0x31, 0xd0, 0xa2, 0x84, 0x7b, 0xca, 0xc8, 0xa3, 0x97, 0xcf,
+	0x44, 0x2b, 0x31, 0x99, 0x15, 0x10, 0x79, 0x00, 0x2b, 0xec, 0x2c, 0x5a, 0x1d, 0xd9, 0xb2, 0x16,
+	0x1f, 0xf4, 0x69, 0x30, 0x76, 0x32, 0xff, 0x91, 0xe5, 0x0f, 0xc3, 0x2e, 0xe2, 0x1c, 0x1a, 0x3b,
+	0x81, 0x1d, 0xf6, 0x52, 0x16, 0x79, 0x88, 0x64, 0x38, 0xb2, 0x07, 0xd4, 0x8b, 0x2e, 0x6a, 0x79,
+	0x79, 0x61, 0x57, 0xef, 0x41, 0xee, 0x72, 0x68, 0x86, 0xcd, 0x78, 0x57, 0xe1, 0x72, 0x58, 0x93,
+	0x0d, 0x5f, 0x63, 0x97, 0xd5, 0x3c, 0x10, 0x11, 0x5c, 0x8d, 0xa8, 0x18, 0xfc, 0xbc, 0x04, 0x76,
+	0xae, 0x46, 0x54, 0x1f, 0x40, 0x29, 0x52, 0xa4, 0xb0, 0x1b, 0x6f, 0x88, 0x20, 0x8e, 0x16, 0x1d,
+	0xc7, 0xe3, 0xca, 0x16, 0x81, 0x9d, 0xf5, 0xf0, 0x5e, 0x80, 0xfb, 0xe1, 0xa2, 0x84, 0x72, 0x9e,
+	0x53, 0x6b, 0x10, 0x9c, 0x5f, 0x89, 0x80, 0xb9, 0x2c, 0xea, 0x6d, 0xb8, 0xbd, 0x37, 0x72, 0xfd,
+	0x9a, 0xe5, 0xf4, 0x71, 0xdd, 0xd3, 0x1b, 0xb9, 0x91, 0xec, 0xc3, 0xfa, 0x34, 0xd1, 0x6b, 0x5c,
+	0x31, 0xbd, 0x01, 0xc5, 0x5e, 0xd8, 0x12, 0xad, 0x90, 0x70, 0x24, 0xa6, 0xa0, 0xba, 0x07, 0x15,
+	0xe4, 0xd2, 0x74, 0x87, 0xb6, 0x63, 0x05, 0xd4, 0xa0, 0x3d, 0xd7, 0xeb, 0xdf, 0x44, 0xff, 0x17,
+	0x2f, 0x6c, 0x7d, 0x0f, 0x4a, 0x2a, 0x4f, 0xec, 0x07, 0xbb, 0x9b, 0x96, 0x3d, 0x93, 0x37, 0xfe,
+	0x21, 0x20, 0x0c, 0x02, 0x72, 0x0e, 0xec, 0x5b, 0xff, 0x5d, 0x0d, 0x36, 0xe7, 0x76, 0xfd, 0x1a,
+	0x5a, 0xfa, 0x00, 0x56, 0x9c, 0x78, 0x73, 0xb1, 0x86, 0xd7, 0xb0, 0xf2, 0x74, 0x27, 0x8d, 0xe9,
+	0xca, 0xfa, 0x0f, 0xe1, 0x4e, 0x58, 0x89, 0x7e, 0x31, 0xca, 0xeb, 0x40, 0x65, 0x1e, 0xcb, 0x6b,
+	0x08, 0x3d, 0x4f, 0x99, 0x0e, 0x9f, 0x6c, 0xcf, 0xdc, 0x2f, 0x68, 0x0a, 0x7c, 0x00, 0x70, 0x19,
+	0xf2, 0xfa, 0x35, 0x06, 0xff, 0x13, 0xd8, 0x98, 0xe9, 0xef, 0x35, 0x54, 0xf0, 0x1e, 0xac, 0x20,
+	0x7b, 0xdc, 0xe8, 0xe2, 0xe3, 0xce, 0xce, 0x24, 0x51, 0xcf, 0x8c, 0xe9, 0x6a, 0xba, 0x1b, 0x31,
+	0xee, 0x7f, 0x21, 0x9a, 0x7a, 0x17, 0x72, 0x97, 0x11, 0x33, 0xe6, 0x95, 0xba, 0x01, 0x95, 0x59,
+	0x31, 0xbc, 0x30, 0x57, 0x45, 0x3f, 0x86, 0xf2, 0x6c, 0x4f, 0xaf, 0xa1, 0xa3, 0x6f, 0x40, 0x89,
+	0x31, 0x9e, 0x55, 0xd2, 0x8a, 0x54, 0x92, 0x80, 0x1b, 0x33, 0x15, 0x75, 0x9b, 0xab, 0xa9, 0x76,
+	0x4e, 0x7b, 0x17, 0x06, 0xf5, 0xc7, 0x83, 0xe0, 0xc6, 0xb2, 0xb1, 0xf0, 0x0c, 0xcf, 0x43, 0x30,
+	0xec, 0x5b, 0x0f, 0xa0, 0x3c, 0xcb, 0xea, 0x9a, 0xcb, 0x01, 0x69, 0x26, 0x22, 0x9a, 0x2c, 0x28,
+	0x10, 0xd1, 0x63, 0x17, 0x09, 0x59, 0x43, 0x05, 0xe9, 0x2d, 0x58, 0x45, 0xae, 0x37, 0x96, 0x68,
+	0xa6, 0x7f, 0x1f, 0x88, 0x4a, 0xf0, 0x5a, 0xa6, 0x3e, 0x15, 0xf3, 0xd4, 0x8b, 0xd2, 0x76, 0xc5,
+	0xef, 0xaf, 0xf5, 0x3f, 0xd3, 0x00, 0x22, 0x70, 0x28, 0xb7, 0xa6, 0xc8, 0xbd, 0x09, 0x59, 0x1e,
+	0xf1, 0x74, 0xc6, 0x52, 0x21, 0x99, 0x13, 0x19, 0x07, 0x51, 0x63, 0x4a, 0x22, 0x99, 0x55, 0x96,
+	0xd1, 0x5d, 0x96, 0xdf, 0xac, 0x2d, 0x0f, 0x83, 0xe5, 0x24, 0xac, 0x39, 0x9e, 0xd1, 0xe9, 0xf2,
+	0xac, 0x4e, 0xff, 0x41, 0x83, 0x92, 0x88, 0xe6, 0x1d, 0xd7, 0xfe, 0x0f, 0x26, 0x8e, 0xe8, 0xbf,
+	0x03, 0xab, 0x4a, 0xff, 0x6f, 0x20, 0x21, 0x64, 0x07, 0x05, 0xe0, 0x74, 0xca, 0xc9, 0xc8, 0x6d,
+	0x91, 0x02, 0x70, 0x8c, 0x11, 0xd6, 0xd1, 0xff, 0x36, 0x01, 0x05, 0x89, 0xe4, 0xea, 0xc3, 0xc8,
+	0x98, 0xdb, 0x1f, 0x0f, 0xa8, 0xa9, 0xb8, 0x91, 0xc0, 0x41, 0xec, 0xa0, 0xa3, 0xba, 0x53, 0x4a,
+	0x0f, 0x42, 0x77, 0x8a, 0x55, 0x42, 0x2a, 0x34, 0x38, 0x77, 0xfb, 0xea, 0x89, 0x09, 0x38, 0x88,
+	0x55, 0x78, 0x04, 0x4b, 0x96, 0x77, 0x26, 0xef, 0xd1, 0x36, 0x67, 0xb4, 0xbc, 0x53, 0xf5, 0xce,
+	0x44, 0x34, 0x81, 0x55, 0xc4, 0xdb, 0x9c, 0x30, 0x52, 0xcd, 0x72, 0xf4, 0x78, 0x46, 0x9a, 0x18,
+	0x21, 0x19, 0xa3, 0x3e, 0x44, 0x8c, 0x51, 0xf4, 0xd4, 0xa2, 0x3f, 0x75, 0x25, 0x1a, 0xa6, 0x7b,
+	0x57, 0xde, 0x85, 0x6c, 0xc8, 0xe6, 0xb3, 0x0e, 0xf4, 0x79, 0xf5, 0x40, 0xff, 0x1f, 0x09, 0x28,
+	0xc6, 0x75, 0x8a, 0x8b, 0x4a, 0xdc, 0x22, 0x6a, 0x73, 0xaf, 0xd4, 0x04, 0x96, 0xbc, 0x05, 0x69,
+	0x79, 0x87, 0x98, 0x98, 0x7f, 0x8d, 0x26, 0xf1, 0xb8, 0x7e, 0x94, 0xc1, 0xc4, 0x08, 0x65, 0x58,
+	0x8e, 0x65, 0xfe, 0x2c, 0xc5, 0x33, 0x7f, 0xd4, 0x49, 0xbc, 0xfc, 0xd9, 0x93, 0xf8, 0x31, 0x64,
+	0x25, 0x55, 0x99, 0x19, 0xc6, 0x9c, 0x99, 0x5a, 0x78, 0x21, 0xc7, 0x91, 0x46, 0x54, 0x0d, 0x43,
+	0x13, 0x63, 0x79, 0x98, 0x93, 0xd7, 0x17, 0xb1, 0x6b, 0x53, 0x05, 0x4d, 0x76, 0x20, 0x37, 0x0e,
+	0x8f, 0x48, 0x7e, 0x39, 0x33, 0xe7, 0xe6, 0x54, 0xad, 0xa0, 0x8f, 0x00, 0x22, 0xbd, 0xb1, 0x99,
+	0x3e, 0xee, 0x5d, 0xd0, 0x20, 0x4c, 0x10, 0x60, 0x25, 0x39, 0x5c, 0x7c, 0x68, 0xf0, 0x33, 0x76,
+	0x9f, 0x9e, 0x7c, 0xd1, 0x7d, 0xfa, 0xd2, 0xf4, 0xe1, 0xf4, 0x08, 0x72, 0xca, 0x00, 0x5c, 0x83,
+	0x65, 0x38, 0x43, 0x92, 0xca, 0x0c, 0xd1, 0xab, 0x50, 0x88, 0x5d, 0x0f, 0xa2, 0x9d, 0x38, 0x96,
+	0xd7, 0xd9, 0xd2, 0x5d, 0x09, 0x01, 0x68, 0x57, 0xb1, 0xba, 0xa0, 0xcb, 0xbe, 0xf5, 0xef, 0x62,
+	0x8a, 0xab, 0x37, 0xb4, 0x7d, 0x3c, 0x41, 0x1d, 0xb9, 0x7d, 0x3a, 0xc0, 0xd3, 0x88, 0x37, 0x1e,
+	0xf0, 0x15, 0x59, 0xe4, 0xcb, 0x3a, 0xaa, 0x62, 0x8c, 0x07, 0xd4, 0x60, 0x78, 0x34, 0x9b, 0x56,
+	0xaf, 0x47, 0x47, 0xc1, 0x33, 0x25, 0x18, 0xa5, 0x82, 0xf4, 0x3b, 0xb0, 0x5c, 0xbd, 0x68, 0x73,
+	0x81, 0xac, 0x0b, 0x3e, 0x61, 0xb3, 0x06, 0x7e, 0xea, 0x7f, 0xa2, 0x41, 0x8a, 0xe1, 0x30, 0xc8,
+	0xbc, 0xe4, 0xd3, 0x70, 0x3a, 0xb3, 0x29, 0xc1, 0x31, 0x3b, 0xf8, 0x47, 0x2c, 0x4d, 0xac, 0x81,
+	0xe1, 0x6a, 0x3a, 0x19, 0xa1, 0xf3, 0x11, 0x9d, 0x30, 0x15, 0x48, 0x65, 0x17, 0xb2, 0x61, 0x93,
+	0x39, 0xcb, 0xec, 0x5e, 0x3c, 0x84, 0x97, 0x0d, 0x39, 0xa9, 0x2b, 0xee, 0x17, 0x1a, 0x24, 0xab,
+	0xbd, 0x01, 0x79, 0x0d, 0x12, 0xa3, 0xa1, 0x30, 0x8c, 0xb7, 0xe2, 0x3a, 0x60, 0x6a, 0x32, 0x12,
+	0xa3, 0x21, 0xf9, 0x1a, 0x64, 0xad, 0x0b, 0xff, 0x23, 0x99, 0x43, 0x14, 0xa6, 0x65, 0x54, 0x7b,
+	0x83, 0x9d, 0xaa, 0x44, 0x88, 0x08, 0x67, 0x58, 0x11, 0xed, 0xae, 0xc5, 0x04, 0x54, 0x43, 0x68,
+	0x5c, 0x64, 0x43, 0x60, 0x30, 0x9e, 0x19, 0x27, 0x70, 0xad, 0x38, 0xe0, 0x7f, 0x6b, 0x90, 0xad,
+	0xf6, 0x06, 0x37, 0x10, 0x18, 0xe7, 0x83, 0x8c, 0x46, 0xac, 0x19, 0xd9, 0x57, 0x15, 0x44, 0x74,
+	0x88, 0x59, 0x64, 0xb1, 0x3d, 0xc5, 0x60, 0x38, 0x70, 0x91, 0x49, 0x96, 0xef, 0x45, 0x22, 0x88,
+	0xc8, 0xff, 0xc5, 0x6b, 0x4e, 0xda, 0x67, 0xa6, 0x33, 0x63, 0x44, 0x00, 0x72, 0x07, 0x92, 0x56,
+	0x6f, 0x20, 0x9e, 0x3e, 0xa4, 0x85, 0x7e, 0x0d, 0x84, 0xe9, 0xbf, 0xa7, 0x41, 0xbe, 0xd1, 0xa7,
+	0x4e, 0x60, 0x07, 0x57, 0xd5, 0x71, 0x70, 0x1e, 0x5e, 0x21, 0x69, 0x73, 0xaf, 0x90, 0x12, 0xb1,
+	0x2b, 0x24, 0x02, 0x4b, 0xca, 0xfb, 0x17, 0xf6, 0xcd, 0xea, 0x52, 0xea, 0x35, 0xf6, 0x84, 0x1c,
+	0xa2, 0x14, 0xbf, 0x35, 0x92, 0x41, 0x1d, 0x09, 0xd0, 0xbf, 0x0e, 0x05, 0xb5, 0x17, 0x3e, 0x79,
+	0x1d, 0x96, 0x70, 0xfb, 0x15, 0x73, 0xba, 0xc4, 0xcc, 0xa2, 0x52, 0xc1, 0x60, 0x58, 0xfd, 0x00,
+	0x0a, 0xb1, 0xfd, 0x04, 0x9b, 0xb1, 0xc0, 0x01, 0x5f, 0x7a, 0x25, 0x75, 0xc3, 0xc1, 0xe0, 0x81,
+	0xc1, 0xb0, 0x51, 0x26, 0x79, 0x42, 0xcd, 0x24, 0xb7, 0x61, 0xb5, 0x7a, 0xf0, 0x38, 0xbc, 0x4a,
+	0xfd, 0x3c, 0x3d, 0xff, 0x1f, 0x00, 0x51, 0x59, 0xdd, 0x80, 0x3b, 0x51, 0x8e, 0xde, 0x04, 0x71,
+	0x97, 0x56, 0x16, 0x31, 0x0c, 0xf0, 0x84, 0x06, 0x82, 0x57, 0x78, 0x3b, 0x7d, 0x53, 0xf2, 0xf5,
+	0xa2, 0x14, 0x68, 0x85, 0xe7, 0xa7, 0x1a, 0x6c, 0xce, 0x65, 0x7a, 0x0d, 0x49, 0xbf, 0x05, 0x61,
+	0xa6, 0xc9, 0x54, 0x68, 0x9d, 0xa8, 0x9b, 0x9e, 0xf0, 0x84, 0x57, 0xc2, 0xba, 0x1c, 0xa0, 0xff,
+	0x8d, 0x06, 0xc5, 0x78, 0x9d, 0x59, 0x7f, 0x48, 0x9b, 0xb3, 0xd2, 0xe6, 0x9c, 0xb7, 0xc2, 0x1c,
+	0xa1, 0xa4, 0x92, 0x23, 0xb4, 0x09, 0x59, 0xdb, 0x37, 0x4f, 0x2c, 0xc7, 0x11, 0xfb, 0x3a, 0x4b,
+	0xa1, 0xdb, 0x65, 0xe5, 0xd9, 0xc9, 0x3e, 0x9d, 0x0e, 0x24, 0xa3, 0x6a, 0xa9, 0x58, 0x54, 0x4d,
+	0xff, 0xc3, 0x04, 0x6c, 0x1d, 0x7b, 0xb4, 0x3e, 0xa1, 0xbd, 0x8f, 0xec, 0xe0, 0x9c, 0x47, 0x0f,
+	0xbb, 0x9d, 0xe7, 0xad, 0xcf, 0x75, 0x3a, 0xa2, 0x8d, 0x62, 0xd1, 0x4a, 0x91, 0x39, 0x21, 0x3c,
+	0x7c, 0x05, 0x84, 0x9e, 0x0a, 0x5a, 0x02, 0x16, 0x6d, 0x4a, 0x29, 0x97, 0x06, 0xb1, 0xdc, 0x9a,
+	0xb0, 0x4a, 0x2c, 0x0e, 0x9b, 0x8e, 0xc7, 0x61, 0xc9, 0x0e, 0xc6, 0xa5, 0x99, 0x34, 0xe2, 0x6e,
+	0x6f, 0x4d, 0xf1, 0x79, 0xc2, 0xc3, 0x81, 0x21, 0x2b, 0xe9, 0x7f, 0xaf, 0xc1, 0xab, 0x0b, 0x74,
+	0xf2, 0xc5, 0xbb, 0xe1, 0x64, 0x87, 0xfb, 0x53, 0xdc, 0x05, 0x11, 0x17, 0x99, 0x45, 0x19, 0x15,
+	0xe6, 0x50, 0x43, 0xa9, 0xa1, 0x3f, 0x87, 0xd2, 0xb4, 0x7b, 0xa6, 0x44, 0x21, 0xb5, 0xe9, 0x28,
+	0xe4, 0x90, 0xfa, 0xbe, 0x75, 0x16, 0xa6, 0x9e, 0x8a, 0x22, 0x4e, 0xc0, 0x13, 0xb7, 0x2f, 0x63,
+	0xfc, 0xec, 0x5b, 0xff, 0x2b, 0x0d, 0x72, 0x4a, 0xfa, 0x10, 0xde, 0x7e, 0xd0, 0xd3, 0x53, 0xda,
+	0xc3, 0xb0, 0x67, 0x94, 0xaa, 0x98, 0x35, 0x0a, 0x21, 0xb4, 0x23, 0x1e, 0x34, 0x0e, 0x2d, 0xef,
+	0x82, 0xf6, 0xc5, 0x95, 0xa6, 0x28, 0x91, 0xb7, 0xa0, 0x14, 0x35, 0x8f, 0x65, 0xff, 0xac, 0x84,
+	0x70, 0x71, 0x3b, 0xf2, 0x2a, 0x40, 0x94, 0x06, 0x18, 0x0f, 0xdf, 0x0b, 0x2f, 0x89, 0xed, 0x20,
+	0xdc, 0xc8, 0xb3, 0x6f, 0xfd, 0x43, 0x10, 0x39, 0x4b, 0x98, 0x0a, 0x74, 0xde, 0x37, 0x95, 0xf6,
+	0x22, 0x4d, 0xe9, 0xbc, 0x1f, 0xf9, 0x59, 0xaf, 0x41, 0xc1, 0xf5, 0xec, 0x33, 0xdb, 0xb1, 0x06,
+	0xfc, 0xd2, 0x9b, 0x6f, 0x3b, 0x79, 0x09, 0xc4, 0x8b, 0x6f, 0xfd, 0x9f, 0x12, 0x50, 0x62, 0xa1,
+	0x78, 0x16, 0x97, 0x10, 0x19, 0xaf, 0x9f, 0xef, 0x4e, 0xfd, 0xff, 0xa1, 0xe8, 0x8e, 0xa8, 0x13,
+	0x71, 0x9d, 0x9e, 0x00, 0x1c, 0x6a, 0x4c, 0xd5, 0x22, 0xef, 0x43, 0x09, 0x87, 0x88, 0xf6, 0x95,
+	0x96, 0xcb, 0x73, 0x5b, 0xce, 0xd4, 0xc3, 0xb6, 0x3c, 0x2b, 0x53, 0x69, 0x9b, 0x9a, 0xdf, 0x76,
+	0xba, 0x1e, 0x7a, 0x16, 0x7d, 0xdb, 0x1f, 0x0d, 0xac, 0x2b, 0x96, 0x4b, 0x21, 0xf3, 0x48, 0x55,
+	0x98, 0x7e, 0x01, 0xa0, 0xb4, 0xd8, 0x02, 0x96, 0x72, 0x55, 0x0b, 0xef, 0xa0, 0xb2, 0x46, 0x04,
+	0x40, 0x2f, 0x04, 0x0b, 0x55, 0xf5, 0x41, 0xae, 0x02, 0x21, 0xf7, 0x60, 0xc9, 0x0e, 0xe8, 0x50,
+	0xcd, 0xce, 0x44, 0xda, 0x07, 0xf4, 0xca, 0x60, 0x08, 0xbd, 0x0d, 0x69, 0x01, 0x50, 0xaf, 0xa7,
+	0xe4, 0xd5, 0x02, 0x2f, 0xe2, 0xf8, 0x28, 0xe9, 0xb4, 0x59, 0x43, 0x94, 0x94, 0xb3, 0x61, 0x52,
+	0x3d, 0x1b, 0xea, 0x5d, 0xd8, 0x50, 0x0d, 0x3d, 0xbe, 0x82, 0xbd, 0x89, 0xa8, 0xcd, 0xa7, 0x1a,
+	0x94, 0x67, 0xe9, 0xde, 0x80, 0xc9, 0x79, 0x00, 0x4b, 0x7d, 0x2b, 0x4c, 0x95, 0x58, 0x9b, 0xde,
+	0xcc, 0x18, 0x1f, 0x56, 0x43, 0xff, 0x1e, 0x94, 0xa6, 0x31, 0x38, 0xa6, 0x96, 0xdc, 0x56, 0xe5,
+	0x20, 0x25, 0x8d, 0x18, 0x4c, 0x3c, 0xfa, 0x63, 0xed, 0x6a, 0xe1, 0x50, 0x25, 0x8d, 0x38, 0x50,
+	0xff, 0x23, 0x0d, 0x36, 0x44, 0x92, 0xf5, 0x8d, 0xbb, 0x05, 0xf3, 0xf7, 0x99, 0xe9, 0xc7, 0x09,
+	0x4b, 0xb3, 0x8f, 0x13, 0x0e, 0x20, 0x2f, 0x3b, 0xc3, 0x6e, 0xd7, 0xbe, 0x01, 0xe1, 0xce, 0x6e,
+	0x86, 0x46, 0x73, 0x91, 0x13, 0x50, 0xec, 0xc5, 0xca, 0xfa, 0xbf, 0x6b, 0x50, 0x9e, 0x95, 0xf0,
+	0x1a, 0x43, 0xd8, 0x50, 0x9f, 0xd5, 0x71, 0xe7, 0xe3, 0x6d, 0xe6, 0x3e, 0x2f, 0x20, 0x1a, 0x76,
+	0x48, 0x66, 0x65, 0x84, 0xad, 0x2b, 0x4d, 0x28, 0xc6, 0x91, 0x73, 0xce, 0x23, 0x6f, 0xc4, 0xcf,
+	0x57, 0x25, 0x55, 0x44, 0xd4, 0x86, 0x7a, 0x42, 0xf9, 0x3b, 0x0d, 0x56, 0x6b, 0x9e, 0xeb, 0xfb,
+	0x1f, 0x8e, 0xa9, 0x77, 0x25, 0xc7, 0x6d, 0x51, 0x92, 0x7e, 0xcc, 0x21, 0x49, 0x4c, 0x3b, 0x24,
+	0xb1, 0xe8, 0x58, 0xf2, 0xb3, 0xa2, 0x63, 0x4b, 0xb3, 0x09, 0xbc, 0x6f, 0x4f, 0xef, 0xe9, 0x73,
+	0xe2, 0x18, 0xe1, 0x86, 0xbe, 0x0f, 0x44, 0xed, 0xb8, 0x18, 0x8e, 0xaf, 0x28, 0x1b, 0xb1, 0x36,
+	0xbb, 0x32, 0xe6, 0x44, 0xc4, 0x50, 0xa3, 0x48, 0x87, 0x25, 0xe0, 0xb0, 0x6c, 0x20, 0xa2, 0x78,
+	0xff, 0x59, 0xe1, 0xeb, 0x3f, 0x80, 0xd2, 0xd0, 0x76, 0x4c, 0xea, 0xf4, 0x5d, 0xcf, 0x77, 0x3d,
+	0x25, 0xfc, 0x59, 0x1c, 0xda, 0x4e, 0x5d, 0x80, 0x9b, 0xe3, 0xa1, 0xfe, 0x0c, 0x0a, 0x8c, 0x9e,
+	0x84, 0xbd, 0xe0, 0x57, 0x09, 0x36, 0x20, 0x3d, 0x1a, 0x9f, 0x98, 0xf2, 0x44, 0x94, 0x65, 0x27,
+	0x22, 0xb1, 0xf7, 0x9d, 0xbb, 0xbe, 0xb4, 0x50, 0xec, 0x5b, 0x0f, 0xa0, 0x18, 0xc9, 0xcb, 0xfa,
+	0xf9, 0x0e, 0x00, 0x4f, 0x7a, 0x64, 0x29, 0x53, 0xca, 0xa5, 0x65, 0x5c, 0x1e, 0x23, 0xdb, 0x0b,
+	0x45, 0x7b, 0x04, 0x59, 0x29, 0x82, 0x9c, 0x89, 0xab, 0x61, 0x0b, 0xd9, 0x63, 0x23, 0xaa, 0x83,
+	0x21, 0x61, 0x85, 0x2d, 0xdb, 0x7a, 0x1f, 0x45, 0xa3, 0xc4, 0x79, 0xde, 0x0e, 0x29, 0xa8, 0x93,
+	0x28, 0x1c, 0x29, 0xf2, 0x58, 0x19, 0x13, 0x3e, 0x25, 0xd7, 0xa7, 0x5b, 0xcc, 0x38, 0x48, 0x6f,
+	0xc2, 0x32, 0x4f, 0xc1, 0x4e, 0x2e, 0x4a, 0xc1, 0xe6, 0x78, 0xbd, 0x0d, 0x05, 0x39, 0xb8, 0xf5,
+	0x4b, 0xea, 0x04, 0xb1, 0xb7, 0xbf, 0xda, 0xd4, 0xdb, 0x5f, 0x79, 0x57, 0x9e, 0x50, 0xee, 0xca,
+	0xe7, 0x38, 0x45, 0x0f, 0xff, 0x3a, 0x05, 0x2b, 0x53, 0x6f, 0x4a, 0xf0, 0x05, 0x56, 0xbb, 0x5b,
+	0xab, 0xd5, 0xdb, 0xed, 0xd2, 0x2b, 0xa4, 0x04, 0x79, 0xfe, 0x8c, 0xd9, 0xe4, 0xef, 0xb6, 0x34,
+	0x42, 0xa0, 0x58, 0x6b, 0x35, 0x9b, 0xf5, 0x5a, 0xc7, 0x34, 0xea, 0xfb, 0xdd, 0x76, 0xbd, 0x94,
+	0x20, 0x77, 0xe0, 0x76, 0xb3, 0xd5, 0x31, 0xeb, 0xcd, 0x56, 0xf7, 0xc9, 0x53, 0x13, 0x9d, 0x4d,
+	0x51, 0x3d, 0x49, 0x74, 0xb8, 0x8b, 0xe5, 0x67, 0x47, 0x66, 0xf5, 0xd0, 0xa8, 0x57, 0xf7, 0x3e,
+	0x36, 0xbb, 0x4d, 0xf1, 0xfc, 0x59, 0xd4, 0x59, 0x22, 0x15, 0x58, 0x17, 0x75, 0x90, 0xca, 0x7e,
+	0xab, 0xdb, 0xdc, 0x13, 0xb8, 0x65, 0xb2, 0x0d, 0x5b, 0x8d, 0xe6, 0x71, 0xb7, 0x63, 0xb6, 0xba,
+	0x1d, 0xfc, 0xc7, 0xf8, 0x7c, 0xd8, 0xad, 0x1e, 0x8a, 0x1a, 0x29, 0xb2, 0x0e, 0xa4, 0xf3, 0x7c,
+	0xa6, 0x65, 0x9a, 0xac, 0x42, 0xa1, 0xf3, 0xdc, 0x6c, 0x37, 0x9e, 0x34, 0x05, 0x28, 0x43, 0x36,
+	0xe0, 0xd6, 0xee, 0x61, 0xab, 0x76, 0x50, 0x7b, 0x5a, 0x6d, 0x34, 0xb1, 0x09, 0x7f, 0x68, 0x96,
+	0x45, 0xa1, 0x9e, 0x55, 0x0f, 0x1b, 0x7b, 0xd5, 0x4e, 0x5d, 0x54, 0x06, 0xb2, 0x09, 0x1b, 0xb5,
+	0x6a, 0x13, 0xe9, 0xb6, 0x3f, 0x6e, 0xd6, 0x4c, 0xd6, 0x50, 0x20, 0x73, 0x48, 0x49, 0x4a, 0xa1,
+	0x22, 0xf2, 0xe4, 0x36, 0xac, 0x0a, 0x59, 0x8e, 0x0f, 0xab, 0x1f, 0x0b, 0x70, 0x81, 0x14, 0x01,
+	0x3e, 0xaa, 0x1e, 0xca, 0x6a, 0x45, 0x72, 0x0b, 0x56, 0x90, 0x32, 0xd7, 0x08, 0x07, 0xae, 0x60,
+	0x5b, 0x41, 0x0c, 0xbb, 0x25, 0xc0, 0x25, 0x54, 0x8f, 0xd1, 0x6a, 0x75, 0xcc, 0x59, 0xdc, 0xaa,
+	0x10, 0x7e, 0xaf, 0x7b, 0x7c, 0xd8, 0xa8, 0x45, 0x9d, 0xbf, 0x85, 0x23, 0xd2, 0xae, 0x1b, 0xcf,
+	0x1a, 0xb5, 0xba, 0x18, 0x25, 0xa9, 0x97, 0x35, 0xe4, 0xd2, 0x79, 0xbe, 0x57, 0xed, 0x54, 0x55,
+	0xdd, 0xdc, 0xc6, 0x91, 0x46, 0x75, 0x1d, 0x4a, 0x1a, 0x77, 0x50, 0x01, 0x9d, 0xe7, 0xe6, 0x7e,
+	0xbd, 0x6e, 0x2a, 0x83, 0xcb, 0x91, 0x15, 0x14, 0x80, 0x8d, 0xb3, 0x42, 0x63, 0x8b, 0xac, 0x41,
+	0x69, 0xef, 0xb8, 0xd5, 0x36, 0x3f, 0xec, 0xd6, 0x0d, 0x29, 0xd6, 0x3d, 0xd4, 0x95, 0xf1, 0x51,
+	0xbb, 0xde, 0x31, 0x1b, 0x4d, 0xa6, 0x64, 0x81, 0xb8, 0xcf, 0x11, 0xd5, 0xda, 0xe1, 0x14, 0x42,
+	0x27, 0x65, 0x58, 0x7b, 0x52, 0x6d, 0xcf, 0xb2, 0x7d, 0x8d, 0x6c, 0x41, 0xb9, 0xf3, 0xdc, 0x7c,
+	0x56, 0x37, 0xda, 0x8d, 0x56, 0x73, 0xaa, 0xdd, 0xeb, 0xe4, 0x3e, 0xbc, 0x5a, 0x6b, 0x1d, 0x1d,
+	0x1f, 0x36, 0xaa, 0xcd, 0x5a, 0xdd, 0xac, 0x3d, 0xad, 0xd7, 0x0e, 0x18, 0x91, 0xea, 0xf1, 0xb1,
+	0xd1, 0x7a, 0x56, 0xdf, 0x2b, 0x7d, 0x09, 0xab, 0x54, 0x6b, 0xb5, 0x56, 0xb7, 0xd9, 0x31, 0x6b,
+	0xad, 0x66, 0xc7, 0xa8, 0xd6, 0x3a, 0x66, 0xbb, 0x53, 0xed, 0x74, 0xdb, 0x82, 0xca, 0x1b, 0xa8,
+	0x3b, 0xce, 0xa3, 0xb1, 0x8f, 0x4a, 0x45, 0x46, 0x1c, 0xf5, 0xe0, 0x21, 0x85, 0xd5, 0x99, 0x1f,
+	0x6c, 0x20, 0x79, 0xc8, 0x74, 0x9b, 0x7b, 0xf5, 0xfd, 0x46, 0xb3, 0x5e, 0x7a, 0x45, 0x7d, 0xc0,
+	0xa8, 0x61, 0x41, 0x4c, 0x93, 0x52, 0x02, 0x1f, 0xfe, 0xef, 0x77, 0x0d, 0x4e, 0xb1, 0x94, 0xc4,
+	0x62, 0xb8, 0x14, 0x4a, 0x4b, 0xca, 0xef, 0x00, 0x2c, 0x3f, 0x3c, 0x00, 0x88, 0x5e, 0xe5, 0x91,
+	0x0c, 0x2c, 0x35, 0x5b, 0x8c, 0x36, 0x40, 0xea, 0xb0, 0xbe, 0xf7, 0xa4, 0x8e, 0xeb, 0x10, 0xb9,
+	0x76, 0x9e, 0xb7, 0x1a, 0xcd, 0xfd, 0x56, 0x29, 0x81, 0xf3, 0x8b, 0x3f, 0xa1, 0x64, 0xe5, 0x24,
+	0xbe, 0xae, 0x3c, 0xae, 0xd7, 0x8d, 0x76, 0x69, 0xe9, 0xe1, 0x6f, 0x43, 0x31, 0x1e, 0x4e, 0x65,
+	0x04, 0xbb, 0x87, 0x87, 0xa5, 0x57, 0x70, 0xde, 0xb3, 0x01, 0xec, 0x3c, 0x35, 0xea, 0xed, 0xa7,
+	0xad, 0xc3, 0xbd, 0x92, 0x86, 0xa4, 0x18, 0xac, 0x7a, 0xd0, 0xae, 0x77, 0x78, 0xb7, 0x59, 0xd9,
+	0xa8, 0x76, 0xea, 0xa5, 0x24, 0xf2, 0x65, 0xc5, 0x76, 0x17, 0x7b, 0x8d, 0x3f, 0x66, 0x50, 0x35,
+	0x71, 0xaa, 0xd5, 0x71, 0xb5, 0x32, 0xe3, 0x70, 0x74, 0xd4, 0x6d, 0x36, 0x3a, 0x1f, 0x9b, 0xcf,
+	0x5a, 0x9d, 0x7a, 0x29, 0xf5, 0xf0, 0x5d, 0xc8, 0xab, 0x31, 0x25, 0x92, 0x86, 0x64, 0xed, 0xb8,
+	0xcb, 0xa5, 0x39, 0xaa, 0x1f, 0xb5, 0x8c, 0x8f, 0x4b, 0x1a, 0x76, 0x69, 0xaf, 0xd1, 0x3e, 0x28,
+	0x25, 0xf0, 0xeb, 0xf9, 0x7e, 0xbd, 0x5e, 0x4a, 0x3e, 0xfe, 0xcb, 0x0d, 0x48, 0x3d, 0x67, 0x26,
+	0x9d, 0x74, 0xa1, 0x14, 0x1d, 0x64, 0x77, 0xaf, 0xd8, 0x8b, 0x83, 0x82, 0xf4, 0x97, 0x59, 0x44,
+	0xbd, 0x32, 0x75, 0xaa, 0xd4, 0xf5, 0x9f, 0xfe, 0xeb, 0x7f, 0xfe, 0x71, 0x62, 0x4b, 0xdf, 0x78,
+	0x74, 0xf9, 0xce, 0x23, 0x9f, 0x35, 0x36, 0xd9, 0x83, 0x89, 0x93, 0x2b, 0xf6, 0x8a, 0xe1, 0x7d,
+	0xed, 0x21, 0xf9, 0x36, 0xa4, 0x8e, 0x5d, 0x3f, 0xe8, 0x4c, 0x48, 0xec, 0xd1, 0x6d, 0x65, 0x85,
+	0x6f, 0xa5, 0xe1, 0x8b, 0x4c, 0x7d, 0x9d, 0x11, 0x2b, 0xe9, 0x39, 0x24, 0x36, 0x72, 0xfd, 0xc0,
+	0x0c, 0x26, 0x48, 0x60, 0x17, 0x32, 0xcc, 0xb0, 0x57, 0x6b, 0x87, 0xbc, 0x3f, 0x61, 0x10, 0xb4,
+	0x12, 0x2f, 0xea, 0x65, 0x46, 0x81, 0xe8, 0x05, 0xa4, 0xf0, 0x43, 0x6c, 0x63, 0x5a, 0xbd, 0x01,
+	0xd2, 0x30, 0x61, 0x85, 0xd1, 0x50, 0x8e, 0x15, 0x6b, 0xf1, 0xa3, 0x0a, 0x3f, 0xac, 0x55, 0xe6,
+	0x42, 0xf5, 0x6d, 0x46, 0xb8, 0xa2, 0xdf, 0x8e, 0x08, 0x33, 0x31, 0x3d, 0x56, 0x09, 0x19, 0xfc,
+	0x18, 0x6e, 0x33, 0x06, 0x33, 0xbe, 0xf1, 0xe6, 0x5c, 0x5f, 0x9a, 0x6f, 0x66, 0x95, 0xad, 0xf9,
+	0x48, 0xe1, 0x4c, 0xbc, 0xc9, 0xb8, 0xde, 0xd7, 0xb7, 0x22, 0xae, 0x31, 0xbf, 0xd3, 0x44, 0x87,
+	0x1c, 0x99, 0xff, 0x04, 0x6e, 0xcd, 0x89, 0x6c, 0x91, 0xbb, 0xe2, 0x89, 0xfe, 0x82, 0x38, 0x5b,
+	0xe5, 0xde, 0x42, 0xbc, 0xe8, 0xc0, 0xeb, 0xac, 0x03, 0x77, 0xf5, 0x3b, 0xd8, 0x81, 0x33, 0x1a,
+	0x84, 0xaf, 0x3e, 0x42, 0x17, 0x12, 0xb9, 0x7f, 0x00, 0x69, 0x26, 0xfa, 0xcc, 0x08, 0xc7, 0x4a,
+	0xfa, 0x06, 0x23, 0xb6, 0xaa, 0xe7, 0x23, 0x69, 0xf8, 0xf8, 0x7e, 0x0f, 0x72, 0xca, 0xcf, 0x8c,
+	0x90, 0xf5, 0x99, 0xdf, 0x1d, 0xe1, 0xbd, 0xdd, 0x58, 0xf0, 0x7b, 0x24, 0xfa, 0x16, 0x23, 0xbc,
+	0xae, 0xaf, 0xca, 0x5e, 0x06, 0x13, 0xe1, 0x96, 0x23, 0xf5, 0x73, 0x28, 0xc6, 0x7f, 0x4c, 0x82,
+	0xb0, 0x7c, 0xdd, 0xb9, 0x3f, 0xcf, 0x51, 0xa9, 0xcc, 0x43, 0x09, 0x36, 0xf7, 0x18, 0x9b, 0x3b,
+	0xfa, 0x1a, 0xb2, 0xc1, 0x07, 0x43, 0xe6, 0x88, 0x57, 0xc2, 0x3c, 0x7d, 0xe4, 0x34, 0x80, 0x95,
+	0xa9, 0xdf, 0x43, 0x20, 0x95, 0xb9, 0x3f, 0x92, 0xc0, 0x79, 0x6d, 0xbe, 0xe0, 0x07, 0x14, 0xe2,
+	0x13, 0x0e, 0x65, 0x1a, 0xf2, 0x5a, 0x4c, 0x30, 0xc6, 0xed, 0xbb, 0x90, 0x53, 0x7e, 0x0f, 0x80,
+	0x6b, 0x6d, 0xf6, 0x87, 0x10, 0x2a, 0x1b, 0x33, 0x70, 0xc1, 0x61, 0x93, 0x71, 0xb8, 0xad, 0x97,
+	0x90, 0x03, 0x15, 0x15, 0xf0, 0xb1, 0x3d, 0x12, 0xff, 0x0e, 0x00, 0xd3, 0x34, 0xff, 0x55, 0x20,
+	0xc2, 0xc7, 0x51, 0xfd, 0x15, 0xa2, 0x4a, 0x4e, 0x81, 0xc5, 0x69, 0x89, 0x11, 0x18, 0x21, 0x06,
+	0x69, 0x35, 0x19, 0x2d, 0xf1, 0x64, 0x96, 0xac, 0x2a, 0x47, 0x15, 0x31, 0x4d, 0x66, 0x41, 0x7a,
+	0x85, 0x11, 0x5c, 0xd3, 0x57, 0x24, 0x41, 0xf1, 0x46, 0x18, 0xe9, 0xd9, 0x50, 0x8a, 0xe8, 0xc9,
+	0x47, 0xc5, 0x0a, 0x89, 0xd8, 0xe3, 0xdc, 0xca, 0x42, 0x8c, 0x7e, 0x9f, 0xf1, 0xd8, 0xd4, 0xd7,
+	0xa7, 0x78, 0x98, 0x7d, 0x46, 0x93, 0xeb, 0x18, 0x59, 0xf1, 0x97, 0xb8, 0xd7, 0x13, 0x60, 0x86,
+	0xb8, 0x78, 0xda, 0xaa, 0xc8, 0xf1, 0x4d, 0xc8, 0xa0, 0x1c, 0x2c, 0x4e, 0x96, 0x0b, 0x7f, 0x0b,
+	0xa0, 0xb1, 0x57, 0xc9, 0x86, 0x85, 0xb8, 0x41, 0x63, 0x7d, 0x44, 0x30, 0xb6, 0x36, 0xb8, 0x16,
+	0xb0, 0xb8, 0x7b, 0x25, 0x62, 0x60, 0x2b, 0x61, 0x43, 0x0e, 0x50, 0x29, 0xc5, 0x2c, 0x75, 0x48,
+	0x09, 0xed, 0x34, 0x8f, 0xab, 0xf1, 0x91, 0xba, 0x25, 0x69, 0x32, 0x77, 0x55, 0x6e, 0xbd, 0x6a,
+	0xd6, 0x78, 0x25, 0x56, 0x9a, 0x1d, 0xf9, 0x93, 0x5e, 0xb4, 0xf4, 0x1a, 0x50, 0x8c, 0xd1, 0x13,
+	0xa4, 0xe4, 0x93, 0xfa, 0x4a, 0xd4, 0x5f, 0x8e, 0x96, 0xe2, 0x12, 0x85, 0x1a, 0x7f, 0x83, 0x40,
+	0xba, 0x6c, 0x6d, 0xf1, 0x7c, 0x70, 0xb5, 0x5b, 0x21, 0xad, 0xf5, 0xd9, 0x7c, 0x71, 0xb6, 0xa9,
+	0xcc, 0x18, 0x07, 0xff, 0xca, 0x8f, 0x7a, 0x78, 0xc6, 0x7e, 0xc0, 0x69, 0x3a, 0xe3, 0xbb, 0x2c,
+	0xac, 0xf2, 0x4c, 0x6e, 0x79, 0xe5, 0xd6, 0x0c, 0x66, 0xec, 0xcf, 0xaa, 0x36, 0x4c, 0xed, 0x8e,
+	0x18, 0xbd, 0x09, 0xd9, 0x27, 0x34, 0x68, 0xd2, 0xa0, 0x6b, 0x1c, 0x4e, 0xf5, 0x9c, 0x9d, 0xf1,
+	0x79, 0xc2, 0xb6, 0xfe, 0x0a, 0x39, 0x00, 0x88, 0x36, 0xe1, 0xcf, 0xda, 0x7e, 0xef, 0x32, 0xce,
+	0x65, 0xfd, 0xd6, 0xd4, 0xf6, 0xeb, 0x9b, 0x97, 0x8f, 0x91, 0x2b, 0xfe, 0x0e, 0xd1, 0xdc, 0x30,
+	0x35, 0x61, 0x0f, 0x8a, 0x5e, 0x14, 0xd5, 0xaf, 0xdc, 0x7f, 0x41, 0x0d, 0x61, 0x42, 0x62, 0x82,
+	0x8f, 0x3c, 0x4a, 0x27, 0xb4, 0x67, 0x2a, 0xdd, 0xc0, 0x2e, 0x3c, 0x81, 0x62, 0x3c, 0xad, 0x94,
+	0x9b, 0xdf, 0xb9, 0xf9, 0xab, 0x95, 0xca, 0x3c, 0x14, 0x67, 0x46, 0x9e, 0xc1, 0xad, 0x39, 0xe9,
+	0x97, 0x7c, 0x8f, 0x5b, 0x9c, 0x52, 0x5a, 0xb9, 0xb7, 0x10, 0x2f, 0xe8, 0xb6, 0x81, 0x84, 0xe8,
+	0x30, 0xc1, 0x91, 0xbc, 0x1a, 0x6b, 0x36, 0x9d, 0x6b, 0x59, 0xb9, 0xbb, 0x08, 0x2d, 0x88, 0x7e,
+	0x07, 0x56, 0xa6, 0xf2, 0x05, 0x49, 0x28, 0xdb, 0x6c, 0xd2, 0x63, 0x65, 0x73, 0x2e, 0x4e, 0xd0,
+	0x3a, 0x82, 0x92, 0x44, 0xc9, 0x7c, 0x37, 0x12, 0x6b, 0x30, 0x95, 0x18, 0x58, 0xd9, 0x9a, 0x8f,
+	0x8c, 0x93, 0x53, 0xf3, 0xd7, 0x22, 0x72, 0x73, 0x12, 0xe8, 0x2a, 0x5b, 0xf3, 0x91, 0x82, 0xdc,
+	0x37, 0x62, 0x49, 0x5e, 0xb7, 0xa7, 0x72, 0xc1, 0x04, 0x89, 0xf5, 0x69, 0xb0, 0x68, 0x6c, 0x41,
+	0x31, 0x72, 0x3f, 0x76, 0xaf, 0xaa, 0x07, 0x9c, 0xc0, 0xcc, 0x8d, 0x67, 0x65, 0x7d, 0x1a, 0xbc,
+	0x68, 0x9b, 0x94, 0x0e, 0xca, 0xc9, 0x95, 0x69, 0x31, 0x3b, 0x79, 0xc9, 0x5d, 0xa3, 0xa9, 0xd8,
+	0x18, 0x97, 0x78, 0x41, 0xa0, 0xb1, 0xb2, 0x35, 0x1f, 0xb9, 0xd0, 0x29, 0xe2, 0x35, 0xe3, 0x4e,
+	0x51, 0x13, 0xd2, 0x62, 0xf1, 0x90, 0xb9, 0x77, 0x49, 0x95, 0xdb, 0x53, 0x50, 0x41, 0x3d, 0xee,
+	0x04, 0xf3, 0x35, 0xf5, 0xbe, 0xf6, 0xf0, 0x24, 0xc5, 0x7e, 0x0d, 0xf3, 0xab, 0xff, 0x3b, 0x00,
+	0x30, 0xad, 0xe8, 0x03, 0x51, 0x53, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -6952,6 +7893,16 @@ type XchainClient interface {
 	// QueryTx query Transaction by TxStatus,
 	// Bcname and Txid are required for this
 	QueryTx(ctx context.Context, in *TxStatus, opts ...grpc.CallOption) (*TxStatus, error)
+	// GetTxStatus 查询交易的生命周期状态
+	GetTxStatus(ctx context.Context, in *GetTxStatusRequest, opts ...grpc.CallOption) (*GetTxStatusResponse, error)
+	// ListPendingTxs 查询未确认交易
+	ListPendingTxs(ctx context.Context, in *ListPendingTxsRequest, opts ...grpc.CallOption) (*ListPendingTxsResponse, error)
+	// GetMempoolStats 查询未确认交易池的统计信息
+	GetMempoolStats(ctx context.Context, in *GetMempoolStatsRequest, opts ...grpc.CallOption) (*GetMempoolStatsResponse, error)
+	// EstimateFee 预执行并参考最近区块估算手续费
+	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
+	// GetTxProof 获取交易的merkle包含证明
+	GetTxProof(ctx context.Context, in *TxProofRequest, opts ...grpc.CallOption) (*TxProof, error)
 	// GetBalance get balance of an address,
 	// Address is required for this
 	GetBalance(ctx context.Context, in *AddressStatus, opts ...grpc.CallOption) (*AddressStatus, error)
@@ -7074,6 +8025,51 @@ func (c *xchainClient) QueryTx(ctx context.Context, in *TxStatus, opts ...grpc.C
 	return out, nil
 }
 
+func (c *xchainClient) GetTxStatus(ctx context.Context, in *GetTxStatusRequest, opts ...grpc.CallOption) (*GetTxStatusResponse, error) {
+	out := new(GetTxStatusResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetTxStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) ListPendingTxs(ctx context.Context, in *ListPendingTxsRequest, opts ...grpc.CallOption) (*ListPendingTxsResponse, error) {
+	out := new(ListPendingTxsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/ListPendingTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) GetMempoolStats(ctx context.Context, in *GetMempoolStatsRequest, opts ...grpc.CallOption) (*GetMempoolStatsResponse, error) {
+	out := new(GetMempoolStatsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetMempoolStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error) {
+	out := new(EstimateFeeResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xchainClient) GetTxProof(ctx context.Context, in *TxProofRequest, opts ...grpc.CallOption) (*TxProof, error) {
+	out := new(TxProof)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetTxProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *xchainClient) GetBalance(ctx context.Context, in *AddressStatus, opts ...grpc.CallOption) (*AddressStatus, error) {
 	out := new(AddressStatus)
 	err := c.cc.Invoke(ctx, "/pb.Xchain/GetBalance", in, out, opts...)
@@ -7285,6 +8281,16 @@ type XchainServer interface {
 	// QueryTx query Transaction by TxStatus,
 	// Bcname and Txid are required for this
 	QueryTx(context.Context, *TxStatus) (*TxStatus, error)
+	// GetTxStatus 查询交易的生命周期状态
+	GetTxStatus(context.Context, *GetTxStatusRequest) (*GetTxStatusResponse, error)
+	// ListPendingTxs 查询未确认交易
+	ListPendingTxs(context.Context, *ListPendingTxsRequest) (*ListPendingTxsResponse, error)
+	// GetMempoolStats 查询未确认交易池的统计信息
+	GetMempoolStats(context.Context, *GetMempoolStatsRequest) (*GetMempoolStatsResponse, error)
+	// EstimateFee 预执行并参考最近区块估算手续费
+	EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error)
+	// GetTxProof 获取交易的merkle包含证明
+	GetTxProof(context.Context, *TxProofRequest) (*TxProof, error)
 	// GetBalance get balance of an address,
 	// Address is required for this
 	GetBalance(context.Context, *AddressStatus) (*AddressStatus, error)
@@ -7361,6 +8367,21 @@ func (*UnimplementedXchainServer) GetAccountContracts(ctx context.Context, req *
 func (*UnimplementedXchainServer) QueryTx(ctx context.Context, req *TxStatus) (*TxStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryTx not implemented")
 }
+func (*UnimplementedXchainServer) GetTxStatus(ctx context.Context, req *GetTxStatusRequest) (*GetTxStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxStatus not implemented")
+}
+func (*UnimplementedXchainServer) ListPendingTxs(ctx context.Context, req *ListPendingTxsRequest) (*ListPendingTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingTxs not implemented")
+}
+func (*UnimplementedXchainServer) GetMempoolStats(ctx context.Context, req *GetMempoolStatsRequest) (*GetMempoolStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMempoolStats not implemented")
+}
+func (*UnimplementedXchainServer) EstimateFee(ctx context.Context, req *EstimateFeeRequest) (*EstimateFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateFee not implemented")
+}
+func (*UnimplementedXchainServer) GetTxProof(ctx context.Context, req *TxProofRequest) (*TxProof, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxProof not implemented")
+}
 func (*UnimplementedXchainServer) GetBalance(ctx context.Context, req *AddressStatus) (*AddressStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
 }
@@ -7558,6 +8579,96 @@ func _Xchain_QueryTx_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Xchain_GetTxStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).GetTxStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/GetTxStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).GetTxStatus(ctx, req.(*GetTxStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_ListPendingTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).ListPendingTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/ListPendingTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).ListPendingTxs(ctx, req.(*ListPendingTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_GetMempoolStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMempoolStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).GetMempoolStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/GetMempoolStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).GetMempoolStats(ctx, req.(*GetMempoolStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).EstimateFee(ctx, req.(*EstimateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xchain_GetTxProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).GetTxProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/GetTxProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).GetTxProof(ctx, req.(*TxProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Xchain_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddressStatus)
 	if err := dec(in); err != nil {
@@ -7986,6 +9097,26 @@ var _Xchain_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryTx",
 			Handler:    _Xchain_QueryTx_Handler,
 		},
+		{
+			MethodName: "GetTxStatus",
+			Handler:    _Xchain_GetTxStatus_Handler,
+		},
+		{
+			MethodName: "ListPendingTxs",
+			Handler:    _Xchain_ListPendingTxs_Handler,
+		},
+		{
+			MethodName: "GetMempoolStats",
+			Handler:    _Xchain_GetMempoolStats_Handler,
+		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _Xchain_EstimateFee_Handler,
+		},
+		{
+			MethodName: "GetTxProof",
+			Handler:    _Xchain_GetTxProof_Handler,
+		},
 		{
 			MethodName: "GetBalance",
 			Handler:    _Xchain_GetBalance_Handler,
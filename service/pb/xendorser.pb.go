@@ -168,36 +168,522 @@ func (m *EndorserResponse) GetResponseData() []byte {
 	return nil
 }
 
+// 背书服务信息请求
+type EndorserInfoRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	BcName               string   `protobuf:"bytes,2,opt,name=BcName,proto3" json:"BcName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EndorserInfoRequest) Reset()         { *m = EndorserInfoRequest{} }
+func (m *EndorserInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*EndorserInfoRequest) ProtoMessage()    {}
+func (*EndorserInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eeaf870ebd3b57e1, []int{2}
+}
+
+func (m *EndorserInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EndorserInfoRequest.Unmarshal(m, b)
+}
+func (m *EndorserInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EndorserInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *EndorserInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndorserInfoRequest.Merge(m, src)
+}
+func (m *EndorserInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_EndorserInfoRequest.Size(m)
+}
+func (m *EndorserInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndorserInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndorserInfoRequest proto.InternalMessageInfo
+
+func (m *EndorserInfoRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *EndorserInfoRequest) GetBcName() string {
+	if m != nil {
+		return m.BcName
+	}
+	return ""
+}
+
+type EndorserInfoResponse struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	BcName               string   `protobuf:"bytes,2,opt,name=BcName,proto3" json:"BcName,omitempty"`
+	EndorserAddress      string   `protobuf:"bytes,3,opt,name=EndorserAddress,proto3" json:"EndorserAddress,omitempty"`
+	PublicKey            string   `protobuf:"bytes,4,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EndorserInfoResponse) Reset()         { *m = EndorserInfoResponse{} }
+func (m *EndorserInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*EndorserInfoResponse) ProtoMessage()    {}
+func (*EndorserInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eeaf870ebd3b57e1, []int{3}
+}
+
+func (m *EndorserInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EndorserInfoResponse.Unmarshal(m, b)
+}
+func (m *EndorserInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EndorserInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *EndorserInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndorserInfoResponse.Merge(m, src)
+}
+func (m *EndorserInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_EndorserInfoResponse.Size(m)
+}
+func (m *EndorserInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndorserInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndorserInfoResponse proto.InternalMessageInfo
+
+func (m *EndorserInfoResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *EndorserInfoResponse) GetBcName() string {
+	if m != nil {
+		return m.BcName
+	}
+	return ""
+}
+
+func (m *EndorserInfoResponse) GetEndorserAddress() string {
+	if m != nil {
+		return m.EndorserAddress
+	}
+	return ""
+}
+
+func (m *EndorserInfoResponse) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+// 背书记录，背书服务每次签名都会保存
+type EndorsementRecord struct {
+	Seq                  uint64         `protobuf:"varint,1,opt,name=Seq,proto3" json:"Seq,omitempty"`
+	BcName               string         `protobuf:"bytes,2,opt,name=BcName,proto3" json:"BcName,omitempty"`
+	RequestName          string         `protobuf:"bytes,3,opt,name=RequestName,proto3" json:"RequestName,omitempty"`
+	Txid                 []byte         `protobuf:"bytes,4,opt,name=Txid,proto3" json:"Txid,omitempty"`
+	RequestHash          []byte         `protobuf:"bytes,5,opt,name=RequestHash,proto3" json:"RequestHash,omitempty"`
+	ResponseHash         []byte         `protobuf:"bytes,6,opt,name=ResponseHash,proto3" json:"ResponseHash,omitempty"`
+	Digest               []byte         `protobuf:"bytes,7,opt,name=Digest,proto3" json:"Digest,omitempty"`
+	EndorserAddress      string         `protobuf:"bytes,8,opt,name=EndorserAddress,proto3" json:"EndorserAddress,omitempty"`
+	EndorserSign         *SignatureInfo `protobuf:"bytes,9,opt,name=EndorserSign,proto3" json:"EndorserSign,omitempty"`
+	ClientIp             string         `protobuf:"bytes,10,opt,name=ClientIp,proto3" json:"ClientIp,omitempty"`
+	Logid                string         `protobuf:"bytes,11,opt,name=Logid,proto3" json:"Logid,omitempty"`
+	Timestamp            int64          `protobuf:"varint,12,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *EndorsementRecord) Reset()         { *m = EndorsementRecord{} }
+func (m *EndorsementRecord) String() string { return proto.CompactTextString(m) }
+func (*EndorsementRecord) ProtoMessage()    {}
+func (*EndorsementRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eeaf870ebd3b57e1, []int{4}
+}
+
+func (m *EndorsementRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EndorsementRecord.Unmarshal(m, b)
+}
+func (m *EndorsementRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EndorsementRecord.Marshal(b, m, deterministic)
+}
+func (m *EndorsementRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndorsementRecord.Merge(m, src)
+}
+func (m *EndorsementRecord) XXX_Size() int {
+	return xxx_messageInfo_EndorsementRecord.Size(m)
+}
+func (m *EndorsementRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndorsementRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndorsementRecord proto.InternalMessageInfo
+
+func (m *EndorsementRecord) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *EndorsementRecord) GetBcName() string {
+	if m != nil {
+		return m.BcName
+	}
+	return ""
+}
+
+func (m *EndorsementRecord) GetRequestName() string {
+	if m != nil {
+		return m.RequestName
+	}
+	return ""
+}
+
+func (m *EndorsementRecord) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+func (m *EndorsementRecord) GetRequestHash() []byte {
+	if m != nil {
+		return m.RequestHash
+	}
+	return nil
+}
+
+func (m *EndorsementRecord) GetResponseHash() []byte {
+	if m != nil {
+		return m.ResponseHash
+	}
+	return nil
+}
+
+func (m *EndorsementRecord) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+func (m *EndorsementRecord) GetEndorserAddress() string {
+	if m != nil {
+		return m.EndorserAddress
+	}
+	return ""
+}
+
+func (m *EndorsementRecord) GetEndorserSign() *SignatureInfo {
+	if m != nil {
+		return m.EndorserSign
+	}
+	return nil
+}
+
+func (m *EndorsementRecord) GetClientIp() string {
+	if m != nil {
+		return m.ClientIp
+	}
+	return ""
+}
+
+func (m *EndorsementRecord) GetLogid() string {
+	if m != nil {
+		return m.Logid
+	}
+	return ""
+}
+
+func (m *EndorsementRecord) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// 背书记录查询请求，Txid和RequestHash至少指定一个
+type QueryEndorsementRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	BcName               string   `protobuf:"bytes,2,opt,name=BcName,proto3" json:"BcName,omitempty"`
+	Txid                 []byte   `protobuf:"bytes,3,opt,name=Txid,proto3" json:"Txid,omitempty"`
+	RequestHash          []byte   `protobuf:"bytes,4,opt,name=RequestHash,proto3" json:"RequestHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryEndorsementRequest) Reset()         { *m = QueryEndorsementRequest{} }
+func (m *QueryEndorsementRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEndorsementRequest) ProtoMessage()    {}
+func (*QueryEndorsementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eeaf870ebd3b57e1, []int{5}
+}
+
+func (m *QueryEndorsementRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryEndorsementRequest.Unmarshal(m, b)
+}
+func (m *QueryEndorsementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryEndorsementRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryEndorsementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEndorsementRequest.Merge(m, src)
+}
+func (m *QueryEndorsementRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryEndorsementRequest.Size(m)
+}
+func (m *QueryEndorsementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEndorsementRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEndorsementRequest proto.InternalMessageInfo
+
+func (m *QueryEndorsementRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *QueryEndorsementRequest) GetBcName() string {
+	if m != nil {
+		return m.BcName
+	}
+	return ""
+}
+
+func (m *QueryEndorsementRequest) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+func (m *QueryEndorsementRequest) GetRequestHash() []byte {
+	if m != nil {
+		return m.RequestHash
+	}
+	return nil
+}
+
+type QueryEndorsementResponse struct {
+	Header               *Header              `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Records              []*EndorsementRecord `protobuf:"bytes,2,rep,name=Records,proto3" json:"Records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *QueryEndorsementResponse) Reset()         { *m = QueryEndorsementResponse{} }
+func (m *QueryEndorsementResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEndorsementResponse) ProtoMessage()    {}
+func (*QueryEndorsementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eeaf870ebd3b57e1, []int{6}
+}
+
+func (m *QueryEndorsementResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryEndorsementResponse.Unmarshal(m, b)
+}
+func (m *QueryEndorsementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryEndorsementResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryEndorsementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEndorsementResponse.Merge(m, src)
+}
+func (m *QueryEndorsementResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryEndorsementResponse.Size(m)
+}
+func (m *QueryEndorsementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEndorsementResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEndorsementResponse proto.InternalMessageInfo
+
+func (m *QueryEndorsementResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *QueryEndorsementResponse) GetRecords() []*EndorsementRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+// TxQueryWithProof的响应，可据此校验交易是否被区块包含
+type TxQueryProof struct {
+	Tx                   *Transaction      `protobuf:"bytes,1,opt,name=Tx,proto3" json:"Tx,omitempty"`
+	Blockid              []byte            `protobuf:"bytes,2,opt,name=Blockid,proto3" json:"Blockid,omitempty"`
+	Height               int64             `protobuf:"varint,3,opt,name=Height,proto3" json:"Height,omitempty"`
+	MerkleRoot           []byte            `protobuf:"bytes,4,opt,name=MerkleRoot,proto3" json:"MerkleRoot,omitempty"`
+	TxIndex              int64             `protobuf:"varint,5,opt,name=TxIndex,proto3" json:"TxIndex,omitempty"`
+	MerklePath           [][]byte          `protobuf:"bytes,6,rep,name=MerklePath,proto3" json:"MerklePath,omitempty"`
+	Status               TransactionStatus `protobuf:"varint,7,opt,name=Status,proto3,enum=pb.TransactionStatus" json:"Status,omitempty"`
+	Distance             int64             `protobuf:"varint,8,opt,name=Distance,proto3" json:"Distance,omitempty"`
+	IsTrunkTip           bool              `protobuf:"varint,9,opt,name=IsTrunkTip,proto3" json:"IsTrunkTip,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *TxQueryProof) Reset()         { *m = TxQueryProof{} }
+func (m *TxQueryProof) String() string { return proto.CompactTextString(m) }
+func (*TxQueryProof) ProtoMessage()    {}
+func (*TxQueryProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eeaf870ebd3b57e1, []int{7}
+}
+
+func (m *TxQueryProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxQueryProof.Unmarshal(m, b)
+}
+func (m *TxQueryProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxQueryProof.Marshal(b, m, deterministic)
+}
+func (m *TxQueryProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxQueryProof.Merge(m, src)
+}
+func (m *TxQueryProof) XXX_Size() int {
+	return xxx_messageInfo_TxQueryProof.Size(m)
+}
+func (m *TxQueryProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxQueryProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxQueryProof proto.InternalMessageInfo
+
+func (m *TxQueryProof) GetTx() *Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *TxQueryProof) GetBlockid() []byte {
+	if m != nil {
+		return m.Blockid
+	}
+	return nil
+}
+
+func (m *TxQueryProof) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TxQueryProof) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
+}
+
+func (m *TxQueryProof) GetTxIndex() int64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *TxQueryProof) GetMerklePath() [][]byte {
+	if m != nil {
+		return m.MerklePath
+	}
+	return nil
+}
+
+func (m *TxQueryProof) GetStatus() TransactionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TransactionStatus_UNDEFINE
+}
+
+func (m *TxQueryProof) GetDistance() int64 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *TxQueryProof) GetIsTrunkTip() bool {
+	if m != nil {
+		return m.IsTrunkTip
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*EndorserRequest)(nil), "pb.EndorserRequest")
 	proto.RegisterType((*EndorserResponse)(nil), "pb.EndorserResponse")
+	proto.RegisterType((*EndorserInfoRequest)(nil), "pb.EndorserInfoRequest")
+	proto.RegisterType((*EndorserInfoResponse)(nil), "pb.EndorserInfoResponse")
+	proto.RegisterType((*EndorsementRecord)(nil), "pb.EndorsementRecord")
+	proto.RegisterType((*QueryEndorsementRequest)(nil), "pb.QueryEndorsementRequest")
+	proto.RegisterType((*QueryEndorsementResponse)(nil), "pb.QueryEndorsementResponse")
+	proto.RegisterType((*TxQueryProof)(nil), "pb.TxQueryProof")
 }
 
 func init() { proto.RegisterFile("xendorser.proto", fileDescriptor_eeaf870ebd3b57e1) }
 
 var fileDescriptor_eeaf870ebd3b57e1 = []byte{
-	// 323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0x4e, 0xc3, 0x30,
-	0x10, 0xc6, 0xe5, 0x16, 0x2a, 0xd5, 0x8d, 0xd4, 0x62, 0xfe, 0x28, 0x2a, 0x0c, 0x21, 0x53, 0xc4,
-	0xd0, 0x88, 0x22, 0x16, 0x36, 0xfe, 0x0a, 0x16, 0x86, 0x80, 0xd8, 0xaf, 0xc9, 0xd1, 0x46, 0x0a,
-	0x76, 0xb0, 0x5d, 0xd4, 0x99, 0x57, 0xe0, 0x55, 0x78, 0x13, 0x1e, 0x80, 0x85, 0x07, 0x41, 0x4e,
-	0x5d, 0xe2, 0x74, 0x62, 0xbc, 0xdf, 0x77, 0xfa, 0xee, 0x3b, 0x9f, 0x69, 0x7f, 0x81, 0x3c, 0x13,
-	0x52, 0xa1, 0x1c, 0x95, 0x52, 0x68, 0xc1, 0x5a, 0xe5, 0x64, 0xe8, 0x2d, 0xd2, 0x19, 0xe4, 0x7c,
-	0x49, 0x86, 0x07, 0x53, 0x21, 0xa6, 0x05, 0xc6, 0x50, 0xe6, 0x31, 0x70, 0x2e, 0x34, 0xe8, 0x5c,
-	0x70, 0xb5, 0x54, 0xc3, 0x4f, 0x42, 0xfb, 0xd7, 0xd6, 0x22, 0xc1, 0xd7, 0x39, 0x2a, 0xcd, 0x42,
-	0xda, 0x99, 0x21, 0x64, 0x28, 0x7d, 0x12, 0x90, 0xa8, 0x37, 0xa6, 0xa3, 0x72, 0x32, 0xba, 0xad,
-	0x48, 0x62, 0x15, 0x16, 0xd0, 0x9e, 0x6d, 0xbf, 0x87, 0x17, 0xf4, 0x5b, 0x01, 0x89, 0xba, 0x89,
-	0x8b, 0xd8, 0x1e, 0xed, 0x5c, 0xa4, 0x95, 0xd8, 0xae, 0x44, 0x5b, 0xb1, 0x43, 0xda, 0xbe, 0x41,
-	0xf4, 0x37, 0x2a, 0xeb, 0xbe, 0xb1, 0x7e, 0x94, 0xc0, 0x15, 0xa4, 0x26, 0x56, 0x62, 0x34, 0xc7,
-	0xfc, 0x0a, 0x34, 0xf8, 0x9b, 0x01, 0x89, 0xbc, 0xc4, 0x45, 0xe1, 0x37, 0xa1, 0x83, 0x3a, 0xb6,
-	0x2a, 0x05, 0x57, 0xf8, 0xaf, 0xdc, 0x21, 0xf5, 0x56, 0xfd, 0x4e, 0xf0, 0x06, 0x63, 0x51, 0xfd,
-	0x24, 0xe7, 0x59, 0x26, 0x51, 0x29, 0xbb, 0xc2, 0x3a, 0x66, 0xa7, 0xd4, 0x5b, 0xa1, 0x87, 0x7c,
-	0xca, 0xed, 0x52, 0x5b, 0x66, 0xae, 0xa9, 0x41, 0xcf, 0x25, 0xde, 0xf1, 0x67, 0x91, 0x34, 0xda,
-	0xdc, 0x10, 0xce, 0x82, 0x0d, 0x36, 0x4e, 0x69, 0xf7, 0xef, 0xb6, 0xec, 0xa9, 0x9e, 0x73, 0x09,
-	0x45, 0xc1, 0xb6, 0xcd, 0x84, 0xb5, 0xb3, 0x0d, 0x77, 0x9a, 0x70, 0xe9, 0x15, 0xee, 0xbf, 0x7f,
-	0xfd, 0x7c, 0xb4, 0x76, 0xc3, 0x41, 0xfc, 0x76, 0x1c, 0xaf, 0x0c, 0x53, 0x28, 0x8a, 0x33, 0x72,
-	0x34, 0xe9, 0x54, 0x9f, 0xe0, 0xe4, 0x37, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x40, 0x0c, 0x04, 0x47,
-	0x02, 0x00, 0x00,
+	// 742 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xc1, 0x6e, 0xda, 0x4a,
+	0x14, 0x95, 0x6d, 0x42, 0xc2, 0xc5, 0x7a, 0x90, 0x49, 0x48, 0x2c, 0x12, 0xbd, 0xc7, 0xf3, 0x0a,
+	0x55, 0x6a, 0x50, 0xa9, 0xba, 0xe9, 0xae, 0x49, 0xda, 0x06, 0xb5, 0xa9, 0x92, 0x89, 0xd5, 0x6d,
+	0x34, 0xd8, 0x03, 0x58, 0x31, 0x1e, 0xc7, 0x1e, 0x2a, 0x67, 0x9b, 0x6d, 0x97, 0xdd, 0xe5, 0x03,
+	0xfa, 0x05, 0xfd, 0x93, 0x7e, 0x40, 0x37, 0xfd, 0x90, 0x6a, 0x06, 0x1b, 0x0f, 0x10, 0xa4, 0xa0,
+	0xee, 0xb8, 0xe7, 0xde, 0x39, 0xf7, 0xce, 0xb9, 0x07, 0x0f, 0xd4, 0x52, 0x1a, 0x7a, 0x2c, 0x4e,
+	0x68, 0x7c, 0x14, 0xc5, 0x8c, 0x33, 0xa4, 0x47, 0xfd, 0xa6, 0x99, 0xba, 0x23, 0xe2, 0x87, 0x53,
+	0xa4, 0x79, 0x38, 0x64, 0x6c, 0x18, 0xd0, 0x0e, 0x89, 0xfc, 0x0e, 0x09, 0x43, 0xc6, 0x09, 0xf7,
+	0x59, 0x98, 0x4c, 0xb3, 0xf6, 0x0f, 0x0d, 0x6a, 0x6f, 0x33, 0x0a, 0x4c, 0x6f, 0x27, 0x34, 0xe1,
+	0xc8, 0x86, 0xf2, 0x88, 0x12, 0x8f, 0xc6, 0x96, 0xd6, 0xd2, 0xda, 0xd5, 0x2e, 0x1c, 0x45, 0xfd,
+	0xa3, 0x33, 0x89, 0xe0, 0x2c, 0x83, 0x5a, 0x50, 0xcd, 0xca, 0x3f, 0x91, 0x31, 0xb5, 0xf4, 0x96,
+	0xd6, 0xae, 0x60, 0x15, 0x42, 0x7b, 0x50, 0x3e, 0x76, 0x65, 0xd2, 0x90, 0xc9, 0x2c, 0x42, 0xff,
+	0x83, 0xf1, 0x8e, 0x52, 0xab, 0x24, 0xa9, 0x6b, 0x82, 0xda, 0x89, 0x49, 0x98, 0x10, 0x57, 0x8c,
+	0x85, 0x45, 0x4e, 0x21, 0x3f, 0x25, 0x9c, 0x58, 0x1b, 0x2d, 0xad, 0x6d, 0x62, 0x15, 0xb2, 0x7f,
+	0x69, 0x50, 0x2f, 0xc6, 0x4e, 0x22, 0x16, 0x26, 0xf4, 0x49, 0x73, 0xdb, 0x60, 0xe6, 0xf5, 0xca,
+	0xe0, 0x73, 0x18, 0x6a, 0x17, 0x92, 0xbc, 0xf1, 0xbc, 0x98, 0x26, 0x49, 0x76, 0x85, 0x45, 0x18,
+	0xbd, 0x02, 0x33, 0x87, 0xae, 0xfc, 0x61, 0x98, 0x5d, 0x6a, 0x5b, 0xf4, 0x15, 0x31, 0xe1, 0x93,
+	0x98, 0xf6, 0xc2, 0x01, 0xc3, 0x73, 0x65, 0xea, 0x10, 0xca, 0x05, 0xe7, 0x30, 0xfb, 0x12, 0x76,
+	0xf2, 0x33, 0x92, 0x61, 0x8d, 0xdd, 0x14, 0xca, 0xeb, 0xaa, 0xf2, 0xf6, 0x83, 0x06, 0xbb, 0xf3,
+	0x9c, 0x6b, 0x08, 0xb7, 0x82, 0x74, 0x0d, 0xb1, 0x0e, 0xa1, 0x72, 0x31, 0xe9, 0x07, 0xbe, 0xfb,
+	0x81, 0xde, 0x49, 0xa5, 0x2a, 0xb8, 0x00, 0xec, 0x7b, 0x03, 0xb6, 0xb3, 0x13, 0x63, 0x1a, 0x72,
+	0x4c, 0x5d, 0x16, 0x7b, 0xa8, 0x0e, 0xc6, 0x15, 0xbd, 0x95, 0x63, 0x95, 0xb0, 0xf8, 0xb9, 0x72,
+	0x8e, 0x05, 0x43, 0x1a, 0xcb, 0x86, 0x44, 0x50, 0x72, 0x52, 0xdf, 0x93, 0xad, 0x4d, 0x2c, 0x7f,
+	0x2b, 0xa7, 0xce, 0x48, 0x32, 0x5a, 0x70, 0x9a, 0x80, 0xd4, 0x5d, 0xc9, 0x92, 0xf2, 0xfc, 0xae,
+	0x64, 0xcd, 0x1e, 0x94, 0x4f, 0xfd, 0x21, 0x4d, 0xb8, 0xb5, 0x29, 0xb3, 0x59, 0xf4, 0x98, 0x36,
+	0x5b, 0x4f, 0x33, 0x52, 0xe5, 0x69, 0x46, 0x6a, 0xc2, 0xd6, 0x49, 0xe0, 0xd3, 0x90, 0xf7, 0x22,
+	0x0b, 0x24, 0xf3, 0x2c, 0x46, 0xbb, 0xb0, 0xf1, 0x91, 0x0d, 0x7d, 0xcf, 0xaa, 0xca, 0xc4, 0x34,
+	0x10, 0x4b, 0x70, 0xfc, 0x31, 0x4d, 0x38, 0x19, 0x47, 0x96, 0xd9, 0xd2, 0xda, 0x06, 0x2e, 0x00,
+	0xfb, 0xab, 0x06, 0xfb, 0x97, 0x13, 0x1a, 0xdf, 0xcd, 0x6d, 0xe2, 0xaf, 0x9d, 0x37, 0x93, 0xde,
+	0x58, 0x2d, 0x7d, 0x69, 0x49, 0x7a, 0x9b, 0x81, 0xb5, 0x3c, 0xcc, 0x1a, 0x96, 0xed, 0xc0, 0xe6,
+	0xd4, 0x46, 0x89, 0xa5, 0xb7, 0x8c, 0x76, 0xb5, 0xdb, 0x10, 0x45, 0x4b, 0x26, 0xc3, 0x79, 0x95,
+	0xfd, 0x5d, 0x07, 0xd3, 0x49, 0x65, 0xcf, 0x8b, 0x98, 0xb1, 0x01, 0xfa, 0x0f, 0x74, 0x27, 0xb5,
+	0xb4, 0xc7, 0x3f, 0x55, 0xba, 0x93, 0x22, 0x0b, 0x36, 0x8f, 0x03, 0xe6, 0xde, 0xf8, 0x9e, 0xbc,
+	0xb1, 0x89, 0xf3, 0x50, 0x48, 0x71, 0x46, 0xfd, 0xe1, 0x88, 0xcb, 0x4b, 0x1b, 0x38, 0x8b, 0xd0,
+	0xbf, 0x00, 0xe7, 0x34, 0xbe, 0x09, 0x28, 0x66, 0x8c, 0x67, 0xb7, 0x56, 0x10, 0xc1, 0xe8, 0xa4,
+	0xbd, 0xd0, 0xa3, 0xa9, 0x74, 0xa3, 0x81, 0xf3, 0xb0, 0x38, 0x79, 0x41, 0xb8, 0xf0, 0xa1, 0x51,
+	0x9c, 0x14, 0x08, 0x7a, 0x0e, 0xe5, 0x2b, 0x4e, 0xf8, 0x24, 0x91, 0x2e, 0xfc, 0xa7, 0xdb, 0x58,
+	0x18, 0x78, 0x9a, 0xc4, 0x59, 0x91, 0xf0, 0xce, 0xa9, 0x9f, 0x70, 0x12, 0xba, 0x54, 0xba, 0xd2,
+	0xc0, 0xb3, 0x58, 0xb4, 0xea, 0x25, 0x4e, 0x3c, 0x09, 0x6f, 0x1c, 0x3f, 0x92, 0x66, 0xdc, 0xc2,
+	0x0a, 0xd2, 0x7d, 0xd0, 0xa1, 0x32, 0x7b, 0x79, 0xd0, 0xe7, 0xc2, 0xbc, 0x27, 0x24, 0x08, 0xd0,
+	0x8e, 0x22, 0x73, 0xfe, 0xa8, 0x34, 0x77, 0xe7, 0xc1, 0xe9, 0x1a, 0xed, 0x83, 0xfb, 0x9f, 0xbf,
+	0xbf, 0xe9, 0x0d, 0xbb, 0xde, 0xf9, 0xf2, 0xa2, 0x93, 0x13, 0xba, 0x24, 0x08, 0x5e, 0x6b, 0xcf,
+	0xd0, 0x00, 0x6a, 0xef, 0x29, 0x57, 0xbf, 0x58, 0x68, 0x5f, 0x65, 0x51, 0xbe, 0x8b, 0x4d, 0x6b,
+	0x39, 0x91, 0xb5, 0x68, 0xc9, 0x16, 0x4d, 0xbb, 0x21, 0x5a, 0x0c, 0x29, 0xbf, 0xce, 0xdb, 0x5c,
+	0xfb, 0xe1, 0x80, 0x89, 0x3e, 0xe7, 0x50, 0x5f, 0xf4, 0x19, 0x3a, 0x10, 0x7c, 0x2b, 0xfe, 0x0a,
+	0xcd, 0xc3, 0xc7, 0x93, 0xd3, 0x86, 0xfd, 0xb2, 0x7c, 0x59, 0x5f, 0xfe, 0x19, 0x00, 0x13, 0xba,
+	0xa1, 0x6f, 0x9c, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -213,6 +699,9 @@ const _ = grpc.SupportPackageIsVersion6
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type XendorserClient interface {
 	EndorserCall(ctx context.Context, in *EndorserRequest, opts ...grpc.CallOption) (*EndorserResponse, error)
+	GetEndorserInfo(ctx context.Context, in *EndorserInfoRequest, opts ...grpc.CallOption) (*EndorserInfoResponse, error)
+	// 背书记录包含客户端IP，只通过grpc提供，不在网关中暴露
+	QueryEndorsement(ctx context.Context, in *QueryEndorsementRequest, opts ...grpc.CallOption) (*QueryEndorsementResponse, error)
 }
 
 type xendorserClient struct {
@@ -232,9 +721,30 @@ func (c *xendorserClient) EndorserCall(ctx context.Context, in *EndorserRequest,
 	return out, nil
 }
 
+func (c *xendorserClient) GetEndorserInfo(ctx context.Context, in *EndorserInfoRequest, opts ...grpc.CallOption) (*EndorserInfoResponse, error) {
+	out := new(EndorserInfoResponse)
+	err := c.cc.Invoke(ctx, "/pb.xendorser/GetEndorserInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xendorserClient) QueryEndorsement(ctx context.Context, in *QueryEndorsementRequest, opts ...grpc.CallOption) (*QueryEndorsementResponse, error) {
+	out := new(QueryEndorsementResponse)
+	err := c.cc.Invoke(ctx, "/pb.xendorser/QueryEndorsement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // XendorserServer is the server API for Xendorser service.
 type XendorserServer interface {
 	EndorserCall(context.Context, *EndorserRequest) (*EndorserResponse, error)
+	GetEndorserInfo(context.Context, *EndorserInfoRequest) (*EndorserInfoResponse, error)
+	// 背书记录包含客户端IP，只通过grpc提供，不在网关中暴露
+	QueryEndorsement(context.Context, *QueryEndorsementRequest) (*QueryEndorsementResponse, error)
 }
 
 // UnimplementedXendorserServer can be embedded to have forward compatible implementations.
@@ -244,6 +754,12 @@ type UnimplementedXendorserServer struct {
 func (*UnimplementedXendorserServer) EndorserCall(ctx context.Context, req *EndorserRequest) (*EndorserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EndorserCall not implemented")
 }
+func (*UnimplementedXendorserServer) GetEndorserInfo(ctx context.Context, req *EndorserInfoRequest) (*EndorserInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEndorserInfo not implemented")
+}
+func (*UnimplementedXendorserServer) QueryEndorsement(ctx context.Context, req *QueryEndorsementRequest) (*QueryEndorsementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryEndorsement not implemented")
+}
 
 func RegisterXendorserServer(s *grpc.Server, srv XendorserServer) {
 	s.RegisterService(&_Xendorser_serviceDesc, srv)
@@ -267,6 +783,42 @@ func _Xendorser_EndorserCall_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Xendorser_GetEndorserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndorserInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XendorserServer).GetEndorserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.xendorser/GetEndorserInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XendorserServer).GetEndorserInfo(ctx, req.(*EndorserInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xendorser_QueryEndorsement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEndorsementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XendorserServer).QueryEndorsement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.xendorser/QueryEndorsement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XendorserServer).QueryEndorsement(ctx, req.(*QueryEndorsementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Xendorser_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.xendorser",
 	HandlerType: (*XendorserServer)(nil),
@@ -275,6 +827,14 @@ var _Xendorser_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EndorserCall",
 			Handler:    _Xendorser_EndorserCall_Handler,
 		},
+		{
+			MethodName: "GetEndorserInfo",
+			Handler:    _Xendorser_GetEndorserInfo_Handler,
+		},
+		{
+			MethodName: "QueryEndorsement",
+			Handler:    _Xendorser_QueryEndorsement_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "xendorser.proto",
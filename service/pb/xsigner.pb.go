@@ -0,0 +1,465 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: xsigner.proto
+
+package pb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// 签名服务信息请求
+type SignerInfoRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignerInfoRequest) Reset()         { *m = SignerInfoRequest{} }
+func (m *SignerInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*SignerInfoRequest) ProtoMessage()    {}
+func (*SignerInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da14134a32cee47, []int{0}
+}
+
+func (m *SignerInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignerInfoRequest.Unmarshal(m, b)
+}
+func (m *SignerInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignerInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *SignerInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerInfoRequest.Merge(m, src)
+}
+func (m *SignerInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_SignerInfoRequest.Size(m)
+}
+func (m *SignerInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerInfoRequest proto.InternalMessageInfo
+
+func (m *SignerInfoRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type SignerInfoResponse struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=Address,proto3" json:"Address,omitempty"`
+	PublicKey            string   `protobuf:"bytes,3,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignerInfoResponse) Reset()         { *m = SignerInfoResponse{} }
+func (m *SignerInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*SignerInfoResponse) ProtoMessage()    {}
+func (*SignerInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da14134a32cee47, []int{1}
+}
+
+func (m *SignerInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignerInfoResponse.Unmarshal(m, b)
+}
+func (m *SignerInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignerInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *SignerInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerInfoResponse.Merge(m, src)
+}
+func (m *SignerInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_SignerInfoResponse.Size(m)
+}
+func (m *SignerInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerInfoResponse proto.InternalMessageInfo
+
+func (m *SignerInfoResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SignerInfoResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SignerInfoResponse) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+// 交易签名请求，签名服务按交易摘要签名
+type SignTxRequest struct {
+	Header               *Header      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Tx                   *Transaction `protobuf:"bytes,2,opt,name=Tx,proto3" json:"Tx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SignTxRequest) Reset()         { *m = SignTxRequest{} }
+func (m *SignTxRequest) String() string { return proto.CompactTextString(m) }
+func (*SignTxRequest) ProtoMessage()    {}
+func (*SignTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da14134a32cee47, []int{2}
+}
+
+func (m *SignTxRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignTxRequest.Unmarshal(m, b)
+}
+func (m *SignTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignTxRequest.Marshal(b, m, deterministic)
+}
+func (m *SignTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignTxRequest.Merge(m, src)
+}
+func (m *SignTxRequest) XXX_Size() int {
+	return xxx_messageInfo_SignTxRequest.Size(m)
+}
+func (m *SignTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignTxRequest proto.InternalMessageInfo
+
+func (m *SignTxRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SignTxRequest) GetTx() *Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+// utxo选择签名请求，签名服务按Bcname+Address+TotalNeed+"true"自行计算摘要，不对任意摘要签名
+type SignSelectUtxoRequest struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname               string   `protobuf:"bytes,2,opt,name=Bcname,proto3" json:"Bcname,omitempty"`
+	Address              string   `protobuf:"bytes,3,opt,name=Address,proto3" json:"Address,omitempty"`
+	TotalNeed            string   `protobuf:"bytes,4,opt,name=TotalNeed,proto3" json:"TotalNeed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignSelectUtxoRequest) Reset()         { *m = SignSelectUtxoRequest{} }
+func (m *SignSelectUtxoRequest) String() string { return proto.CompactTextString(m) }
+func (*SignSelectUtxoRequest) ProtoMessage()    {}
+func (*SignSelectUtxoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da14134a32cee47, []int{3}
+}
+
+func (m *SignSelectUtxoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignSelectUtxoRequest.Unmarshal(m, b)
+}
+func (m *SignSelectUtxoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignSelectUtxoRequest.Marshal(b, m, deterministic)
+}
+func (m *SignSelectUtxoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignSelectUtxoRequest.Merge(m, src)
+}
+func (m *SignSelectUtxoRequest) XXX_Size() int {
+	return xxx_messageInfo_SignSelectUtxoRequest.Size(m)
+}
+func (m *SignSelectUtxoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignSelectUtxoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignSelectUtxoRequest proto.InternalMessageInfo
+
+func (m *SignSelectUtxoRequest) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SignSelectUtxoRequest) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *SignSelectUtxoRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SignSelectUtxoRequest) GetTotalNeed() string {
+	if m != nil {
+		return m.TotalNeed
+	}
+	return ""
+}
+
+type SignResponse struct {
+	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Sign                 []byte   `protobuf:"bytes,2,opt,name=Sign,proto3" json:"Sign,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignResponse) Reset()         { *m = SignResponse{} }
+func (m *SignResponse) String() string { return proto.CompactTextString(m) }
+func (*SignResponse) ProtoMessage()    {}
+func (*SignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da14134a32cee47, []int{4}
+}
+
+func (m *SignResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignResponse.Unmarshal(m, b)
+}
+func (m *SignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignResponse.Marshal(b, m, deterministic)
+}
+func (m *SignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignResponse.Merge(m, src)
+}
+func (m *SignResponse) XXX_Size() int {
+	return xxx_messageInfo_SignResponse.Size(m)
+}
+func (m *SignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignResponse proto.InternalMessageInfo
+
+func (m *SignResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SignResponse) GetSign() []byte {
+	if m != nil {
+		return m.Sign
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SignerInfoRequest)(nil), "pb.SignerInfoRequest")
+	proto.RegisterType((*SignerInfoResponse)(nil), "pb.SignerInfoResponse")
+	proto.RegisterType((*SignTxRequest)(nil), "pb.SignTxRequest")
+	proto.RegisterType((*SignSelectUtxoRequest)(nil), "pb.SignSelectUtxoRequest")
+	proto.RegisterType((*SignResponse)(nil), "pb.SignResponse")
+}
+
+func init() { proto.RegisterFile("xsigner.proto", fileDescriptor_7da14134a32cee47) }
+
+var fileDescriptor_7da14134a32cee47 = []byte{
+	// 320 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcd, 0x4e, 0xc2, 0x40,
+	0x10, 0x4e, 0x0b, 0x29, 0x61, 0x00, 0x95, 0x49, 0x20, 0x95, 0x98, 0x48, 0x7a, 0xe2, 0x22, 0x07,
+	0x3c, 0x78, 0xd2, 0x44, 0x0f, 0xfe, 0xc4, 0xc4, 0x98, 0x52, 0x1f, 0x60, 0xdb, 0x8e, 0xd2, 0x04,
+	0x77, 0x6b, 0x77, 0x49, 0xd6, 0x67, 0xf0, 0x81, 0x7c, 0x3d, 0xb3, 0x4b, 0x0b, 0x68, 0x2f, 0x72,
+	0xeb, 0x7e, 0x33, 0xdf, 0xee, 0xf7, 0x53, 0xe8, 0x69, 0x99, 0xbd, 0x71, 0x2a, 0xa6, 0x79, 0x21,
+	0x94, 0x40, 0x37, 0x8f, 0x47, 0x5d, 0x9d, 0x2c, 0x58, 0xc6, 0xd7, 0x48, 0x70, 0x01, 0xfd, 0xb9,
+	0xdd, 0x78, 0xe0, 0xaf, 0x22, 0xa4, 0x8f, 0x15, 0x49, 0x85, 0x01, 0x78, 0x0b, 0x62, 0x29, 0x15,
+	0xbe, 0x33, 0x76, 0x26, 0x9d, 0x19, 0x4c, 0xf3, 0x78, 0x7a, 0x6f, 0x91, 0xb0, 0x9c, 0x04, 0x39,
+	0xe0, 0x2e, 0x51, 0xe6, 0x82, 0x4b, 0xfa, 0x0f, 0x13, 0x7d, 0x68, 0x5d, 0xa7, 0x69, 0x41, 0x52,
+	0xfa, 0xee, 0xd8, 0x99, 0xb4, 0xc3, 0xea, 0x88, 0x27, 0xd0, 0x7e, 0x5e, 0xc5, 0xcb, 0x2c, 0x79,
+	0xa4, 0x4f, 0xbf, 0x61, 0x67, 0x5b, 0x20, 0x88, 0xa0, 0x67, 0x5e, 0x8c, 0xf4, 0x1e, 0x32, 0xf1,
+	0x14, 0xdc, 0x48, 0xdb, 0x77, 0x3a, 0xb3, 0x43, 0x33, 0x8f, 0x0a, 0xc6, 0x25, 0x4b, 0x54, 0x26,
+	0x78, 0xe8, 0x46, 0x3a, 0xf8, 0x72, 0x60, 0x60, 0xae, 0x9d, 0xd3, 0x92, 0x12, 0xf5, 0xa2, 0xf4,
+	0x3e, 0x29, 0xe0, 0x10, 0xbc, 0x9b, 0x84, 0xb3, 0x77, 0x2a, 0xad, 0x94, 0xa7, 0x5d, 0x8f, 0x8d,
+	0x9a, 0xc7, 0x48, 0x28, 0xb6, 0x7c, 0x22, 0x4a, 0xfd, 0xe6, 0xda, 0xe3, 0x06, 0x08, 0x6e, 0xa1,
+	0x6b, 0xc4, 0xec, 0x95, 0x27, 0x42, 0xd3, 0x70, 0xac, 0x82, 0x6e, 0x68, 0xbf, 0x67, 0xdf, 0x0e,
+	0xb4, 0xca, 0xea, 0xf1, 0x0a, 0x7a, 0x77, 0xa4, 0xb6, 0x65, 0xe1, 0xc0, 0x5c, 0x52, 0x6b, 0x7d,
+	0x34, 0xfc, 0x0b, 0x97, 0x1a, 0xce, 0xc0, 0x5b, 0xe7, 0x8e, 0xfd, 0x6a, 0x63, 0xd3, 0xc1, 0xe8,
+	0xa8, 0x82, 0x36, 0xeb, 0x97, 0x70, 0xf0, 0x3b, 0x4f, 0x3c, 0xae, 0x76, 0x6a, 0x19, 0xd7, 0xe9,
+	0xb1, 0x67, 0xff, 0xcb, 0xf3, 0x9f, 0x01, 0x00, 0xec, 0x03, 0xda, 0x89, 0xba, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// XsignerClient is the client API for Xsigner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type XsignerClient interface {
+	GetSignerInfo(ctx context.Context, in *SignerInfoRequest, opts ...grpc.CallOption) (*SignerInfoResponse, error)
+	SignTx(ctx context.Context, in *SignTxRequest, opts ...grpc.CallOption) (*SignResponse, error)
+	SignSelectUtxo(ctx context.Context, in *SignSelectUtxoRequest, opts ...grpc.CallOption) (*SignResponse, error)
+}
+
+type xsignerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewXsignerClient(cc grpc.ClientConnInterface) XsignerClient {
+	return &xsignerClient{cc}
+}
+
+func (c *xsignerClient) GetSignerInfo(ctx context.Context, in *SignerInfoRequest, opts ...grpc.CallOption) (*SignerInfoResponse, error) {
+	out := new(SignerInfoResponse)
+	err := c.cc.Invoke(ctx, "/pb.xsigner/GetSignerInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xsignerClient) SignTx(ctx context.Context, in *SignTxRequest, opts ...grpc.CallOption) (*SignResponse, error) {
+	out := new(SignResponse)
+	err := c.cc.Invoke(ctx, "/pb.xsigner/SignTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *xsignerClient) SignSelectUtxo(ctx context.Context, in *SignSelectUtxoRequest, opts ...grpc.CallOption) (*SignResponse, error) {
+	out := new(SignResponse)
+	err := c.cc.Invoke(ctx, "/pb.xsigner/SignSelectUtxo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// XsignerServer is the server API for Xsigner service.
+type XsignerServer interface {
+	GetSignerInfo(context.Context, *SignerInfoRequest) (*SignerInfoResponse, error)
+	SignTx(context.Context, *SignTxRequest) (*SignResponse, error)
+	SignSelectUtxo(context.Context, *SignSelectUtxoRequest) (*SignResponse, error)
+}
+
+// UnimplementedXsignerServer can be embedded to have forward compatible implementations.
+type UnimplementedXsignerServer struct {
+}
+
+func (*UnimplementedXsignerServer) GetSignerInfo(ctx context.Context, req *SignerInfoRequest) (*SignerInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSignerInfo not implemented")
+}
+func (*UnimplementedXsignerServer) SignTx(ctx context.Context, req *SignTxRequest) (*SignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignTx not implemented")
+}
+func (*UnimplementedXsignerServer) SignSelectUtxo(ctx context.Context, req *SignSelectUtxoRequest) (*SignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignSelectUtxo not implemented")
+}
+
+func RegisterXsignerServer(s *grpc.Server, srv XsignerServer) {
+	s.RegisterService(&_Xsigner_serviceDesc, srv)
+}
+
+func _Xsigner_GetSignerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignerInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XsignerServer).GetSignerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.xsigner/GetSignerInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XsignerServer).GetSignerInfo(ctx, req.(*SignerInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xsigner_SignTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XsignerServer).SignTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.xsigner/SignTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XsignerServer).SignTx(ctx, req.(*SignTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Xsigner_SignSelectUtxo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignSelectUtxoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XsignerServer).SignSelectUtxo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.xsigner/SignSelectUtxo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XsignerServer).SignSelectUtxo(ctx, req.(*SignSelectUtxoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Xsigner_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.xsigner",
+	HandlerType: (*XsignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSignerInfo",
+			Handler:    _Xsigner_GetSignerInfo_Handler,
+		},
+		{
+			MethodName: "SignTx",
+			Handler:    _Xsigner_SignTx_Handler,
+		},
+		{
+			MethodName: "SignSelectUtxo",
+			Handler:    _Xsigner_SignSelectUtxo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "xsigner.proto",
+}
@@ -2,12 +2,16 @@ package rpc
 
 import (
 	"context"
+	"encoding/hex"
 	"math/big"
+	"time"
 
 	"github.com/xuperchain/xuperchain/models"
 	acom "github.com/xuperchain/xuperchain/service/common"
 	"github.com/xuperchain/xuperchain/service/pb"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
 	sctx "github.com/xuperchain/xupercore/example/xchain/common/context"
+	"github.com/xuperchain/xupercore/kernel/contract"
 	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
 	"github.com/xuperchain/xupercore/kernel/network/p2p"
 	"github.com/xuperchain/xupercore/lib/utils"
@@ -94,6 +98,87 @@ func (t *RpcServ) PreExec(gctx context.Context, req *pb.InvokeRPCRequest) (*pb.I
 	return resp, err
 }
 
+// EstimateFee estimate fee by preExec gas and recent blocks
+func (t *RpcServ) EstimateFee(gctx context.Context, req *pb.EstimateFeeRequest) (*pb.EstimateFeeResponse, error) {
+	// 默认响应
+	resp := &pb.EstimateFeeResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" || len(req.GetRequests()) == 0 {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+	reqs, err := acom.ConvertInvokeReq(req.GetRequests())
+	if err != nil {
+		rctx.GetLog().Warn("param error, convert failed", "err", err)
+		return resp, ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+	res, err := handle.PreExec(reqs, req.GetInitiator(), req.GetAuthRequire())
+	if err != nil {
+		rctx.GetLog().Warn("preExec failed", "err", err)
+		return resp, err
+	}
+	for _, r := range res.GetResponses() {
+		if r.GetStatus() >= contract.StatusErrorThreshold {
+			rctx.GetLog().Warn("contract error", "status", r.GetStatus(), "message", r.GetMessage())
+			return resp, ecom.ErrContractInvokeFailed.More("%s", r.GetMessage())
+		}
+	}
+
+	status, err := handle.QueryChainStatus()
+	if err != nil {
+		rctx.GetLog().Warn("get chain status error", "error", err)
+		return resp, err
+	}
+	recentBlocks := req.GetRecentBlocks()
+	if recentBlocks <= 0 {
+		recentBlocks = defaultFeeRecentBlocks
+	}
+	if recentBlocks > maxFeeRecentBlocks {
+		recentBlocks = maxFeeRecentBlocks
+	}
+	// 最新区块不变时使用缓存的中位数，避免每次请求都读取最近的区块
+	methods := contractMethods(reqs)
+	tip := status.GetLedgerMeta().GetTipBlockid()
+	cacheKey := feeCacheKey(recentBlocks, methods)
+	median, ok := t.feeCache.get(req.GetBcname(), tip, cacheKey)
+	if !ok {
+		trunkHeight := status.GetLedgerMeta().GetTrunkHeight()
+		var blocks []*xldgpb.InternalBlock
+		for height := trunkHeight; height >= 0 && height > trunkHeight-recentBlocks; height-- {
+			blockInfo, err := handle.QueryBlockByHeight(height, true)
+			if err != nil {
+				rctx.GetLog().Warn("query block error", "height", height, "error", err)
+				return resp, err
+			}
+			blocks = append(blocks, blockInfo.GetBlock())
+		}
+		median.samples, median.median = recentFeeMedian(blocks, methods)
+		t.feeCache.put(req.GetBcname(), tip, cacheKey, median)
+	}
+
+	resp.Bcname = req.GetBcname()
+	resp.GasUsed = res.GetGasUsed()
+	resp.GasPrice = acom.UtxoMetaToXchain(status.GetUtxoMeta()).GetGasPrice()
+	resp.RecentSamples, resp.RecentFeeMedian = median.samples, median.median
+	resp.SuggestedFee = resp.GasUsed
+	if resp.RecentFeeMedian > resp.SuggestedFee {
+		resp.SuggestedFee = resp.RecentFeeMedian
+	}
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("gas_used", resp.GasUsed)
+	rctx.GetLog().SetInfoField("suggested_fee", resp.SuggestedFee)
+	return resp, nil
+}
+
 // PreExecWithSelectUTXO preExec + selectUtxo
 func (t *RpcServ) PreExecWithSelectUTXO(gctx context.Context,
 	req *pb.PreExecWithSelectUTXORequest) (*pb.PreExecWithSelectUTXOResponse, error) {
@@ -421,6 +506,205 @@ func (t *RpcServ) QueryTx(gctx context.Context, req *pb.TxStatus) (*pb.TxStatus,
 	return resp, nil
 }
 
+// GetTxStatus get lifecycle status of transaction
+func (t *RpcServ) GetTxStatus(gctx context.Context, req *pb.GetTxStatusRequest) (*pb.GetTxStatusResponse, error) {
+	// 默认响应
+	resp := &pb.GetTxStatusResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" || len(req.GetTxid()) == 0 {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+	resp.Bcname = req.GetBcname()
+	resp.Txid = req.GetTxid()
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("txid", utils.F(req.GetTxid()))
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err)
+		return resp, ecom.ErrInternal.More("%v", err)
+	}
+
+	txInfo, err := handle.QueryTx(req.GetTxid())
+	if err == ecom.ErrTxNotExist {
+		resp.State = pb.GetTxStatusResponse_UNKNOWN
+		return resp, nil
+	}
+	if err != nil {
+		rctx.GetLog().Warn("query tx failed", "err", err)
+		return resp, err
+	}
+
+	switch pb.TransactionStatus(txInfo.Status) {
+	case pb.TransactionStatus_UNCONFIRM:
+		resp.State = pb.GetTxStatusResponse_PENDING
+		resp.Reason = "tx is in unconfirmed pool"
+		return resp, nil
+	case pb.TransactionStatus_FURCATION:
+		resp.State = pb.GetTxStatusResponse_PENDING
+		resp.Blockid = txInfo.GetTx().GetBlockid()
+		resp.Reason = "tx block is on a branch"
+		return resp, nil
+	case pb.TransactionStatus_CONFIRM:
+	default:
+		resp.State = pb.GetTxStatusResponse_UNKNOWN
+		return resp, nil
+	}
+
+	blockid := txInfo.GetTx().GetBlockid()
+	blockInfo, err := handle.QueryBlock(blockid, false)
+	if err != nil {
+		rctx.GetLog().Warn("query block error", "error", err)
+		return resp, err
+	}
+	block := blockInfo.GetBlock()
+	resp.Blockid = blockid
+	resp.Height = block.GetHeight()
+	resp.Confirmations = txInfo.Distance + 1
+	resp.State = pb.GetTxStatusResponse_CONFIRMED
+	failedTxs := block.GetFailedTxs()
+	if reason, ok := failedTxs[hex.EncodeToString(req.GetTxid())]; ok {
+		resp.State = pb.GetTxStatusResponse_FAILED
+		resp.Reason = reason
+	}
+	return resp, nil
+}
+
+// ListPendingTxs list unconfirmed transactions
+func (t *RpcServ) ListPendingTxs(gctx context.Context, req *pb.ListPendingTxsRequest) (*pb.ListPendingTxsResponse, error) {
+	// 默认响应
+	resp := &pb.ListPendingTxsResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err)
+		return resp, ecom.ErrInternal.More("%v", err)
+	}
+
+	txs, err := handle.GetUnconfirmedTx()
+	if err != nil {
+		rctx.GetLog().Warn("get unconfirmed tx failed", "err", err)
+		return resp, ecom.ErrInternal.More("%v", err)
+	}
+
+	resp.Bcname = req.GetBcname()
+	resp.Total, resp.Txs = listPendingTxs(txs, req.GetFilter())
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("total", resp.Total)
+	return resp, nil
+}
+
+// GetMempoolStats get statistics of unconfirmed transactions
+func (t *RpcServ) GetMempoolStats(gctx context.Context, req *pb.GetMempoolStatsRequest) (*pb.GetMempoolStatsResponse, error) {
+	// 默认响应
+	resp := &pb.GetMempoolStatsResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err)
+		return resp, ecom.ErrInternal.More("%v", err)
+	}
+
+	txs, err := handle.GetUnconfirmedTx()
+	if err != nil {
+		rctx.GetLog().Warn("get unconfirmed tx failed", "err", err)
+		return resp, ecom.ErrInternal.More("%v", err)
+	}
+
+	resp = mempoolStats(txs, time.Now())
+	resp.Bcname = req.GetBcname()
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("count", resp.Count)
+	return resp, nil
+}
+
+// GetTxProof get merkle inclusion proof of transaction
+func (t *RpcServ) GetTxProof(gctx context.Context, req *pb.TxProofRequest) (*pb.TxProof, error) {
+	// 默认响应
+	resp := &pb.TxProof{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil || req.GetBcname() == "" || len(req.GetTxid()) == 0 {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err)
+		return resp, ecom.ErrInternal.More("%v", err)
+	}
+
+	txInfo, err := handle.QueryTx(req.GetTxid())
+	if err != nil {
+		rctx.GetLog().Warn("query tx failed", "err", err)
+		return resp, err
+	}
+	status := pb.TransactionStatus(txInfo.Status)
+	if status != pb.TransactionStatus_CONFIRM && status != pb.TransactionStatus_FURCATION {
+		rctx.GetLog().Warn("tx is not in any block", "status", status)
+		return resp, ecom.ErrTxNotExist
+	}
+
+	blockid := txInfo.GetTx().GetBlockid()
+	blockInfo, err := handle.QueryBlock(blockid, true)
+	if err != nil {
+		rctx.GetLog().Warn("query block error", "error", err)
+		return resp, err
+	}
+	block := acom.BlockToXchain(blockInfo.Block)
+	if block == nil {
+		rctx.GetLog().Warn("convert block failed")
+		return resp, ecom.ErrInternal
+	}
+	index, path, err := acom.MakeBlockMerklePath(block, req.GetTxid())
+	if err != nil {
+		rctx.GetLog().Warn("make merkle path failed", "err", err)
+		return resp, ecom.ErrInternal.More("%v", err)
+	}
+
+	isTrunkTip, err := handle.IsTrunkTipBlock(blockid)
+	if err != nil {
+		rctx.GetLog().Warn("query is trunk tip block fail", "err", err)
+		return resp, err
+	}
+
+	// 只返回区块头
+	block.Transactions = nil
+	block.MerkleTree = nil
+	resp.Bcname = req.GetBcname()
+	resp.Txid = req.GetTxid()
+	resp.BlockHeader = block
+	resp.TxIndex = index
+	resp.MerklePath = path
+	resp.Status = status
+	resp.Distance = txInfo.Distance
+	resp.IsTrunkTip = isTrunkTip
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("txid", utils.F(req.GetTxid()))
+	rctx.GetLog().SetInfoField("blockid", utils.F(blockid))
+	return resp, nil
+}
+
 // GetBalance get balance for account or addr
 func (t *RpcServ) GetBalance(gctx context.Context, req *pb.AddressStatus) (*pb.AddressStatus, error) {
 	// 默认响应
@@ -0,0 +1,151 @@
+package rpc
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+// 背书记录库中的key前缀
+const (
+	auditRecordPrefix  = "R/"
+	auditTxidPrefix    = "T/"
+	auditReqHashPrefix = "H/"
+	auditDigestPrefix  = "D/"
+	auditFeePrefix     = "F/"
+)
+
+// endorserAudit 背书记录库，只追加不修改，按txid、请求哈希和签名摘要建立索引；
+// 同时保存已使用的服务费交易，重启后仍能防止重放
+type endorserAudit struct {
+	mutex sync.Mutex
+	db    *leveldb.DB
+	seq   uint64
+}
+
+func openEndorserAudit(path string) (*endorserAudit, error) {
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ea := &endorserAudit{db: db}
+	iter := db.NewIterator(util.BytesPrefix([]byte(auditRecordPrefix)), nil)
+	if iter.Last() {
+		ea.seq = binary.BigEndian.Uint64(iter.Key()[len(auditRecordPrefix):])
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return ea, nil
+}
+
+// append 同步写入一条背书记录，返回前已落盘
+func (ea *endorserAudit) append(record *pb.EndorsementRecord) error {
+	ea.mutex.Lock()
+	defer ea.mutex.Unlock()
+
+	record.Seq = ea.seq + 1
+	value, err := proto.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	seq := seqKey(record.Seq)
+	batch := new(leveldb.Batch)
+	batch.Put(append([]byte(auditRecordPrefix), seq...), value)
+	if len(record.Txid) > 0 {
+		batch.Put(indexKey(auditTxidPrefix, record.Txid, seq), nil)
+	}
+	batch.Put(indexKey(auditReqHashPrefix, record.RequestHash, seq), nil)
+	batch.Put(indexKey(auditDigestPrefix, record.Digest, seq), nil)
+	if err := ea.db.Write(batch, &opt.WriteOptions{Sync: true}); err != nil {
+		return err
+	}
+	ea.seq = record.Seq
+	return nil
+}
+
+func (ea *endorserAudit) queryByTxid(txid []byte) ([]*pb.EndorsementRecord, error) {
+	return ea.query(auditTxidPrefix, txid)
+}
+
+func (ea *endorserAudit) queryByRequestHash(hash []byte) ([]*pb.EndorsementRecord, error) {
+	return ea.query(auditReqHashPrefix, hash)
+}
+
+func (ea *endorserAudit) queryByDigest(digest []byte) ([]*pb.EndorsementRecord, error) {
+	return ea.query(auditDigestPrefix, digest)
+}
+
+// query 按索引查询记录，结果按写入顺序排列
+func (ea *endorserAudit) query(prefix string, value []byte) ([]*pb.EndorsementRecord, error) {
+	if len(value) == 0 {
+		return nil, errors.New("query key is empty")
+	}
+
+	var records []*pb.EndorsementRecord
+	iter := ea.db.NewIterator(util.BytesPrefix(indexKey(prefix, value, nil)), nil)
+	defer iter.Release()
+	for iter.Next() {
+		key := iter.Key()
+		seq := key[len(key)-8:]
+		data, err := ea.db.Get(append([]byte(auditRecordPrefix), seq...), nil)
+		if err != nil {
+			return nil, err
+		}
+		record := &pb.EndorsementRecord{}
+		if err := proto.Unmarshal(data, record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, iter.Error()
+}
+
+// feeBinding 查询服务费交易使用时记录的绑定，未使用过时返回nil
+func (ea *endorserAudit) feeBinding(txid []byte) ([]byte, error) {
+	binding, err := ea.db.Get(feeKey(txid), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, nil
+	}
+	return binding, err
+}
+
+// putFeeBinding 同步写入服务费交易的绑定，返回前已落盘
+func (ea *endorserAudit) putFeeBinding(txid, binding []byte) error {
+	return ea.db.Put(feeKey(txid), binding, &opt.WriteOptions{Sync: true})
+}
+
+func (ea *endorserAudit) deleteFeeBinding(txid []byte) error {
+	return ea.db.Delete(feeKey(txid), &opt.WriteOptions{Sync: true})
+}
+
+func (ea *endorserAudit) close() {
+	ea.db.Close()
+}
+
+func seqKey(seq uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+	return key
+}
+
+func feeKey(txid []byte) []byte {
+	return []byte(auditFeePrefix + hex.EncodeToString(txid))
+}
+
+func indexKey(prefix string, value []byte, seq []byte) []byte {
+	key := []byte(prefix + hex.EncodeToString(value) + "/")
+	return append(key, seq...)
+}
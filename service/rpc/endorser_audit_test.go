@@ -0,0 +1,70 @@
+package rpc
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+func TestEndorserAudit(t *testing.T) {
+	dir, err := ioutil.TempDir("", "endorser_audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ea, err := openEndorserAudit(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	records := []*pb.EndorsementRecord{
+		{Txid: []byte("tx1"), RequestHash: []byte("req1"), Digest: []byte("d1")},
+		{RequestHash: []byte("req2"), Digest: []byte("d2")},
+		{Txid: []byte("tx1"), RequestHash: []byte("req3"), Digest: []byte("d3")},
+	}
+	for _, r := range records[:2] {
+		if err := ea.append(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ea.close()
+
+	// 重新打开后序号继续递增
+	ea, err = openEndorserAudit(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ea.close()
+	if err := ea.append(records[2]); err != nil {
+		t.Fatal(err)
+	}
+	if records[2].Seq != 3 {
+		t.Errorf("expect seq 3, got %d", records[2].Seq)
+	}
+
+	res, err := ea.queryByTxid([]byte("tx1"))
+	if err != nil || len(res) != 2 || res[0].Seq != 1 || res[1].Seq != 3 {
+		t.Errorf("unexpected query by txid result: %v %v", res, err)
+	}
+	res, err = ea.queryByRequestHash([]byte("req2"))
+	if err != nil || len(res) != 1 || res[0].Seq != 2 {
+		t.Errorf("unexpected query by request hash result: %v %v", res, err)
+	}
+	res, err = ea.queryByDigest([]byte("d"))
+	if err != nil || len(res) != 0 {
+		t.Errorf("expect no prefix match, got %v %v", res, err)
+	}
+
+	dxe := NewDefaultXEndorser(nil, nil)
+	dxe.audit = ea
+	qres, err := dxe.QueryEndorsement(context.TODO(), &pb.QueryEndorsementRequest{Txid: []byte("tx1")})
+	if err != nil || len(qres.GetRecords()) != 2 {
+		t.Errorf("unexpected QueryEndorsement result: %v %v", qres, err)
+	}
+	if _, err := dxe.QueryEndorsement(context.TODO(), &pb.QueryEndorsementRequest{}); err == nil {
+		t.Error("expect error without txid and request hash")
+	}
+}
@@ -0,0 +1,131 @@
+package rpc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	scom "github.com/xuperchain/xuperchain/service/common"
+	sconf "github.com/xuperchain/xuperchain/service/config"
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+type feeRule struct {
+	address string
+	amount  *big.Int
+}
+
+// endorserFee 背书服务费校验，检查收款地址、金额、与被背书交易的绑定关系以及重放
+type endorserFee struct {
+	mutex sync.Mutex
+	rules map[string]*feeRule
+	// 已使用的服务费交易保存在背书记录库中，重启后仍然有效
+	audit *endorserAudit
+}
+
+func newEndorserFee(confs []sconf.EndorserFeeConf, audit *endorserAudit) (*endorserFee, error) {
+	if len(confs) > 0 && audit == nil {
+		return nil, errors.New("endorserFees requires endorserAuditPath to record used fee txs")
+	}
+	ef := &endorserFee{
+		rules: make(map[string]*feeRule, len(confs)),
+		audit: audit,
+	}
+	for _, conf := range confs {
+		amount, ok := big.NewInt(0).SetString(conf.Amount, 10)
+		if !ok || amount.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid endorser fee amount %q of chain %q", conf.Amount, conf.BcName)
+		}
+		ef.rules[conf.BcName] = &feeRule{address: conf.Address, amount: amount}
+	}
+	return ef, nil
+}
+
+// rule 返回链对应的服务费规则，未配置时不收取
+func (ef *endorserFee) rule(bcname string) *feeRule {
+	if rule, ok := ef.rules[bcname]; ok {
+		return rule
+	}
+	return ef.rules[""]
+}
+
+// verify 校验服务费交易支付给收款地址的金额，并且与被背书交易绑定，返回绑定方式
+func (ef *endorserFee) verify(rule *feeRule, tx, fee *pb.Transaction) ([]byte, error) {
+	txid, err := scom.MakeTxId(fee)
+	if err != nil {
+		return nil, fmt.Errorf("make fee txid failed.err:%v", err)
+	}
+	if !bytes.Equal(txid, fee.GetTxid()) {
+		return nil, errors.New("fee txid mismatch")
+	}
+
+	paid := big.NewInt(0)
+	for _, output := range fee.GetTxOutputs() {
+		if string(output.GetToAddr()) == rule.address {
+			paid.Add(paid, big.NewInt(0).SetBytes(output.GetAmount()))
+		}
+	}
+	if paid.Cmp(rule.amount) < 0 {
+		return nil, fmt.Errorf("fee paid to %s is %s, need %s", rule.address, paid, rule.amount)
+	}
+
+	return bindFee(tx, fee)
+}
+
+// bindFee 被背书交易需要花费服务费交易的输出，或者服务费交易Desc中包含被背书交易的摘要。
+// 返回的绑定为花费的服务费交易输出中最小的offset，或者desc：
+// 同一输出只能被一笔交易花费，Desc只对应一个交易摘要，同一绑定的被背书交易最多一笔上链
+func bindFee(tx, fee *pb.Transaction) ([]byte, error) {
+	if tx == nil {
+		return nil, errors.New("tx is empty")
+	}
+	offset := int32(-1)
+	for _, input := range tx.GetTxInputs() {
+		if bytes.Equal(input.GetRefTxid(), fee.GetTxid()) && (offset < 0 || input.GetRefOffset() < offset) {
+			offset = input.GetRefOffset()
+		}
+	}
+	if offset >= 0 {
+		return []byte(fmt.Sprintf("output:%d", offset)), nil
+	}
+
+	desc, err := scom.MakeEndorserFeeDesc(tx)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(fee.GetDesc(), desc) {
+		return []byte("desc"), nil
+	}
+	return nil, errors.New("fee tx is not bound to the endorsed tx")
+}
+
+// markUsed 记录服务费交易的绑定，已使用过的服务费交易只能再用于同一绑定的交易，
+// 例如客户端更换背书节点后重新生成的交易；返回是否为首次使用
+func (ef *endorserFee) markUsed(txid, binding []byte) (bool, error) {
+	ef.mutex.Lock()
+	defer ef.mutex.Unlock()
+
+	used, err := ef.audit.feeBinding(txid)
+	if err != nil {
+		return false, fmt.Errorf("query used fee failed.err:%v", err)
+	}
+	if used != nil {
+		if !bytes.Equal(used, binding) {
+			return false, errors.New("endorser fee tx is replayed")
+		}
+		return false, nil
+	}
+	if err := ef.audit.putFeeBinding(txid, binding); err != nil {
+		return false, fmt.Errorf("record used fee failed.err:%v", err)
+	}
+	return true, nil
+}
+
+// unmarkUsed 首次使用的服务费交易提交失败时允许重新使用
+func (ef *endorserFee) unmarkUsed(txid []byte) {
+	ef.mutex.Lock()
+	defer ef.mutex.Unlock()
+	ef.audit.deleteFeeBinding(txid)
+}
@@ -0,0 +1,153 @@
+package rpc
+
+import (
+	"math/big"
+	"testing"
+
+	scom "github.com/xuperchain/xuperchain/service/common"
+	sconf "github.com/xuperchain/xuperchain/service/config"
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+func newFeeTx(t *testing.T, to string, amount int64, desc []byte) *pb.Transaction {
+	fee := &pb.Transaction{
+		Version:   3,
+		Desc:      desc,
+		Nonce:     "nonce",
+		Initiator: "alice",
+		TxOutputs: []*pb.TxOutput{{ToAddr: []byte(to), Amount: big.NewInt(amount).Bytes()}},
+	}
+	txid, err := scom.MakeTxId(fee)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fee.Txid = txid
+	return fee
+}
+
+var testFeeConfs = []sconf.EndorserFeeConf{
+	{Address: "endorser", Amount: "400"},
+	{BcName: "free", Address: "endorser", Amount: "1"},
+}
+
+func TestEndorserFee(t *testing.T) {
+	if _, err := newEndorserFee(testFeeConfs, nil); err == nil {
+		t.Fatal("expect error without a store for used fees")
+	}
+	audit, err := openEndorserAudit(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer audit.close()
+	ef, err := newEndorserFee(testFeeConfs, audit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rule := ef.rule("xuper")
+	if rule == nil || rule.amount.Int64() != 400 || ef.rule("free").amount.Int64() != 1 {
+		t.Fatal("unexpected fee rule")
+	}
+
+	tx := &pb.Transaction{Version: 3, Nonce: "tx", Initiator: "alice"}
+	desc, err := scom.MakeEndorserFeeDesc(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tampered := newFeeTx(t, "endorser", 400, desc)
+	tampered.TxOutputs[0].Amount = big.NewInt(1000).Bytes()
+	spent := newFeeTx(t, "endorser", 400, nil)
+	spendingTx := &pb.Transaction{TxInputs: []*pb.TxInput{{RefTxid: spent.Txid}}}
+
+	cases := []struct {
+		name string
+		tx   *pb.Transaction
+		fee  *pb.Transaction
+		ok   bool
+	}{
+		{"bound by desc", tx, newFeeTx(t, "endorser", 400, desc), true},
+		{"bound by input", spendingTx, spent, true},
+		{"not bound", tx, newFeeTx(t, "endorser", 400, nil), false},
+		{"wrong address", tx, newFeeTx(t, "other", 400, desc), false},
+		{"not enough", tx, newFeeTx(t, "endorser", 399, desc), false},
+		{"txid mismatch", tx, tampered, false},
+	}
+	for _, cs := range cases {
+		_, err := ef.verify(rule, cs.tx, cs.fee)
+		if (err == nil) != cs.ok {
+			t.Errorf("%s: expect ok=%v, got err %v", cs.name, cs.ok, err)
+		}
+	}
+
+	binding, err := ef.verify(rule, spendingTx, spent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first, err := ef.markUsed(spent.Txid, binding); !first || err != nil {
+		t.Fatalf("expect first use of fee tx, got %v, %v", first, err)
+	}
+	ef.unmarkUsed(spent.Txid)
+	if first, err := ef.markUsed(spent.Txid, binding); !first || err != nil {
+		t.Fatalf("expect fee tx usable again after unmark, got %v, %v", first, err)
+	}
+}
+
+func TestEndorserFeeReplay(t *testing.T) {
+	dir := t.TempDir()
+	audit, err := openEndorserAudit(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ef, err := newEndorserFee(testFeeConfs, audit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rule := ef.rule("xuper")
+
+	// 服务费交易向发起者找零两个输出，被背书交易花费其中一个
+	fee := &pb.Transaction{
+		Version:   3,
+		Nonce:     "nonce",
+		Initiator: "alice",
+		TxOutputs: []*pb.TxOutput{
+			{ToAddr: []byte("endorser"), Amount: big.NewInt(400).Bytes()},
+			{ToAddr: []byte("alice"), Amount: big.NewInt(100).Bytes()},
+			{ToAddr: []byte("alice"), Amount: big.NewInt(100).Bytes()},
+		},
+	}
+	if fee.Txid, err = scom.MakeTxId(fee); err != nil {
+		t.Fatal(err)
+	}
+	spend := func(nonce string, offset int32) *pb.Transaction {
+		return &pb.Transaction{Nonce: nonce, TxInputs: []*pb.TxInput{{RefTxid: fee.Txid, RefOffset: offset}}}
+	}
+	use := func(ef *endorserFee, tx *pb.Transaction) error {
+		binding, err := ef.verify(rule, tx, fee)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = ef.markUsed(fee.Txid, binding)
+		return err
+	}
+	if err := use(ef, spend("first", 1)); err != nil {
+		t.Fatal(err)
+	}
+
+	// 重启后内存状态丢失，已使用的服务费交易仍不能用于花费其他输出的交易
+	audit.close()
+	audit, err = openEndorserAudit(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer audit.close()
+	if ef, err = newEndorserFee(testFeeConfs, audit); err != nil {
+		t.Fatal(err)
+	}
+	if err := use(ef, spend("replay", 2)); err == nil {
+		t.Fatal("expect replayed fee tx to be refused after restart")
+	}
+	// 花费同一输出的交易互相冲突，例如更换背书节点后重新生成的交易，可以再次使用
+	if err := use(ef, spend("retry", 1)); err != nil {
+		t.Fatalf("expect fee tx reusable by tx spending the same output: %v", err)
+	}
+}
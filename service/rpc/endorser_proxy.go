@@ -0,0 +1,480 @@
+package rpc
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	scom "github.com/xuperchain/xuperchain/service/common"
+	sconf "github.com/xuperchain/xuperchain/service/config"
+	"github.com/xuperchain/xuperchain/service/pb"
+	sctx "github.com/xuperchain/xupercore/example/xchain/common/context"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/lib/logs"
+	"github.com/xuperchain/xupercore/lib/utils"
+)
+
+// 延迟滑动平均中新样本的权重
+const latencyEWMAWeight = 0.2
+
+// endorserHost 上游背书节点及其连接、健康和熔断状态
+type endorserHost struct {
+	addr string
+
+	mutex  sync.Mutex
+	conn   *grpc.ClientConn
+	client pb.XendorserClient
+	// 最近一次主动健康检查结果
+	healthy bool
+	// 连续失败次数
+	failures int
+	// 熔断截止时间，零值表示未熔断；过了截止时间进入半开状态
+	openUntil time.Time
+	// 半开状态下只放行一个探测请求，探测成功后关闭熔断
+	probing bool
+	// 请求延迟的滑动平均
+	latency time.Duration
+}
+
+// available 节点健康，且未熔断或处于半开状态且没有正在进行的探测
+func (h *endorserHost) available(now time.Time) bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if !h.healthy || now.Before(h.openUntil) {
+		return false
+	}
+	return h.openUntil.IsZero() || !h.probing
+}
+
+// acquire 请求前调用，半开状态下只有第一个请求作为探测放行
+func (h *endorserHost) acquire(now time.Time) bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.openUntil.IsZero() || now.Before(h.openUntil) {
+		// 熔断期内只有全部节点不可用时才会被挑中，此时照常请求
+		return true
+	}
+	if h.probing {
+		return false
+	}
+	h.probing = true
+	return true
+}
+
+func (h *endorserHost) getLatency() time.Duration {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	return h.latency
+}
+
+// ProxyXEndorser 将背书请求按负载均衡策略转发给上游背书节点
+type ProxyXEndorser struct {
+	engine ecom.Engine
+	conf   *sconf.ServConf
+	log    logs.Logger
+
+	hosts    []*endorserHost
+	dialOpts []grpc.DialOption
+	// 轮询计数
+	next uint64
+	// 随机策略使用，只在创建时初始化种子
+	rand      *rand.Rand
+	randMutex sync.Mutex
+
+	exitOnce sync.Once
+	exitCh   chan struct{}
+}
+
+var _ XEndorser = (*ProxyXEndorser)(nil)
+
+// validateProxyConf 代理背书需要配置后端背书节点，开启endorserTlsEnable时需要tls文件
+func validateProxyConf(cfg *sconf.ServConf, tlsPath string) []error {
+	var errs []error
+	if len(cfg.EndorserHosts) == 0 {
+		errs = append(errs, errors.New("endorserHosts must not be empty when endorserModule is proxy"))
+	}
+	// enableTls时已经检查过tls文件
+	if cfg.EndorserTlsEnable && !cfg.EnableTls {
+		errs = append(errs, sconf.TlsFileErrors(tlsPath, "endorserTlsEnable")...)
+	}
+	return errs
+}
+
+func NewProxyXEndorser(cfg *sconf.ServConf, engine ecom.Engine) (*ProxyXEndorser, error) {
+	if len(cfg.EndorserHosts) == 0 {
+		return nil, fmt.Errorf("endorser hosts is empty")
+	}
+
+	dialOpts := []grpc.DialOption{grpc.WithMaxMsgSize(cfg.MaxMsgSize)}
+	if cfg.EndorserTlsEnable {
+		// tls证书在节点的tls目录中，需要从引擎的环境配置获取路径
+		if engine == nil || engine.Context() == nil || engine.Context().EnvCfg == nil {
+			return nil, fmt.Errorf("endorser tls requires the env config of engine")
+		}
+		envConf := engine.Context().EnvCfg
+		serverName := cfg.EndorserTlsServerName
+		if serverName == "" {
+			serverName = cfg.TlsServerName
+		}
+		creds, err := newClientTls(envConf.GenDataAbsPath(envConf.TlsDir), serverName)
+		if err != nil {
+			return nil, fmt.Errorf("load endorser tls failed.err:%v", err)
+		}
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(creds))
+	} else {
+		dialOpts = append(dialOpts, grpc.WithInsecure())
+	}
+
+	log, _ := logs.NewLogger("", scom.SubModName)
+	pxe := &ProxyXEndorser{
+		engine:   engine,
+		conf:     cfg,
+		log:      log,
+		hosts:    make([]*endorserHost, 0, len(cfg.EndorserHosts)),
+		dialOpts: dialOpts,
+		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
+		exitCh:   make(chan struct{}),
+	}
+	for _, addr := range cfg.EndorserHosts {
+		// 未检查前默认健康，避免启动后的首个检查周期内无节点可用
+		pxe.hosts = append(pxe.hosts, &endorserHost{addr: addr, healthy: true})
+	}
+
+	if cfg.EndorserHealthCheckMs > 0 {
+		go pxe.healthCheckLoop()
+	}
+	return pxe, nil
+}
+
+func (pxe *ProxyXEndorser) EndorserCall(gctx context.Context, req *pb.EndorserRequest) (*pb.EndorserResponse, error) {
+	resp := &pb.EndorserResponse{}
+	rctx := sctx.ValueReqCtx(gctx)
+
+	candidates := pxe.pickHosts()
+	attempts := pxe.conf.EndorserRetry + 1
+	if attempts > len(candidates) {
+		attempts = len(candidates)
+	}
+
+	var err error
+	for i := 0; i < attempts; i++ {
+		var res *pb.EndorserResponse
+		host := candidates[i]
+		res, err = pxe.callHost(gctx, host, req)
+		if err == nil {
+			resp.EndorserAddress = res.EndorserAddress
+			resp.ResponseName = res.ResponseName
+			resp.ResponseData = res.ResponseData
+			resp.EndorserSign = res.EndorserSign
+			if rctx != nil {
+				rctx.GetLog().SetInfoField("bc_name", req.GetBcName())
+				rctx.GetLog().SetInfoField("request_name", req.GetRequestName())
+				rctx.GetLog().SetInfoField("endorser_host", host.addr)
+			}
+			return resp, nil
+		}
+		if !retryable(err) {
+			// 业务错误由上游背书节点给出，换节点重试没有意义
+			return resp, err
+		}
+		pxe.log.Warn("call endorser failed, try next host", "host", host.addr, "attempt", i+1, "err", err)
+	}
+	if err == nil {
+		err = errors.New("no endorser host available")
+	}
+	return resp, err
+}
+
+// GetEndorserInfo 从可用的上游背书节点查询背书地址和公钥，重试策略与EndorserCall一致
+func (pxe *ProxyXEndorser) GetEndorserInfo(gctx context.Context,
+	req *pb.EndorserInfoRequest) (*pb.EndorserInfoResponse, error) {
+	candidates := pxe.pickHosts()
+	attempts := pxe.conf.EndorserRetry + 1
+	if attempts > len(candidates) {
+		attempts = len(candidates)
+	}
+
+	var err error
+	for i := 0; i < attempts; i++ {
+		var res *pb.EndorserInfoResponse
+		host := candidates[i]
+		res, err = pxe.getHostInfo(gctx, host, req)
+		if err == nil || !retryable(err) {
+			return res, err
+		}
+		pxe.log.Warn("get endorser info failed, try next host", "host", host.addr, "attempt", i+1, "err", err)
+	}
+	if err == nil {
+		err = errors.New("no endorser host available")
+	}
+	return nil, err
+}
+
+// QueryEndorsement 背书记录保存在各上游背书节点，查询全部节点后合并结果，全部失败时返回错误
+func (pxe *ProxyXEndorser) QueryEndorsement(gctx context.Context,
+	req *pb.QueryEndorsementRequest) (*pb.QueryEndorsementResponse, error) {
+	resp := &pb.QueryEndorsementResponse{
+		Header: &pb.Header{Logid: req.GetHeader().GetLogid()},
+	}
+
+	var err error
+	succ := 0
+	for _, host := range pxe.hosts {
+		var client pb.XendorserClient
+		client, err = pxe.getClient(host)
+		if err != nil {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(gctx, time.Duration(pxe.conf.EndorserTimeoutMs)*time.Millisecond)
+		var res *pb.QueryEndorsementResponse
+		res, err = client.QueryEndorsement(ctx, req)
+		cancel()
+		if err != nil {
+			pxe.log.Warn("query endorsement failed", "host", host.addr, "err", err)
+			continue
+		}
+		succ++
+		resp.Records = append(resp.Records, res.GetRecords()...)
+	}
+	if succ == 0 {
+		if err == nil {
+			err = errors.New("no endorser host available")
+		}
+		resp.Header.Error = pb.XChainErrorEnum_CONNECT_REFUSE
+		return resp, err
+	}
+	return resp, nil
+}
+
+// Close 停止健康检查并关闭到上游背书节点的连接
+func (pxe *ProxyXEndorser) Close() {
+	pxe.exitOnce.Do(func() {
+		close(pxe.exitCh)
+		for _, host := range pxe.hosts {
+			host.mutex.Lock()
+			if host.conn != nil {
+				host.conn.Close()
+				host.conn, host.client = nil, nil
+			}
+			host.mutex.Unlock()
+		}
+	})
+}
+
+func (pxe *ProxyXEndorser) callHost(gctx context.Context, host *endorserHost,
+	req *pb.EndorserRequest) (*pb.EndorserResponse, error) {
+	if !host.acquire(time.Now()) {
+		return nil, status.Errorf(codes.Unavailable, "endorser is half open and probing.host:%s", host.addr)
+	}
+	client, err := pxe.getClient(host)
+	if err != nil {
+		err = status.Errorf(codes.Unavailable, "dial endorser failed.host:%s err:%v", host.addr, err)
+		pxe.onResult(host, 0, err)
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(gctx, time.Duration(pxe.conf.EndorserTimeoutMs)*time.Millisecond)
+	defer cancel()
+	begin := time.Now()
+	res, err := client.EndorserCall(ctx, req)
+	pxe.onResult(host, time.Since(begin), err)
+	return res, err
+}
+
+func (pxe *ProxyXEndorser) getHostInfo(gctx context.Context, host *endorserHost,
+	req *pb.EndorserInfoRequest) (*pb.EndorserInfoResponse, error) {
+	if !host.acquire(time.Now()) {
+		return nil, status.Errorf(codes.Unavailable, "endorser is half open and probing.host:%s", host.addr)
+	}
+	client, err := pxe.getClient(host)
+	if err != nil {
+		err = status.Errorf(codes.Unavailable, "dial endorser failed.host:%s err:%v", host.addr, err)
+		pxe.onResult(host, 0, err)
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(gctx, time.Duration(pxe.conf.EndorserTimeoutMs)*time.Millisecond)
+	defer cancel()
+	begin := time.Now()
+	res, err := client.GetEndorserInfo(ctx, req)
+	pxe.onResult(host, time.Since(begin), err)
+	return res, err
+}
+
+// pickHosts 按负载均衡策略返回候选节点顺序，不可用节点不参与；全部不可用时退化为尝试所有节点
+func (pxe *ProxyXEndorser) pickHosts() []*endorserHost {
+	now := time.Now()
+	hosts := make([]*endorserHost, 0, len(pxe.hosts))
+	for _, host := range pxe.hosts {
+		if host.available(now) {
+			hosts = append(hosts, host)
+		}
+	}
+	if len(hosts) == 0 {
+		hosts = append(hosts, pxe.hosts...)
+	}
+
+	switch pxe.conf.EndorserPolicy {
+	case sconf.EndorserPolicyLeastLatency:
+		sort.SliceStable(hosts, func(i, j int) bool {
+			return hosts[i].getLatency() < hosts[j].getLatency()
+		})
+	case sconf.EndorserPolicyRandom:
+		pxe.randMutex.Lock()
+		pxe.rand.Shuffle(len(hosts), func(i, j int) {
+			hosts[i], hosts[j] = hosts[j], hosts[i]
+		})
+		pxe.randMutex.Unlock()
+	default:
+		start := int(atomic.AddUint64(&pxe.next, 1) % uint64(len(hosts)))
+		hosts = append(hosts[start:], hosts[:start]...)
+	}
+	return hosts
+}
+
+// onResult 记录请求结果，更新延迟、熔断状态和监控指标
+func (pxe *ProxyXEndorser) onResult(host *endorserHost, cost time.Duration, err error) {
+	code := status.Code(err).String()
+	EndorserProxyCallCounter.WithLabelValues(host.addr, code).Inc()
+	if cost > 0 {
+		EndorserProxyCallHistogram.WithLabelValues(host.addr).Observe(cost.Seconds())
+	}
+
+	host.mutex.Lock()
+	defer host.mutex.Unlock()
+	wasOpen := !host.openUntil.IsZero()
+	host.probing = false
+	if err == nil || !retryable(err) {
+		// 上游有响应即认为节点可用，半开状态的探测成功后关闭熔断
+		if wasOpen {
+			pxe.log.Info("endorser host circuit closed", "host", host.addr)
+		}
+		host.failures = 0
+		host.openUntil = time.Time{}
+		if host.latency == 0 {
+			host.latency = cost
+		} else {
+			host.latency = time.Duration((1-latencyEWMAWeight)*float64(host.latency) +
+				latencyEWMAWeight*float64(cost))
+		}
+		EndorserProxyBreakerGauge.WithLabelValues(host.addr).Set(0)
+		return
+	}
+
+	host.failures++
+	threshold := pxe.conf.EndorserBreakerThreshold
+	// 半开状态的探测失败时直接重新熔断
+	if wasOpen || (threshold > 0 && host.failures >= threshold) {
+		cooldown := time.Duration(pxe.conf.EndorserBreakerCooldownMs) * time.Millisecond
+		host.openUntil = time.Now().Add(cooldown)
+		// 丢弃旧连接，熔断结束后重新建连
+		if host.conn != nil {
+			host.conn.Close()
+			host.conn, host.client = nil, nil
+		}
+		EndorserProxyBreakerGauge.WithLabelValues(host.addr).Set(1)
+		pxe.log.Warn("endorser host circuit open", "host", host.addr,
+			"failures", host.failures, "cooldown", cooldown)
+	}
+}
+
+func (pxe *ProxyXEndorser) getClient(host *endorserHost) (pb.XendorserClient, error) {
+	host.mutex.Lock()
+	defer host.mutex.Unlock()
+	if host.client != nil {
+		return host.client, nil
+	}
+
+	conn, err := grpc.Dial(host.addr, pxe.dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+	host.conn = conn
+	host.client = pb.NewXendorserClient(conn)
+	return host.client, nil
+}
+
+func (pxe *ProxyXEndorser) healthCheckLoop() {
+	interval := time.Duration(pxe.conf.EndorserHealthCheckMs) * time.Millisecond
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-pxe.exitCh:
+			return
+		case <-ticker.C:
+			for _, host := range pxe.hosts {
+				pxe.checkHost(host)
+			}
+		}
+	}
+}
+
+// checkHost 调用GetEndorserInfo检查上游背书节点能否在超时时间内响应
+func (pxe *ProxyXEndorser) checkHost(host *endorserHost) {
+	client, err := pxe.getClient(host)
+	if err != nil {
+		pxe.setHealthy(host, false)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(pxe.conf.EndorserTimeoutMs)*time.Millisecond)
+	defer cancel()
+	req := &pb.EndorserInfoRequest{
+		Header: &pb.Header{Logid: utils.GenLogId()},
+	}
+	_, err = client.GetEndorserInfo(ctx, req)
+	// 业务错误说明节点有响应，只有传输层错误认为不健康
+	pxe.setHealthy(host, err == nil || !retryable(err))
+}
+
+func (pxe *ProxyXEndorser) setHealthy(host *endorserHost, healthy bool) {
+	host.mutex.Lock()
+	changed := host.healthy != healthy
+	host.healthy = healthy
+	host.mutex.Unlock()
+
+	value := 0.0
+	if healthy {
+		value = 1
+	}
+	EndorserProxyHealthGauge.WithLabelValues(host.addr).Set(value)
+	if changed {
+		pxe.log.Info("endorser host health changed", "host", host.addr, "healthy", healthy)
+	}
+}
+
+// retryable 传输层错误可以换节点重试
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
+
+func newClientTls(tlsPath, serverName string) (credentials.TransportCredentials, error) {
+	certPool, certificate, err := loadTlsCerts(tlsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	creds := credentials.NewTLS(&tls.Config{
+		ServerName:   serverName,
+		Certificates: []tls.Certificate{certificate},
+		RootCAs:      certPool,
+	})
+	return creds, nil
+}
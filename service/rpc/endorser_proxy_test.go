@@ -0,0 +1,233 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/xuperchain/xuperchain/data/mock"
+	sconf "github.com/xuperchain/xuperchain/service/config"
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+type mockXEndorser struct {
+	addr string
+}
+
+func (m *mockXEndorser) EndorserCall(ctx context.Context, req *pb.EndorserRequest) (*pb.EndorserResponse, error) {
+	return &pb.EndorserResponse{
+		ResponseName:    req.GetRequestName(),
+		EndorserAddress: m.addr,
+	}, nil
+}
+
+func (m *mockXEndorser) GetEndorserInfo(ctx context.Context, req *pb.EndorserInfoRequest) (*pb.EndorserInfoResponse, error) {
+	return &pb.EndorserInfoResponse{BcName: req.GetBcName(), EndorserAddress: m.addr}, nil
+}
+
+func (m *mockXEndorser) QueryEndorsement(ctx context.Context, req *pb.QueryEndorsementRequest) (*pb.QueryEndorsementResponse, error) {
+	return &pb.QueryEndorsementResponse{Records: []*pb.EndorsementRecord{{EndorserAddress: m.addr}}}, nil
+}
+
+func startMockEndorser(t *testing.T) (string, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := lis.Addr().String()
+	s := grpc.NewServer()
+	pb.RegisterXendorserServer(s, &mockXEndorser{addr: addr})
+	go s.Serve(lis)
+	return addr, s.Stop
+}
+
+func deadAddr(t *testing.T) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := lis.Addr().String()
+	lis.Close()
+	return addr
+}
+
+// 等待死节点熔断的最大请求次数，随机策略下连续这么多次都没有挑中死节点的概率可以忽略
+const maxBreakerCalls = 100
+
+func TestProxyXEndorser(t *testing.T) {
+	// 初始化日志
+	if _, err := mock.NewEnvConfForTest(); err != nil {
+		t.Fatal(err)
+	}
+	addr, stop := startMockEndorser(t)
+	defer stop()
+	dead := deadAddr(t)
+
+	for _, policy := range []string{sconf.EndorserPolicyRoundRobin,
+		sconf.EndorserPolicyRandom, sconf.EndorserPolicyLeastLatency} {
+		cfg := sconf.GetDefServConf()
+		cfg.EndorserHosts = []string{dead, addr}
+		cfg.EndorserPolicy = policy
+		cfg.EndorserRetry = 1
+		cfg.EndorserHealthCheckMs = 0
+		cfg.EndorserBreakerThreshold = 1
+		pxe, err := NewProxyXEndorser(cfg, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// 死节点失败后重试到存活节点，每次请求都应成功；
+		// 随机策略下死节点被挑中的次数不确定，一直请求到死节点熔断为止
+		for i := 0; pxe.hosts[0].available(time.Now()); i++ {
+			if i >= maxBreakerCalls {
+				t.Fatalf("policy:%s dead host is not circuit open after %d calls", policy, i)
+			}
+			resp, err := pxe.EndorserCall(context.TODO(), &pb.EndorserRequest{RequestName: "TxQuery"})
+			if err != nil {
+				t.Fatalf("policy:%s call failed.err:%v", policy, err)
+			}
+			if resp.GetEndorserAddress() != addr {
+				t.Errorf("policy:%s unexpected endorser:%s", policy, resp.GetEndorserAddress())
+			}
+		}
+
+		// 死节点已熔断，不再参与挑选
+		hosts := pxe.pickHosts()
+		if len(hosts) != 1 || hosts[0].addr != addr {
+			t.Errorf("policy:%s dead host should be circuit open", policy)
+		}
+		pxe.Close()
+	}
+}
+
+func TestProxyXEndorserTlsWithoutEngine(t *testing.T) {
+	cfg := sconf.GetDefServConf()
+	cfg.EndorserHosts = []string{"127.0.0.1:8848"}
+	cfg.EndorserTlsEnable = true
+	if _, err := NewProxyXEndorser(cfg, nil); err == nil {
+		t.Errorf("expect error when tls is enabled without engine")
+	}
+}
+
+func TestProxyBreakerHalfOpen(t *testing.T) {
+	if _, err := mock.NewEnvConfForTest(); err != nil {
+		t.Fatal(err)
+	}
+	addr, stop := startMockEndorser(t)
+	defer stop()
+
+	cfg := sconf.GetDefServConf()
+	cfg.EndorserHosts = []string{deadAddr(t), addr}
+	cfg.EndorserHealthCheckMs = 0
+	cfg.EndorserBreakerThreshold = 1
+	cfg.EndorserBreakerCooldownMs = 20
+	pxe, err := NewProxyXEndorser(cfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pxe.Close()
+	dead, live := pxe.hosts[0], pxe.hosts[1]
+	cooldown := time.Duration(cfg.EndorserBreakerCooldownMs) * time.Millisecond
+	unavailable := status.Error(codes.Unavailable, "mock unavailable")
+	req := &pb.EndorserRequest{RequestName: "TxQuery"}
+
+	// 冷却结束后只放行一个探测请求
+	pxe.onResult(live, 0, unavailable)
+	if live.available(time.Now()) {
+		t.Fatal("host should be circuit open")
+	}
+	time.Sleep(cooldown)
+	if !live.available(time.Now()) || !live.acquire(time.Now()) {
+		t.Fatal("half open host should accept a probe")
+	}
+	if live.available(time.Now()) || live.acquire(time.Now()) {
+		t.Fatal("half open host should accept only one probe")
+	}
+	// 探测成功后关闭熔断
+	pxe.onResult(live, time.Millisecond, nil)
+	if !live.available(time.Now()) || !live.openUntil.IsZero() {
+		t.Fatal("host should be circuit closed after a successful probe")
+	}
+
+	// 探测失败后重新熔断
+	pxe.onResult(dead, 0, unavailable)
+	time.Sleep(cooldown)
+	if _, err := pxe.callHost(context.TODO(), dead, req); err == nil {
+		t.Fatal("call dead host should fail")
+	}
+	if dead.available(time.Now()) {
+		t.Fatal("host should be circuit open again after a failed probe")
+	}
+
+	// 半开的存活节点通过真实请求探测
+	pxe.onResult(live, 0, unavailable)
+	time.Sleep(cooldown)
+	if _, err := pxe.callHost(context.TODO(), live, req); err != nil {
+		t.Fatalf("probe live host failed.err:%v", err)
+	}
+	if !live.available(time.Now()) {
+		t.Fatal("live host should be circuit closed")
+	}
+}
+
+func TestProxyHealthCheck(t *testing.T) {
+	if _, err := mock.NewEnvConfForTest(); err != nil {
+		t.Fatal(err)
+	}
+	addr, stop := startMockEndorser(t)
+	defer stop()
+
+	cfg := sconf.GetDefServConf()
+	cfg.EndorserHosts = []string{deadAddr(t), addr}
+	cfg.EndorserHealthCheckMs = 0
+	cfg.EndorserTimeoutMs = 500
+	pxe, err := NewProxyXEndorser(cfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pxe.Close()
+
+	for _, host := range pxe.hosts {
+		pxe.checkHost(host)
+	}
+	if pxe.hosts[0].healthy {
+		t.Error("dead host should be unhealthy")
+	}
+	if !pxe.hosts[1].healthy {
+		t.Error("live host should be healthy")
+	}
+}
+
+func TestValidateProxyConf(t *testing.T) {
+	cfg := sconf.GetDefServConf()
+	cfg.EnableEndorser = true
+	cfg.EndorserModule = EndorserModuleProxy
+	cfg.EndorserHosts = nil
+	errs, _ := cfg.Validate("").(sconf.ConfErrors)
+	if len(errs) != 1 {
+		t.Fatalf("expect endorserHosts error, got %v", errs)
+	}
+
+	// 开启endorserTlsEnable时检查tls文件
+	cfg.EndorserHosts = []string{"127.0.0.1:8848"}
+	cfg.EndorserTlsEnable = true
+	errs, _ = cfg.Validate(t.TempDir()).(sconf.ConfErrors)
+	if len(errs) != len(sconf.TlsFiles) {
+		t.Fatalf("expect %d tls file errors, got %v", len(sconf.TlsFiles), errs)
+	}
+
+	cfg.EndorserTlsEnable = false
+	if err := cfg.Validate(""); err != nil {
+		t.Fatalf("validate proxy conf failed: %v", err)
+	}
+	// 已注册的模块都可以通过校验
+	cfg.EndorserModule = EndorserModuleDefault
+	if err := cfg.Validate(""); err != nil {
+		t.Fatalf("validate default conf failed: %v", err)
+	}
+}
@@ -5,23 +5,22 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io/ioutil"
-	"math/rand"
 	"net"
+	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
-	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
 
 	scom "github.com/xuperchain/xuperchain/service/common"
 	sconf "github.com/xuperchain/xuperchain/service/config"
+	"github.com/xuperchain/xuperchain/service/endorser/compliance"
 	"github.com/xuperchain/xuperchain/service/pb"
 	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo/txhash"
 	sctx "github.com/xuperchain/xupercore/example/xchain/common/context"
 	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
-	crypto_client "github.com/xuperchain/xupercore/lib/crypto/client"
 	"github.com/xuperchain/xupercore/lib/crypto/hash"
 )
 
@@ -32,82 +31,72 @@ const (
 
 type XEndorser interface {
 	EndorserCall(gctx context.Context, req *pb.EndorserRequest) (*pb.EndorserResponse, error)
+	// GetEndorserInfo 返回背书节点在指定链上使用的背书地址和公钥
+	GetEndorserInfo(gctx context.Context, req *pb.EndorserInfoRequest) (*pb.EndorserInfoResponse, error)
+	// QueryEndorsement 按txid或请求哈希查询背书记录
+	QueryEndorsement(gctx context.Context, req *pb.QueryEndorsementRequest) (*pb.QueryEndorsementResponse, error)
 }
 
-type ProxyXEndorser struct {
-	engine      ecom.Engine
-	clientCache sync.Map
-	mutex       sync.Mutex
-	conf        *sconf.ServConf
-}
+// NewEndorserFunc 创建背书模块实例的方法
+type NewEndorserFunc func(engine ecom.Engine, cfg *sconf.ServConf, svr XEndorserServer) (XEndorser, error)
 
-func newEndorserService(cfg *sconf.ServConf, engine ecom.Engine, svr XEndorserServer) (XEndorser, error) {
-	switch cfg.EndorserModule {
-	case EndorserModuleDefault:
-		dxe := NewDefaultXEndorser(svr, engine)
-		return dxe, nil
-	case EndorserModuleProxy:
-		return &ProxyXEndorser{
-			engine: engine,
-			conf:   cfg,
-		}, nil
-	default:
-		return nil, fmt.Errorf("unknown endorser module")
-	}
+var (
+	endorserMu sync.RWMutex
+	endorsers  = make(map[string]NewEndorserFunc)
+)
 
+func init() {
+	RegisterEndorser(EndorserModuleDefault, func(engine ecom.Engine, cfg *sconf.ServConf,
+		svr XEndorserServer) (XEndorser, error) {
+		return NewDefaultXEndorserWithConf(svr, engine, cfg)
+	})
+	RegisterEndorserWithValidator(EndorserModuleProxy, func(engine ecom.Engine, cfg *sconf.ServConf,
+		svr XEndorserServer) (XEndorser, error) {
+		return NewProxyXEndorser(cfg, engine)
+	}, validateProxyConf)
 }
 
-func (pxe *ProxyXEndorser) EndorserCall(gctx context.Context, req *pb.EndorserRequest) (*pb.EndorserResponse, error) {
-	resp := &pb.EndorserResponse{}
-	rctx := sctx.ValueReqCtx(gctx)
-	endc, err := pxe.getClient(pxe.getHost())
-	if err != nil {
-		return resp, err
+// RegisterEndorser 注册背书模块，通过server.yaml的endorserModule选择
+func RegisterEndorser(name string, f NewEndorserFunc) {
+	RegisterEndorserWithValidator(name, f, nil)
+}
+
+// RegisterEndorserWithValidator 注册背书模块，validate在配置校验时检查该模块的配置项
+func RegisterEndorserWithValidator(name string, f NewEndorserFunc, validate sconf.EndorserModuleValidator) {
+	endorserMu.Lock()
+	defer endorserMu.Unlock()
+
+	if f == nil {
+		panic("endorser: Register new func is nil")
 	}
-	res, err := endc.EndorserCall(gctx, req)
-	if err != nil {
-		return resp, err
+	if _, dup := endorsers[name]; dup {
+		panic("endorser: Register called twice for func " + name)
 	}
-	resp.EndorserAddress = res.EndorserAddress
-	resp.ResponseName = res.ResponseName
-	resp.ResponseData = res.ResponseData
-	resp.EndorserSign = res.EndorserSign
-	rctx.GetLog().SetInfoField("bc_name", req.GetBcName())
-	rctx.GetLog().SetInfoField("request_name", req.GetBcName())
-	return resp, nil
+	endorsers[name] = f
+	sconf.RegisterEndorserModule(name, validate)
 }
 
-func (pxe *ProxyXEndorser) getHost() string {
-	host := ""
-	hostCnt := len(pxe.conf.EndorserHosts)
-	if hostCnt > 0 {
-		rand.Seed(time.Now().Unix())
-		index := rand.Intn(hostCnt)
-		host = pxe.conf.EndorserHosts[index]
+// Endorsers 返回已注册的背书模块名
+func Endorsers() []string {
+	endorserMu.RLock()
+	defer endorserMu.RUnlock()
+	list := make([]string, 0, len(endorsers))
+	for name := range endorsers {
+		list = append(list, name)
 	}
-	return host
+	sort.Strings(list)
+	return list
 }
 
-func (pxe *ProxyXEndorser) getClient(host string) (pb.XendorserClient, error) {
-	if host == "" {
-		return nil, fmt.Errorf("empty host")
-	}
-	if c, ok := pxe.clientCache.Load(host); ok {
-		return c.(pb.XendorserClient), nil
+func newEndorserService(cfg *sconf.ServConf, engine ecom.Engine, svr XEndorserServer) (XEndorser, error) {
+	endorserMu.RLock()
+	f, ok := endorsers[cfg.EndorserModule]
+	endorserMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown endorser module.module:%s registered:%v", cfg.EndorserModule, Endorsers())
 	}
 
-	pxe.mutex.Lock()
-	defer pxe.mutex.Unlock()
-	if c, ok := pxe.clientCache.Load(host); ok {
-		return c.(pb.XendorserClient), nil
-	}
-	conn, err := grpc.Dial(host, grpc.WithInsecure())
-	if err != nil {
-		return nil, err
-	}
-	c := pb.NewXendorserClient(conn)
-	pxe.clientCache.Store(host, c)
-	return c, nil
+	return f(engine, cfg, svr)
 }
 
 type XEndorserServer interface {
@@ -120,12 +109,22 @@ type XEndorserServer interface {
 	PreExecWithSelectUTXO(context.Context, *pb.PreExecWithSelectUTXORequest) (*pb.PreExecWithSelectUTXOResponse, error)
 	// 预执行合约
 	PreExec(context.Context, *pb.InvokeRPCRequest) (*pb.InvokeRPCResponse, error)
+	// GetBlock 查询区块内容
+	GetBlock(context.Context, *pb.BlockID) (*pb.Block, error)
+	// ConfirmBlockChainStatus 查询区块是否为主干最新区块
+	ConfirmBlockChainStatus(context.Context, *pb.BCStatus) (*pb.BCTipStatus, error)
 }
 
 type DefaultXEndorser struct {
 	svr         XEndorserServer
 	requestType map[string]bool
 	engine      ecom.Engine
+	keyring     *endorserKeyring
+	// 合规检查规则，为空表示不检查交易内容
+	compliance *compliance.Checker
+	fee        *endorserFee
+	// 背书记录库，为空表示不保存背书记录
+	audit *endorserAudit
 }
 
 var _ XEndorser = (*DefaultXEndorser)(nil)
@@ -135,17 +134,89 @@ const (
 	DefaultKeyPath = "./data/endorser/keys/"
 )
 
+// NewDefaultXEndorser 使用DefaultKeyPath下的背书密钥，首次签名时加载
 func NewDefaultXEndorser(svr XEndorserServer, engine ecom.Engine) *DefaultXEndorser {
+	return newDefaultXEndorser(svr, engine, nil)
+}
+
+// NewDefaultXEndorserWithConf 按server.yaml的endorserKeys为每条链选择背书密钥，
+// 创建时即加载全部密钥，密钥不可用时返回错误
+func NewDefaultXEndorserWithConf(svr XEndorserServer, engine ecom.Engine,
+	cfg *sconf.ServConf) (*DefaultXEndorser, error) {
+	dxe := newDefaultXEndorser(svr, engine, cfg.EndorserKeys)
+	if err := dxe.keyring.loadAll(); err != nil {
+		return nil, err
+	}
+
+	if cfg.EndorserComplianceRules != "" {
+		file := cfg.EndorserComplianceRules
+		if !filepath.IsAbs(file) && engine != nil {
+			file = engine.Context().EnvCfg.GenConfFilePath(file)
+		}
+		checker, err := compliance.NewChecker(file)
+		if err != nil {
+			return nil, err
+		}
+		dxe.compliance = checker
+	}
+
+	if cfg.EndorserAuditPath != "" {
+		path := cfg.EndorserAuditPath
+		if !filepath.IsAbs(path) && engine != nil {
+			path = engine.Context().EnvCfg.GenDataAbsPath(path)
+		}
+		audit, err := openEndorserAudit(path)
+		if err != nil {
+			return nil, fmt.Errorf("open endorser audit failed.path:%s err:%v", path, err)
+		}
+		dxe.audit = audit
+	}
+
+	fee, err := newEndorserFee(cfg.EndorserFees, dxe.audit)
+	if err != nil {
+		dxe.Close()
+		return nil, err
+	}
+	dxe.fee = fee
+	return dxe, nil
+}
+
+func newDefaultXEndorser(svr XEndorserServer, engine ecom.Engine, keys []sconf.EndorserKeyConf) *DefaultXEndorser {
 	return &DefaultXEndorser{
 		requestType: map[string]bool{
 			"PreExecWithFee":    true,
 			"ComplianceCheck":   true,
 			"CrossQueryPreExec": true,
 			"TxQuery":           true,
+			"TxQueryWithProof":  true,
 		},
-		svr:    svr,
-		engine: engine,
+		svr:     svr,
+		engine:  engine,
+		keyring: newEndorserKeyring(engine, keys),
+		fee:     &endorserFee{},
+	}
+}
+
+// GetEndorserInfo 返回指定链的背书地址和公钥，供客户端在背书前确认
+func (dxe *DefaultXEndorser) GetEndorserInfo(ctx context.Context,
+	req *pb.EndorserInfoRequest) (*pb.EndorserInfoResponse, error) {
+	resHeader := &pb.Header{
+		Logid: req.GetHeader().GetLogid(),
+		Error: pb.XChainErrorEnum_SUCCESS,
+	}
+	res := &pb.EndorserInfoResponse{
+		Header: resHeader,
+		BcName: req.GetBcName(),
+	}
+
+	signer, err := dxe.keyring.signer(req.GetBcName())
+	if err != nil {
+		resHeader.Error = pb.XChainErrorEnum_SERVICE_REFUSED_ERROR
+		return res, err
 	}
+	res.EndorserAddress = signer.Address()
+	res.PublicKey = signer.PublicKey()
+	return res, nil
 }
 
 // EndorserCall process endorser call
@@ -172,12 +243,26 @@ func (dxe *DefaultXEndorser) EndorserCall(ctx context.Context, req *pb.EndorserR
 
 	switch req.GetRequestName() {
 	case "ComplianceCheck":
+		quota, err := dxe.checkCompliance(req)
+		if err != nil {
+			resHeader.Error = pb.XChainErrorEnum_SERVICE_REFUSED_ERROR
+			return dxe.generateErrorResponse(req, resHeader, err)
+		}
+		// 背书成功后才计入发起者当天的转账额度，失败时释放预留
+		endorsed := false
+		defer func() {
+			if endorsed {
+				quota.Commit()
+			} else {
+				quota.Release()
+			}
+		}()
 		success, errcode, err := dxe.processFee(ctx, req)
 		if err != nil || !success {
 			resHeader.Error = errcode
 			return dxe.generateErrorResponse(req, resHeader, err)
 		}
-		addr, sign, err := dxe.generateTxSign(ctx, req)
+		digest, addr, sign, err := dxe.generateTxSign(ctx, req)
 		if err != nil {
 			resHeader.Error = pb.XChainErrorEnum_SERVICE_REFUSED_ERROR
 			return dxe.generateErrorResponse(req, resHeader, err)
@@ -193,6 +278,11 @@ func (dxe *DefaultXEndorser) EndorserCall(ctx context.Context, req *pb.EndorserR
 			resHeader.Error = pb.XChainErrorEnum_SERVICE_REFUSED_ERROR
 			return dxe.generateErrorResponse(req, resHeader, err)
 		}
+		if err := dxe.recordEndorsement(ctx, req, resData, digest, addr, sign); err != nil {
+			resHeader.Error = pb.XChainErrorEnum_UNKNOW_ERROR
+			return dxe.generateErrorResponse(req, resHeader, err)
+		}
+		endorsed = true
 		return dxe.generateSuccessResponse(req, resData, addr, sign, resHeader)
 
 	case "PreExecWithFee":
@@ -209,33 +299,42 @@ func (dxe *DefaultXEndorser) EndorserCall(ctx context.Context, req *pb.EndorserR
 			resHeader.Error = errcode
 			return dxe.generateErrorResponse(req, resHeader, err)
 		}
-		data := append(req.RequestData[:], resData[:]...)
-		digest := hash.UsingSha256(data)
-		addr, sign, err := dxe.signData(ctx, digest, DefaultKeyPath)
-		if err != nil {
-			resHeader.Error = errcode
-			return dxe.generateErrorResponse(req, resHeader, err)
-		}
-		return dxe.generateSuccessResponse(req, resData, addr, sign, resHeader)
-	case "TxQuery":
-		resData, errcode, err := dxe.getTxResult(ctx, req)
-		if err != nil {
-			resHeader.Error = errcode
-			return dxe.generateErrorResponse(req, resHeader, err)
+		return dxe.signResult(ctx, req, resData, resHeader)
+	case "TxQuery", "TxQueryWithProof":
+		var resData []byte
+		var errcode pb.XChainErrorEnum
+		if req.GetRequestName() == "TxQuery" {
+			resData, errcode, err = dxe.getTxResult(ctx, req)
+		} else {
+			resData, errcode, err = dxe.getTxProofResult(ctx, req)
 		}
-		data := append(req.RequestData[:], resData[:]...)
-		digest := hash.UsingSha256(data)
-		addr, sign, err := dxe.signData(ctx, digest, DefaultKeyPath)
 		if err != nil {
 			resHeader.Error = errcode
 			return dxe.generateErrorResponse(req, resHeader, err)
 		}
-		return dxe.generateSuccessResponse(req, resData, addr, sign, resHeader)
+		return dxe.signResult(ctx, req, resData, resHeader)
 	}
 
 	return nil, nil
 }
 
+// signResult 对请求和查询结果一起签名并保存背书记录，签名失败时拒绝服务
+func (dxe *DefaultXEndorser) signResult(ctx context.Context, req *pb.EndorserRequest, resData []byte,
+	resHeader *pb.Header) (*pb.EndorserResponse, error) {
+	data := append(req.RequestData[:], resData[:]...)
+	digest := hash.UsingSha256(data)
+	addr, sign, err := dxe.signData(ctx, req.GetBcName(), digest)
+	if err != nil {
+		resHeader.Error = pb.XChainErrorEnum_SERVICE_REFUSED_ERROR
+		return dxe.generateErrorResponse(req, resHeader, err)
+	}
+	if err := dxe.recordEndorsement(ctx, req, resData, digest, addr, sign); err != nil {
+		resHeader.Error = pb.XChainErrorEnum_UNKNOW_ERROR
+		return dxe.generateErrorResponse(req, resHeader, err)
+	}
+	return dxe.generateSuccessResponse(req, resData, addr, sign, resHeader)
+}
+
 func (dxe *DefaultXEndorser) getPreExecResult(ctx context.Context, req *pb.EndorserRequest) ([]byte, pb.XChainErrorEnum, error) {
 	request := &pb.PreExecWithSelectUTXORequest{}
 	err := json.Unmarshal(req.GetRequestData(), request)
@@ -321,12 +420,119 @@ func (dxe *DefaultXEndorser) getTxResult(ctx context.Context, req *pb.EndorserRe
 	return sData, pb.XChainErrorEnum_SUCCESS, nil
 }
 
+// getTxProofResult 查询交易及其所在区块、merkle路径和主干状态
+func (dxe *DefaultXEndorser) getTxProofResult(ctx context.Context, req *pb.EndorserRequest) ([]byte, pb.XChainErrorEnum, error) {
+	request := &pb.TxStatus{}
+	if err := json.Unmarshal(req.GetRequestData(), request); err != nil {
+		return nil, pb.XChainErrorEnum_SERVICE_REFUSED_ERROR, err
+	}
+
+	reply, err := dxe.svr.QueryTx(ctx, request)
+	if err != nil {
+		return nil, reply.GetHeader().GetError(), err
+	}
+	if reply.GetTx() == nil {
+		return nil, reply.GetHeader().GetError(), errors.New("tx not found")
+	}
+	if reply.GetStatus() != pb.TransactionStatus_CONFIRM && reply.GetStatus() != pb.TransactionStatus_FURCATION {
+		return nil, pb.XChainErrorEnum_SERVICE_REFUSED_ERROR, errors.New("tx is not in any block")
+	}
+
+	blockid := reply.GetTx().GetBlockid()
+	block, err := dxe.svr.GetBlock(ctx, &pb.BlockID{
+		Bcname:      request.GetBcname(),
+		Blockid:     blockid,
+		NeedContent: true,
+	})
+	if err != nil {
+		return nil, pb.XChainErrorEnum_UNKNOW_ERROR, err
+	}
+	proof, err := makeTxQueryProof(block.GetBlock(), request.GetTxid())
+	if err != nil {
+		return nil, pb.XChainErrorEnum_UNKNOW_ERROR, err
+	}
+
+	tipStatus, err := dxe.svr.ConfirmBlockChainStatus(ctx, &pb.BCStatus{
+		Bcname: request.GetBcname(),
+		Block:  &pb.InternalBlock{Blockid: blockid},
+	})
+	if err != nil {
+		return nil, pb.XChainErrorEnum_UNKNOW_ERROR, err
+	}
+	proof.Tx = reply.GetTx()
+	proof.Status = reply.GetStatus()
+	proof.Distance = reply.GetDistance()
+	proof.IsTrunkTip = tipStatus.GetIsTrunkTip()
+
+	sData, err := json.Marshal(proof)
+	if err != nil {
+		return nil, pb.XChainErrorEnum_SERVICE_REFUSED_ERROR, err
+	}
+	return sData, pb.XChainErrorEnum_SUCCESS, nil
+}
+
+// makeTxQueryProof 生成txid在区块中的merkle路径
+func makeTxQueryProof(block *pb.InternalBlock, txid []byte) (*pb.TxQueryProof, error) {
+	index, path, err := scom.MakeBlockMerklePath(block, txid)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TxQueryProof{
+		Blockid:    block.GetBlockid(),
+		Height:     block.GetHeight(),
+		MerkleRoot: block.GetMerkleRoot(),
+		TxIndex:    index,
+		MerklePath: path,
+	}, nil
+}
+
+// checkCompliance 按合规规则检查待背书交易，拒绝时错误中包含原因；
+// 通过时返回预留的当天转账额度，未配置规则时返回nil
+func (dxe *DefaultXEndorser) checkCompliance(req *pb.EndorserRequest) (*compliance.Reservation, error) {
+	if dxe.compliance == nil {
+		return nil, nil
+	}
+
+	txStatus := &pb.TxStatus{}
+	if err := json.Unmarshal(req.GetRequestData(), txStatus); err != nil {
+		return nil, err
+	}
+	return dxe.compliance.Check(txStatus.GetTx())
+}
+
 func (dxe *DefaultXEndorser) processFee(ctx context.Context, req *pb.EndorserRequest) (bool, pb.XChainErrorEnum, error) {
+	rule := dxe.fee.rule(req.GetBcName())
 	if req.GetFee() == nil {
+		if rule != nil {
+			return false, pb.XChainErrorEnum_SERVICE_REFUSED_ERROR, errors.New("endorser fee is required")
+		}
 		// no fee provided, default to true
 		return true, pb.XChainErrorEnum_SUCCESS, nil
 	}
 
+	first := false
+	if rule != nil {
+		txStatus := &pb.TxStatus{}
+		if err := json.Unmarshal(req.GetRequestData(), txStatus); err != nil {
+			return false, pb.XChainErrorEnum_SERVICE_REFUSED_ERROR, err
+		}
+		binding, err := dxe.fee.verify(rule, txStatus.GetTx(), req.GetFee())
+		if err != nil {
+			return false, pb.XChainErrorEnum_SERVICE_REFUSED_ERROR, fmt.Errorf("verify endorser fee failed.err:%v", err)
+		}
+
+		// 同一笔服务费交易只能用于同一绑定的交易
+		first, err = dxe.fee.markUsed(req.GetFee().GetTxid(), binding)
+		if err != nil {
+			return false, pb.XChainErrorEnum_SERVICE_REFUSED_ERROR, err
+		}
+		// 多个背书节点共用一笔服务费交易，已被其他节点提交时不再重复提交；
+		// 服务费交易已与被背书交易绑定，不能用于其他交易
+		if dxe.feeOnChain(ctx, req) {
+			return true, pb.XChainErrorEnum_SUCCESS, nil
+		}
+	}
+
 	txStatus := &pb.TxStatus{
 		Txid:   req.GetFee().GetTxid(),
 		Bcname: req.GetBcName(),
@@ -334,61 +540,172 @@ func (dxe *DefaultXEndorser) processFee(ctx context.Context, req *pb.EndorserReq
 	}
 
 	res, err := dxe.svr.PostTx(ctx, txStatus)
+	if err == nil && res.GetHeader().GetError() != pb.XChainErrorEnum_SUCCESS {
+		err = errors.New("Fee post to chain failed")
+	}
 	if err != nil {
+		// 其他背书节点同时提交了同一笔服务费交易
+		if rule != nil && dxe.feeOnChain(ctx, req) {
+			return true, pb.XChainErrorEnum_SUCCESS, nil
+		}
+		if first {
+			dxe.fee.unmarkUsed(req.GetFee().GetTxid())
+		}
 		return false, res.GetHeader().GetError(), err
-	} else if res.GetHeader().GetError() != pb.XChainErrorEnum_SUCCESS {
-		return false, res.GetHeader().GetError(), errors.New("Fee post to chain failed")
 	}
 
 	return true, pb.XChainErrorEnum_SUCCESS, nil
 }
 
-func (dxe *DefaultXEndorser) generateTxSign(ctx context.Context, req *pb.EndorserRequest) ([]byte, *pb.SignatureInfo, error) {
+// feeOnChain 服务费交易是否已上链或已在未确认交易中
+func (dxe *DefaultXEndorser) feeOnChain(ctx context.Context, req *pb.EndorserRequest) bool {
+	onChain, err := dxe.svr.QueryTx(ctx, &pb.TxStatus{Bcname: req.GetBcName(), Txid: req.GetFee().GetTxid()})
+	return err == nil && onChain.GetTx() != nil
+}
+
+// generateTxSign 对交易摘要签名，返回摘要、背书地址和签名
+func (dxe *DefaultXEndorser) generateTxSign(ctx context.Context, req *pb.EndorserRequest) ([]byte, []byte, *pb.SignatureInfo, error) {
 	if req.GetRequestData() == nil {
-		return nil, nil, errors.New("request data is empty")
+		return nil, nil, nil, errors.New("request data is empty")
 	}
 
 	txStatus := &pb.TxStatus{}
 	err := json.Unmarshal(req.GetRequestData(), txStatus)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	tx := scom.TxToXledger(txStatus.GetTx())
 	digest, err := txhash.MakeTxDigestHash(tx)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
-	return dxe.signData(ctx, digest, DefaultKeyPath)
+	addr, sign, err := dxe.signData(ctx, req.GetBcName(), digest)
+	return digest, addr, sign, err
 }
 
-func (dxe *DefaultXEndorser) signData(ctx context.Context, data []byte, keypath string) ([]byte, *pb.SignatureInfo, error) {
-	addr, jsonSKey, jsonAKey, err := dxe.getEndorserKey(keypath)
+func (dxe *DefaultXEndorser) signData(ctx context.Context, bcname string, data []byte) ([]byte, *pb.SignatureInfo, error) {
+	signer, err := dxe.keyring.signer(bcname)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	cryptoClient, err := crypto_client.CreateCryptoClientFromJSONPrivateKey(jsonSKey)
+	sign, err := signer.Sign(data)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	privateKey, err := cryptoClient.GetEcdsaPrivateKeyFromJsonStr(string(jsonSKey))
+	signInfo := &pb.SignatureInfo{
+		PublicKey: signer.PublicKey(),
+		Sign:      sign,
+	}
+	return []byte(signer.Address()), signInfo, nil
+}
+
+// recordEndorsement 保存背书记录，保存失败时不返回签名，保证签过的内容都有据可查
+func (dxe *DefaultXEndorser) recordEndorsement(ctx context.Context, req *pb.EndorserRequest, resData []byte,
+	digest []byte, addr []byte, sign *pb.SignatureInfo) error {
+	if dxe.audit == nil {
+		return nil
+	}
+
+	clientIp, _ := dxe.getClietIP(ctx)
+	record := &pb.EndorsementRecord{
+		BcName:          req.GetBcName(),
+		RequestName:     req.GetRequestName(),
+		Txid:            endorsedTxid(req),
+		RequestHash:     hash.UsingSha256(req.GetRequestData()),
+		ResponseHash:    hash.UsingSha256(resData),
+		Digest:          digest,
+		EndorserAddress: string(addr),
+		EndorserSign:    sign,
+		ClientIp:        clientIp,
+		Logid:           req.GetHeader().GetLogid(),
+		Timestamp:       time.Now().UnixNano(),
+	}
+	if err := dxe.audit.append(record); err != nil {
+		return fmt.Errorf("record endorsement failed.err:%v", err)
+	}
+	return nil
+}
+
+// QueryEndorsement 按txid或请求哈希查询背书记录；按txid未找到时，
+// 从链上查询该交易并按交易摘要匹配，用于查询ComplianceCheck背书过的交易
+func (dxe *DefaultXEndorser) QueryEndorsement(ctx context.Context,
+	req *pb.QueryEndorsementRequest) (*pb.QueryEndorsementResponse, error) {
+	resHeader := &pb.Header{
+		Logid: req.GetHeader().GetLogid(),
+		Error: pb.XChainErrorEnum_SUCCESS,
+	}
+	res := &pb.QueryEndorsementResponse{Header: resHeader}
+	if dxe.audit == nil {
+		resHeader.Error = pb.XChainErrorEnum_SERVICE_REFUSED_ERROR
+		return res, errors.New("endorser audit is disabled")
+	}
+
+	var err error
+	switch {
+	case len(req.GetRequestHash()) > 0:
+		res.Records, err = dxe.audit.queryByRequestHash(req.GetRequestHash())
+	case len(req.GetTxid()) > 0:
+		res.Records, err = dxe.audit.queryByTxid(req.GetTxid())
+		if err == nil && len(res.Records) == 0 && req.GetBcName() != "" {
+			res.Records, err = dxe.queryByChainTx(ctx, req)
+		}
+	default:
+		resHeader.Error = pb.XChainErrorEnum_VALIDATE_ERROR
+		return res, errors.New("txid or request hash is required")
+	}
 	if err != nil {
-		return nil, nil, err
+		resHeader.Error = pb.XChainErrorEnum_UNKNOW_ERROR
+		return res, err
 	}
+	return res, nil
+}
 
-	sign, err := cryptoClient.SignECDSA(privateKey, data)
+func (dxe *DefaultXEndorser) queryByChainTx(ctx context.Context,
+	req *pb.QueryEndorsementRequest) ([]*pb.EndorsementRecord, error) {
+	reqCtx, err := dxe.createReqCtx(ctx, req.GetHeader())
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
+	ctx = sctx.WithReqCtx(ctx, reqCtx)
 
-	signInfo := &pb.SignatureInfo{
-		PublicKey: string(jsonAKey),
-		Sign:      sign,
+	txStatus, err := dxe.svr.QueryTx(ctx, &pb.TxStatus{Bcname: req.GetBcName(), Txid: req.GetTxid()})
+	if err != nil || txStatus.GetTx() == nil {
+		// 链上不存在该交易时没有背书记录
+		return nil, nil
+	}
+	digest, err := scom.MakeTxDigestHash(txStatus.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	return dxe.audit.queryByDigest(digest)
+}
+
+// Close 关闭背书记录库
+func (dxe *DefaultXEndorser) Close() {
+	if dxe.audit != nil {
+		dxe.audit.close()
+	}
+}
+
+// endorsedTxid 被背书或被查询的交易id
+func endorsedTxid(req *pb.EndorserRequest) []byte {
+	switch req.GetRequestName() {
+	case "ComplianceCheck", "TxQuery", "TxQueryWithProof":
+	default:
+		return nil
+	}
+	txStatus := &pb.TxStatus{}
+	if err := json.Unmarshal(req.GetRequestData(), txStatus); err != nil {
+		return nil
 	}
-	return addr, signInfo, nil
+	if len(txStatus.GetTxid()) > 0 {
+		return txStatus.GetTxid()
+	}
+	return txStatus.GetTx().GetTxid()
 }
 
 func (dxe *DefaultXEndorser) generateErrorResponse(req *pb.EndorserRequest, header *pb.Header,
@@ -412,21 +729,6 @@ func (dxe *DefaultXEndorser) generateSuccessResponse(req *pb.EndorserRequest, re
 	return res, nil
 }
 
-func (dxe *DefaultXEndorser) getEndorserKey(keypath string) ([]byte, []byte, []byte, error) {
-	sk, err := ioutil.ReadFile(keypath + "private.key")
-	if err != nil {
-		return nil, nil, nil, err
-	}
-
-	ak, err := ioutil.ReadFile(keypath + "public.key")
-	if err != nil {
-		return nil, nil, nil, err
-	}
-
-	addr, err := ioutil.ReadFile(keypath + "address")
-	return addr, sk, ak, err
-}
-
 func (dxe *DefaultXEndorser) createReqCtx(gctx context.Context, reqHeader *pb.Header) (sctx.ReqCtx, error) {
 	// 获取客户端ip
 	clientIp, err := dxe.getClietIP(gctx)
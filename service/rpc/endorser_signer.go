@@ -0,0 +1,373 @@
+package rpc
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/xuperchain/crypto/core/hdwallet/key"
+
+	sconf "github.com/xuperchain/xuperchain/service/config"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	crypto_client "github.com/xuperchain/xupercore/lib/crypto/client"
+	crypto_base "github.com/xuperchain/xupercore/lib/crypto/client/base"
+)
+
+// EndorserSigner 背书签名器，私钥的保存方式由具体实现决定
+type EndorserSigner interface {
+	// Address 背书地址
+	Address() string
+	// PublicKey json格式的背书公钥
+	PublicKey() string
+	// Sign 对摘要签名
+	Sign(digest []byte) ([]byte, error)
+}
+
+// Token PKCS#11风格的密钥令牌，登录后通过标签使用密钥，私钥不离开令牌
+type Token interface {
+	// Login 使用PIN登录令牌
+	Login(pin string) error
+	// PublicKey 返回标签对应密钥的json格式公钥
+	PublicKey(label string) (string, error)
+	// Sign 使用标签对应的私钥对摘要签名
+	Sign(label string, digest []byte) ([]byte, error)
+}
+
+// NewTokenFunc 创建令牌实例的方法，path为令牌配置
+type NewTokenFunc func(path string) (Token, error)
+
+const (
+	// SoftTokenName 软件实现的令牌，可作为硬件令牌的替身
+	SoftTokenName = "soft"
+)
+
+var (
+	tokenMu sync.RWMutex
+	tokens  = make(map[string]NewTokenFunc)
+)
+
+func init() {
+	RegisterToken(SoftTokenName, NewSoftToken)
+}
+
+// RegisterToken 注册密钥令牌实现
+func RegisterToken(name string, f NewTokenFunc) {
+	tokenMu.Lock()
+	defer tokenMu.Unlock()
+
+	if f == nil {
+		panic("token: Register new func is nil")
+	}
+	if _, dup := tokens[name]; dup {
+		panic("token: Register called twice for func " + name)
+	}
+	tokens[name] = f
+}
+
+// Tokens 返回已注册的令牌实现名
+func Tokens() []string {
+	tokenMu.RLock()
+	defer tokenMu.RUnlock()
+	list := make([]string, 0, len(tokens))
+	for name := range tokens {
+		list = append(list, name)
+	}
+	sort.Strings(list)
+	return list
+}
+
+// endorserKeyring 按链选择背书签名器
+type endorserKeyring struct {
+	mutex   sync.Mutex
+	engine  ecom.Engine
+	confs   map[string]sconf.EndorserKeyConf
+	signers map[string]EndorserSigner
+}
+
+func newEndorserKeyring(engine ecom.Engine, confs []sconf.EndorserKeyConf) *endorserKeyring {
+	if len(confs) == 0 {
+		confs = []sconf.EndorserKeyConf{{Type: sconf.EndorserKeyTypeFile, Path: DefaultKeyPath}}
+	}
+	kr := &endorserKeyring{
+		engine:  engine,
+		confs:   make(map[string]sconf.EndorserKeyConf, len(confs)),
+		signers: make(map[string]EndorserSigner, len(confs)),
+	}
+	for _, conf := range confs {
+		kr.confs[conf.BcName] = conf
+	}
+	return kr
+}
+
+// loadAll 加载全部密钥，用于启动时尽早发现配置错误
+func (kr *endorserKeyring) loadAll() error {
+	for bcname := range kr.confs {
+		if _, err := kr.signer(bcname); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// signer 返回链对应的签名器，链未单独配置时使用默认密钥
+func (kr *endorserKeyring) signer(bcname string) (EndorserSigner, error) {
+	kr.mutex.Lock()
+	defer kr.mutex.Unlock()
+
+	conf, ok := kr.confs[bcname]
+	if !ok {
+		bcname = ""
+		if conf, ok = kr.confs[bcname]; !ok {
+			return nil, fmt.Errorf("no endorser key for chain %s", bcname)
+		}
+	}
+	if s, ok := kr.signers[bcname]; ok {
+		return s, nil
+	}
+
+	s, err := kr.newSigner(conf)
+	if err != nil {
+		return nil, fmt.Errorf("load endorser key failed.bcname:%s type:%s err:%v", conf.BcName, conf.Type, err)
+	}
+	kr.signers[bcname] = s
+	return s, nil
+}
+
+func (kr *endorserKeyring) newSigner(conf sconf.EndorserKeyConf) (EndorserSigner, error) {
+	path := kr.absPath(conf.Path)
+	switch conf.Type {
+	case sconf.EndorserKeyTypeFile:
+		return newFileSigner(path)
+	case sconf.EndorserKeyTypeEncrypted:
+		return newEncryptedSigner(path, os.Getenv(conf.PassphraseEnv))
+	case sconf.EndorserKeyTypeToken:
+		tokenMu.RLock()
+		f, ok := tokens[conf.Token]
+		tokenMu.RUnlock()
+		if !ok {
+			return nil, fmt.Errorf("unknown token %s, registered:%v", conf.Token, Tokens())
+		}
+		token, err := f(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := token.Login(os.Getenv(conf.PassphraseEnv)); err != nil {
+			return nil, err
+		}
+		return newTokenSigner(token, conf.Label)
+	}
+	return nil, fmt.Errorf("unknown endorser key type")
+}
+
+func (kr *endorserKeyring) absPath(path string) string {
+	if path == "" || filepath.IsAbs(path) || kr.engine == nil {
+		return path
+	}
+	return kr.engine.Context().EnvCfg.GenDirAbsPath(path)
+}
+
+// keySigner 私钥保存在进程内存中的签名器
+type keySigner struct {
+	address      string
+	publicKey    string
+	privateKey   *ecdsa.PrivateKey
+	cryptoClient crypto_base.CryptoClient
+}
+
+func (s *keySigner) Address() string {
+	return s.address
+}
+
+func (s *keySigner) PublicKey() string {
+	return s.publicKey
+}
+
+func (s *keySigner) Sign(digest []byte) ([]byte, error) {
+	return s.cryptoClient.SignECDSA(s.privateKey, digest)
+}
+
+// newFileSigner 从明文密钥目录加载签名器
+func newFileSigner(path string) (EndorserSigner, error) {
+	sk, err := ioutil.ReadFile(filepath.Join(path, "private.key"))
+	if err != nil {
+		return nil, err
+	}
+	return newKeySigner(sk)
+}
+
+// newEncryptedSigner 使用口令解密私钥后加载签名器，私钥格式与xchain-cli account decrypt一致
+func newEncryptedSigner(path, passphrase string) (EndorserSigner, error) {
+	if passphrase == "" {
+		return nil, fmt.Errorf("passphrase is empty")
+	}
+	sk, err := key.GetBinaryEcdsaPrivateKeyFromFile(dirPath(path), passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt private key failed, please check the passphrase.err:%v", err)
+	}
+	return newKeySigner(sk)
+}
+
+// newKeySigner 根据json格式私钥生成签名器，地址和公钥由私钥推导，避免与私钥不匹配
+func newKeySigner(jsonSKey []byte) (*keySigner, error) {
+	cryptoClient, err := crypto_client.CreateCryptoClientFromJSONPrivateKey(jsonSKey)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := cryptoClient.GetEcdsaPrivateKeyFromJsonStr(string(jsonSKey))
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := cryptoClient.GetEcdsaPublicKeyJsonFormatStr(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	address, err := cryptoClient.GetAddressFromPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &keySigner{
+		address:      address,
+		publicKey:    publicKey,
+		privateKey:   privateKey,
+		cryptoClient: cryptoClient,
+	}, nil
+}
+
+// tokenSigner 使用令牌中的密钥签名
+type tokenSigner struct {
+	token     Token
+	label     string
+	address   string
+	publicKey string
+}
+
+func newTokenSigner(token Token, label string) (EndorserSigner, error) {
+	publicKey, err := token.PublicKey(label)
+	if err != nil {
+		return nil, err
+	}
+	cryptoClient, err := crypto_client.CreateCryptoClientFromJSONPublicKey([]byte(publicKey))
+	if err != nil {
+		return nil, err
+	}
+	pk, err := cryptoClient.GetEcdsaPublicKeyFromJsonStr(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	address, err := cryptoClient.GetAddressFromPublicKey(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tokenSigner{
+		token:     token,
+		label:     label,
+		address:   address,
+		publicKey: publicKey,
+	}, nil
+}
+
+func (s *tokenSigner) Address() string {
+	return s.address
+}
+
+func (s *tokenSigner) PublicKey() string {
+	return s.publicKey
+}
+
+func (s *tokenSigner) Sign(digest []byte) ([]byte, error) {
+	return s.token.Sign(s.label, digest)
+}
+
+// SoftToken 软件令牌，path目录下每个子目录是一个密钥，子目录名为标签；
+// 登录PIN非空时子目录中的private.key为PIN加密的私钥，否则为明文私钥
+type SoftToken struct {
+	mutex  sync.Mutex
+	path   string
+	pin    string
+	login  bool
+	labels map[string]*keySigner
+}
+
+var _ Token = (*SoftToken)(nil)
+
+func NewSoftToken(path string) (Token, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		return nil, fmt.Errorf("soft token path %s is not a directory", path)
+	}
+	return &SoftToken{
+		path:   path,
+		labels: make(map[string]*keySigner),
+	}, nil
+}
+
+func (t *SoftToken) Login(pin string) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.pin = pin
+	t.login = true
+	return nil
+}
+
+func (t *SoftToken) PublicKey(label string) (string, error) {
+	s, err := t.findKey(label)
+	if err != nil {
+		return "", err
+	}
+	return s.PublicKey(), nil
+}
+
+func (t *SoftToken) Sign(label string, digest []byte) ([]byte, error) {
+	s, err := t.findKey(label)
+	if err != nil {
+		return nil, err
+	}
+	return s.Sign(digest)
+}
+
+func (t *SoftToken) findKey(label string) (*keySigner, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if !t.login {
+		return nil, fmt.Errorf("soft token not login")
+	}
+	if s, ok := t.labels[label]; ok {
+		return s, nil
+	}
+
+	path := filepath.Join(t.path, label)
+	var sk []byte
+	var err error
+	if t.pin == "" {
+		sk, err = ioutil.ReadFile(filepath.Join(path, "private.key"))
+	} else {
+		sk, err = key.GetBinaryEcdsaPrivateKeyFromFile(dirPath(path), t.pin)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("key %s not found in soft token.err:%v", label, err)
+	}
+	s, err := newKeySigner(sk)
+	if err != nil {
+		return nil, err
+	}
+	t.labels[label] = s
+	return s, nil
+}
+
+// dirPath xuperchain/crypto按前缀拼接文件名，目录需要以分隔符结尾
+func dirPath(path string) string {
+	if strings.HasSuffix(path, string(filepath.Separator)) {
+		return path
+	}
+	return path + string(filepath.Separator)
+}
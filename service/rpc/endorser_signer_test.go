@@ -0,0 +1,126 @@
+package rpc
+
+import (
+	"context"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/xuperchain/crypto/core/hdwallet/key"
+
+	sconf "github.com/xuperchain/xuperchain/service/config"
+	"github.com/xuperchain/xuperchain/service/pb"
+	crypto_client "github.com/xuperchain/xupercore/lib/crypto/client"
+)
+
+func newTestKey(t *testing.T, dir, pin string) string {
+	cryptoClient, err := crypto_client.CreateCryptoClient(crypto_client.CryptoTypeDefault)
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.MkdirAll(dir, 0755)
+	if err := cryptoClient.ExportNewAccount(dir); err != nil {
+		t.Fatal(err)
+	}
+	addr, _ := ioutil.ReadFile(filepath.Join(dir, "address"))
+	if pin == "" {
+		return string(addr)
+	}
+
+	sk, _ := ioutil.ReadFile(filepath.Join(dir, "private.key"))
+	cipher, err := key.EncryptByKey(string(sk), pin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := base64.StdEncoding.EncodeToString([]byte(cipher))
+	ioutil.WriteFile(filepath.Join(dir, "private.key"), []byte(content), 0600)
+	return string(addr)
+}
+
+func TestEndorserKeyring(t *testing.T) {
+	dir, err := ioutil.TempDir("", "endorser_keys")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	plainAddr := newTestKey(t, filepath.Join(dir, "plain"), "")
+	encAddr := newTestKey(t, filepath.Join(dir, "enc"), "123456")
+	tokenAddr := newTestKey(t, filepath.Join(dir, "token", "endorser"), "654321")
+	os.Setenv("TEST_ENDORSER_PASS", "123456")
+	os.Setenv("TEST_ENDORSER_PIN", "654321")
+	defer os.Unsetenv("TEST_ENDORSER_PASS")
+	defer os.Unsetenv("TEST_ENDORSER_PIN")
+
+	cfg := &sconf.ServConf{
+		EndorserKeys: []sconf.EndorserKeyConf{
+			{Type: sconf.EndorserKeyTypeFile, Path: filepath.Join(dir, "plain")},
+			{BcName: "enc", Type: sconf.EndorserKeyTypeEncrypted, Path: filepath.Join(dir, "enc"),
+				PassphraseEnv: "TEST_ENDORSER_PASS"},
+			{BcName: "token", Type: sconf.EndorserKeyTypeToken, Path: filepath.Join(dir, "token"),
+				PassphraseEnv: "TEST_ENDORSER_PIN", Token: SoftTokenName, Label: "endorser"},
+		},
+	}
+	dxe, err := NewDefaultXEndorserWithConf(nil, nil, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]string{
+		"xuper": plainAddr,
+		"enc":   encAddr,
+		"token": tokenAddr,
+	}
+	for bcname, addr := range cases {
+		info, err := dxe.GetEndorserInfo(context.TODO(), &pb.EndorserInfoRequest{BcName: bcname})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.EndorserAddress != addr {
+			t.Errorf("chain %s expect address %s, got %s", bcname, addr, info.EndorserAddress)
+		}
+
+		signAddr, sign, err := dxe.signData(context.TODO(), bcname, []byte("0123456789abcdef0123456789abcdef"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(signAddr) != addr || sign.PublicKey != info.PublicKey {
+			t.Errorf("chain %s signed by unexpected key", bcname)
+		}
+	}
+
+	// 口令错误时创建失败
+	os.Setenv("TEST_ENDORSER_PASS", "wrong")
+	if _, err := NewDefaultXEndorserWithConf(nil, nil, cfg); err == nil {
+		t.Error("expect error with wrong passphrase")
+	}
+}
+
+func TestSignResult(t *testing.T) {
+	dir := t.TempDir()
+	addr := newTestKey(t, dir, "")
+	dxe := newDefaultXEndorser(nil, nil, []sconf.EndorserKeyConf{
+		{BcName: "xuper", Type: sconf.EndorserKeyTypeFile, Path: dir},
+	})
+
+	req := &pb.EndorserRequest{RequestName: "TxQuery", BcName: "xuper", RequestData: []byte("request")}
+	res, err := dxe.signResult(context.TODO(), req, []byte("result"), &pb.Header{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.GetHeader().GetError() != pb.XChainErrorEnum_SUCCESS || res.GetEndorserAddress() != addr {
+		t.Fatalf("unexpected response %v", res)
+	}
+
+	// 没有该链的背书密钥时签名失败，拒绝服务而不是返回SUCCESS
+	req.BcName = "other"
+	res, err = dxe.signResult(context.TODO(), req, []byte("result"), &pb.Header{})
+	if err == nil {
+		t.Fatal("expect sign to fail without endorser key")
+	}
+	if res.GetHeader().GetError() != pb.XChainErrorEnum_SERVICE_REFUSED_ERROR || res.GetEndorserSign() != nil {
+		t.Fatalf("unexpected response %v", res)
+	}
+}
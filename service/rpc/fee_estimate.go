@@ -0,0 +1,120 @@
+package rpc
+
+import (
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo"
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/protos"
+)
+
+const (
+	// EstimateFee默认和最多参考的最近区块数
+	defaultFeeRecentBlocks = 20
+	maxFeeRecentBlocks     = 100
+	// 每条链缓存的手续费中位数个数，超过时清空
+	maxFeeCacheEntries = 1024
+)
+
+type feeMedian struct {
+	samples int64
+	median  int64
+}
+
+// feeMedianCache 按链的最新区块缓存手续费中位数，最新区块变化前相同的请求不再重新读取区块
+type feeMedianCache struct {
+	mutex  sync.Mutex
+	chains map[string]*chainFeeMedians
+}
+
+type chainFeeMedians struct {
+	tip     string
+	medians map[string]feeMedian
+}
+
+func newFeeMedianCache() *feeMedianCache {
+	return &feeMedianCache{chains: make(map[string]*chainFeeMedians)}
+}
+
+// feeCacheKey 缓存key，由参考区块数和排序后的合约方法组成
+func feeCacheKey(recentBlocks int64, methods map[string]bool) string {
+	keys := make([]string, 0, len(methods))
+	for method := range methods {
+		keys = append(keys, method)
+	}
+	sort.Strings(keys)
+	return strconv.FormatInt(recentBlocks, 10) + ":" + strings.Join(keys, ",")
+}
+
+func (c *feeMedianCache) get(bcname string, tip []byte, key string) (feeMedian, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	chain, ok := c.chains[bcname]
+	if !ok || chain.tip != string(tip) {
+		return feeMedian{}, false
+	}
+	median, ok := chain.medians[key]
+	return median, ok
+}
+
+func (c *feeMedianCache) put(bcname string, tip []byte, key string, median feeMedian) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	chain, ok := c.chains[bcname]
+	if !ok || chain.tip != string(tip) || len(chain.medians) >= maxFeeCacheEntries {
+		chain = &chainFeeMedians{tip: string(tip), medians: make(map[string]feeMedian)}
+		c.chains[bcname] = chain
+	}
+	chain.medians[key] = median
+}
+
+// contractMethods 被调用的合约方法集合，key为contract/method
+func contractMethods(reqs []*protos.InvokeRequest) map[string]bool {
+	methods := make(map[string]bool, len(reqs))
+	for _, req := range reqs {
+		methods[req.GetContractName()+"/"+req.GetMethodName()] = true
+	}
+	return methods
+}
+
+// recentFeeMedian 统计最近区块中调用相同合约方法的交易所付手续费的中位数
+func recentFeeMedian(blocks []*lpb.InternalBlock, methods map[string]bool) (int64, int64) {
+	var fees []int64
+	for _, block := range blocks {
+		for _, tx := range block.GetTransactions() {
+			if !invokesAny(tx, methods) {
+				continue
+			}
+			fees = append(fees, txFeeAmount(tx))
+		}
+	}
+	if len(fees) == 0 {
+		return 0, 0
+	}
+	sort.Slice(fees, func(i, j int) bool { return fees[i] < fees[j] })
+	return int64(len(fees)), fees[len(fees)/2]
+}
+
+func invokesAny(tx *lpb.Transaction, methods map[string]bool) bool {
+	for _, req := range tx.GetContractRequests() {
+		if methods[req.GetContractName()+"/"+req.GetMethodName()] {
+			return true
+		}
+	}
+	return false
+}
+
+// txFeeAmount 交易转给手续费地址的金额
+func txFeeAmount(tx *lpb.Transaction) int64 {
+	fee := big.NewInt(0)
+	for _, output := range tx.GetTxOutputs() {
+		if string(output.GetToAddr()) == utxo.FeePlaceholder {
+			fee.Add(fee, big.NewInt(0).SetBytes(output.GetAmount()))
+		}
+	}
+	return fee.Int64()
+}
@@ -0,0 +1,75 @@
+package rpc
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo"
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/protos"
+)
+
+func newInvokeFeeTx(contract, method string, fee int64) *lpb.Transaction {
+	return &lpb.Transaction{
+		ContractRequests: []*protos.InvokeRequest{{ContractName: contract, MethodName: method}},
+		TxOutputs: []*protos.TxOutput{
+			{ToAddr: []byte("bob"), Amount: big.NewInt(1000).Bytes()},
+			{ToAddr: []byte(utxo.FeePlaceholder), Amount: big.NewInt(fee).Bytes()},
+		},
+	}
+}
+
+func TestRecentFeeMedian(t *testing.T) {
+	blocks := []*lpb.InternalBlock{
+		{Transactions: []*lpb.Transaction{newInvokeFeeTx("counter", "increase", 30), newInvokeFeeTx("counter", "get", 1)}},
+		{Transactions: []*lpb.Transaction{newInvokeFeeTx("counter", "increase", 10), {}}},
+		{Transactions: []*lpb.Transaction{newInvokeFeeTx("counter", "increase", 20)}},
+	}
+	methods := contractMethods([]*protos.InvokeRequest{{ContractName: "counter", MethodName: "increase"}})
+	samples, median := recentFeeMedian(blocks, methods)
+	if samples != 3 || median != 20 {
+		t.Errorf("expect 3 samples with median 20, got %d %d", samples, median)
+	}
+
+	samples, median = recentFeeMedian(blocks, contractMethods(nil))
+	if samples != 0 || median != 0 {
+		t.Errorf("expect no samples, got %d %d", samples, median)
+	}
+}
+
+func TestFeeMedianCache(t *testing.T) {
+	cache := newFeeMedianCache()
+	increase := contractMethods([]*protos.InvokeRequest{{ContractName: "counter", MethodName: "increase"}})
+	key := feeCacheKey(defaultFeeRecentBlocks, increase)
+	if _, ok := cache.get("xuper", []byte("tip1"), key); ok {
+		t.Fatal("empty cache should miss")
+	}
+
+	cache.put("xuper", []byte("tip1"), key, feeMedian{samples: 3, median: 20})
+	if got, ok := cache.get("xuper", []byte("tip1"), key); !ok || got.samples != 3 || got.median != 20 {
+		t.Fatalf("expect cached median 20 of 3 samples, got %+v %v", got, ok)
+	}
+	// 方法集合的顺序不影响key，区块数和链不同时不命中
+	methods := contractMethods([]*protos.InvokeRequest{
+		{ContractName: "counter", MethodName: "get"}, {ContractName: "counter", MethodName: "increase"}})
+	reversed := contractMethods([]*protos.InvokeRequest{
+		{ContractName: "counter", MethodName: "increase"}, {ContractName: "counter", MethodName: "get"}})
+	if feeCacheKey(20, methods) != feeCacheKey(20, reversed) {
+		t.Fatal("cache key should not depend on method order")
+	}
+	if _, ok := cache.get("xuper", []byte("tip1"), feeCacheKey(maxFeeRecentBlocks, increase)); ok {
+		t.Fatal("different recent blocks should miss")
+	}
+	if _, ok := cache.get("other", []byte("tip1"), key); ok {
+		t.Fatal("different chain should miss")
+	}
+
+	// 新区块产生后缓存失效
+	if _, ok := cache.get("xuper", []byte("tip2"), key); ok {
+		t.Fatal("new tip should miss")
+	}
+	cache.put("xuper", []byte("tip2"), key, feeMedian{samples: 4, median: 25})
+	if _, ok := cache.get("xuper", []byte("tip1"), key); ok {
+		t.Fatal("medians of old tip should be dropped")
+	}
+}
@@ -37,6 +37,7 @@ type RpcServMG struct {
 	log      logs.Logger
 	rpcServ  *RpcServ
 	servHD   *grpc.Server
+	endorser XEndorser
 	isInit   bool
 	exitOnce *sync.Once
 }
@@ -134,10 +135,12 @@ func (t *RpcServMG) runRpcServ() error {
 			return fmt.Errorf("failed to register endorser")
 		}
 		pb.RegisterXendorserServer(t.servHD, endorserService)
+		t.endorser = endorserService
 	}
 
 	if t.scfg.EnableMetric {
 		metrics.RegisterMetrics()
+		RegisterMetrics()
 		gpromeus.Register(t.servHD)
 		gpromeus.EnableHandlingTimeHistogram(
 			gpromeus.WithHistogramBuckets(metrics.DefBuckets),
@@ -168,18 +171,7 @@ func (t *RpcServMG) runRpcServ() error {
 
 func (t *RpcServMG) newTls() (credentials.TransportCredentials, error) {
 	envConf := t.engine.Context().EnvCfg
-	tlsPath := envConf.GenDataAbsPath(envConf.TlsDir)
-	bs, err := ioutil.ReadFile(filepath.Join(tlsPath, "cert.crt"))
-	if err != nil {
-		return nil, err
-	}
-	certPool := x509.NewCertPool()
-	ok := certPool.AppendCertsFromPEM(bs)
-	if !ok {
-		return nil, err
-	}
-	certificate, err := tls.LoadX509KeyPair(filepath.Join(tlsPath, "key.pem"),
-		filepath.Join(tlsPath, "private.key"))
+	certPool, certificate, err := loadTlsCerts(envConf.GenDataAbsPath(envConf.TlsDir))
 	if err != nil {
 		return nil, err
 	}
@@ -195,10 +187,32 @@ func (t *RpcServMG) newTls() (credentials.TransportCredentials, error) {
 	return creds, nil
 }
 
+// loadTlsCerts 读取tls目录下的CA证书cert.crt和节点证书key.pem、private.key，rpc服务和背书代理共用
+func loadTlsCerts(tlsPath string) (*x509.CertPool, tls.Certificate, error) {
+	bs, err := ioutil.ReadFile(filepath.Join(tlsPath, "cert.crt"))
+	if err != nil {
+		return nil, tls.Certificate{}, err
+	}
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(bs) {
+		return nil, tls.Certificate{}, fmt.Errorf("append ca cert failed")
+	}
+	certificate, err := tls.LoadX509KeyPair(filepath.Join(tlsPath, "key.pem"),
+		filepath.Join(tlsPath, "private.key"))
+	if err != nil {
+		return nil, tls.Certificate{}, err
+	}
+	return certPool, certificate, nil
+}
+
 // 需要幂等
 func (t *RpcServMG) stopRpcServ() {
 	if t.servHD != nil {
 		// 优雅关闭grpc server
 		t.servHD.GracefulStop()
 	}
+	// 释放背书模块持有的连接等资源
+	if closer, ok := t.endorser.(interface{ Close() }); ok {
+		closer.Close()
+	}
 }
@@ -0,0 +1,107 @@
+package rpc
+
+import (
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+// defaultPendingTxLimit ListPendingTxs默认最多返回的交易数
+const defaultPendingTxLimit = 100
+
+// listPendingTxs 按条件过滤未确认交易，按收到时间从早到晚排列，返回符合条件的总数和前limit笔
+func listPendingTxs(txs []*lpb.Transaction, filter *pb.PendingTxFilter) (int64, []*pb.PendingTx) {
+	pending := make(map[string]bool, len(txs))
+	for _, tx := range txs {
+		pending[string(tx.GetTxid())] = true
+	}
+
+	var matched []*lpb.Transaction
+	for _, tx := range txs {
+		if filter.GetInitiator() != "" && tx.GetInitiator() != filter.GetInitiator() {
+			continue
+		}
+		if filter.GetContract() != "" && !callsContract(tx, filter.GetContract()) {
+			continue
+		}
+		matched = append(matched, tx)
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		return matched[i].GetReceivedTimestamp() < matched[j].GetReceivedTimestamp()
+	})
+
+	limit := filter.GetLimit()
+	if limit <= 0 {
+		limit = defaultPendingTxLimit
+	}
+	total := int64(len(matched))
+	if total > limit {
+		matched = matched[:limit]
+	}
+
+	out := make([]*pb.PendingTx, 0, len(matched))
+	for _, tx := range matched {
+		out = append(out, toPendingTx(tx, pending))
+	}
+	return total, out
+}
+
+func toPendingTx(tx *lpb.Transaction, pending map[string]bool) *pb.PendingTx {
+	ptx := &pb.PendingTx{
+		Txid:              tx.GetTxid(),
+		Initiator:         tx.GetInitiator(),
+		ReceivedTimestamp: tx.GetReceivedTimestamp(),
+		Size:              int64(proto.Size(tx)),
+	}
+	for _, req := range tx.GetContractRequests() {
+		ptx.Contracts = append(ptx.Contracts, req.GetContractName())
+	}
+
+	depends := make(map[string]bool)
+	addDepend := func(refTxid []byte) {
+		if pending[string(refTxid)] && !depends[string(refTxid)] {
+			depends[string(refTxid)] = true
+			ptx.Depends = append(ptx.Depends, refTxid)
+		}
+	}
+	for _, input := range tx.GetTxInputs() {
+		addDepend(input.GetRefTxid())
+	}
+	for _, input := range tx.GetTxInputsExt() {
+		addDepend(input.GetRefTxid())
+	}
+	return ptx
+}
+
+func callsContract(tx *lpb.Transaction, contract string) bool {
+	for _, req := range tx.GetContractRequests() {
+		if req.GetContractName() == contract {
+			return true
+		}
+	}
+	return false
+}
+
+// mempoolStats 统计未确认交易的数量、字节数、最长等待时间和各发起者的交易数
+func mempoolStats(txs []*lpb.Transaction, now time.Time) *pb.GetMempoolStatsResponse {
+	resp := &pb.GetMempoolStatsResponse{
+		InitiatorCounts: make(map[string]int64),
+	}
+	var oldest int64
+	for _, tx := range txs {
+		resp.Count++
+		resp.Bytes += int64(proto.Size(tx))
+		resp.InitiatorCounts[tx.GetInitiator()]++
+		if oldest == 0 || tx.GetReceivedTimestamp() < oldest {
+			oldest = tx.GetReceivedTimestamp()
+		}
+	}
+	if oldest > 0 {
+		resp.OldestAgeMs = (now.UnixNano() - oldest) / int64(time.Millisecond)
+	}
+	return resp
+}
@@ -0,0 +1,45 @@
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/protos"
+
+	"github.com/xuperchain/xuperchain/service/pb"
+)
+
+func TestMempool(t *testing.T) {
+	now := time.Unix(100, 0)
+	txs := []*lpb.Transaction{
+		{Txid: []byte("tx1"), Initiator: "alice", ReceivedTimestamp: now.Add(-3 * time.Second).UnixNano()},
+		{Txid: []byte("tx2"), Initiator: "bob", ReceivedTimestamp: now.Add(-1 * time.Second).UnixNano(),
+			TxInputs:         []*protos.TxInput{{RefTxid: []byte("tx1")}, {RefTxid: []byte("confirmed")}},
+			ContractRequests: []*protos.InvokeRequest{{ContractName: "counter"}}},
+		{Txid: []byte("tx3"), Initiator: "alice", ReceivedTimestamp: now.Add(-2 * time.Second).UnixNano()},
+	}
+
+	total, list := listPendingTxs(txs, nil)
+	if total != 3 || len(list) != 3 || string(list[0].Txid) != "tx1" || string(list[2].Txid) != "tx2" {
+		t.Fatalf("unexpected pending txs: %d %v", total, list)
+	}
+	if len(list[2].Depends) != 1 || string(list[2].Depends[0]) != "tx1" {
+		t.Errorf("expect tx2 depends on tx1 only, got %q", list[2].Depends)
+	}
+
+	total, list = listPendingTxs(txs, &pb.PendingTxFilter{Initiator: "alice", Limit: 1})
+	if total != 2 || len(list) != 1 || string(list[0].Txid) != "tx1" {
+		t.Errorf("unexpected filtered by initiator: %d %v", total, list)
+	}
+	total, list = listPendingTxs(txs, &pb.PendingTxFilter{Contract: "counter"})
+	if total != 1 || string(list[0].Txid) != "tx2" {
+		t.Errorf("unexpected filtered by contract: %d %v", total, list)
+	}
+
+	stats := mempoolStats(txs, now)
+	if stats.Count != 3 || stats.Bytes <= 0 || stats.OldestAgeMs != 3000 ||
+		stats.InitiatorCounts["alice"] != 2 || stats.InitiatorCounts["bob"] != 1 {
+		t.Errorf("unexpected mempool stats: %v", stats)
+	}
+}
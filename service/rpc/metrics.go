@@ -0,0 +1,63 @@
+package rpc
+
+import (
+	"sync"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/xuperchain/xupercore/lib/metrics"
+)
+
+const (
+	SubsystemEndorser = "endorser"
+
+	LabelEndorserHost = "host"
+)
+
+// endorser proxy
+var (
+	EndorserProxyCallCounter = prom.NewCounterVec(
+		prom.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: SubsystemEndorser,
+			Name:      "proxy_call_total",
+			Help:      "Total number of endorser proxy calls per upstream host.",
+		},
+		[]string{LabelEndorserHost, metrics.LabelErrorCode})
+	EndorserProxyCallHistogram = prom.NewHistogramVec(
+		prom.HistogramOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: SubsystemEndorser,
+			Name:      "proxy_call_seconds",
+			Help:      "Histogram of endorser proxy call latency per upstream host.",
+			Buckets:   metrics.DefBuckets,
+		},
+		[]string{LabelEndorserHost})
+	EndorserProxyHealthGauge = prom.NewGaugeVec(
+		prom.GaugeOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: SubsystemEndorser,
+			Name:      "proxy_host_healthy",
+			Help:      "Whether the upstream endorser host passed the last health check.",
+		},
+		[]string{LabelEndorserHost})
+	EndorserProxyBreakerGauge = prom.NewGaugeVec(
+		prom.GaugeOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: SubsystemEndorser,
+			Name:      "proxy_host_circuit_open",
+			Help:      "Whether the circuit breaker of the upstream endorser host is open.",
+		},
+		[]string{LabelEndorserHost})
+)
+
+var registerOnce sync.Once
+
+// RegisterMetrics 注册service层监控指标，可重复调用
+func RegisterMetrics() {
+	registerOnce.Do(func() {
+		prom.MustRegister(EndorserProxyCallCounter)
+		prom.MustRegister(EndorserProxyCallHistogram)
+		prom.MustRegister(EndorserProxyHealthGauge)
+		prom.MustRegister(EndorserProxyBreakerGauge)
+	})
+}
@@ -22,14 +22,16 @@ import (
 )
 
 type RpcServ struct {
-	engine ecom.Engine
-	log    logs.Logger
+	engine   ecom.Engine
+	log      logs.Logger
+	feeCache *feeMedianCache
 }
 
 func NewRpcServ(engine ecom.Engine, log logs.Logger) *RpcServ {
 	return &RpcServ{
-		engine: engine,
-		log:    log,
+		engine:   engine,
+		log:      log,
+		feeCache: newFeeMedianCache(),
 	}
 }
 